@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/view"
+)
+
+var (
+	outputFormat string
+	getCmd       = &cobra.Command{
+		Use:   "get <resource>",
+		Short: "Print resources without starting the UI",
+		Long: `Lists resources through the same data layer as the UI and prints them to stdout.
+Resources accept the UI's command names and aliases, e.g. ec2, sg, roles or vpc/subnet.
+With --all-regions (-A), or when the profile's region is "all", regional
+resources are listed in every enabled region and merged. Regions that fail
+are reported on stderr and the command exits non-zero after printing the rest.`,
+		Example: `  a1s get ec2 --region us-east-1 -o json
+  a1s get ec2 -A -o table
+  a1s get buckets -o yaml
+  a1s get sg --profile prod`,
+		Args: cobra.ExactArgs(1),
+		RunE: runGet,
+	}
+)
+
+func initGetCmd() {
+	getCmd.Flags().StringVarP(&outputFormat, "output", "o", view.OutputTable, "Output format (json, yaml, table)")
+	rootCmd.AddCommand(getCmd)
+}
+
+func runGet(cmd *cobra.Command, args []string) error {
+	switch outputFormat {
+	case view.OutputJSON, view.OutputYAML, view.OutputTable:
+	default:
+		return fmt.Errorf("unknown output format %q (use json, yaml or table)", outputFormat)
+	}
+	cmd.SilenceUsage = true
+
+	s, err := newSession()
+	if err != nil {
+		return err
+	}
+	defer s.logFile.Close()
+
+	rid, err := view.ResolveResource(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.A1s.Timeouts.Duration(config.TimeoutList))
+	defer cancel()
+
+	objects, listErr := view.ListResources(ctx, dao.NewFactory(s.client), rid, s.region)
+	if listErr != nil && !errors.Is(listErr, dao.ErrPartialList) {
+		return listErr
+	}
+
+	if err := view.WriteResources(os.Stdout, rid, objects, s.region, outputFormat); err != nil {
+		return err
+	}
+	return listErr
+}
@@ -1,11 +1,16 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"log/slog"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/a1s/a1s/internal/aws"
 	"github.com/a1s/a1s/internal/config"
@@ -46,21 +51,37 @@ func init() {
 	a1sFlags = config.NewFlags()
 	initA1sFlags()
 	rootCmd.AddCommand(versionCmd)
+	initGetCmd()
 }
 
 func initA1sFlags() {
 	rootCmd.Flags().Float32VarP(a1sFlags.RefreshRate, "refresh", "r", 2.0, "Refresh rate in seconds")
-	rootCmd.Flags().StringVarP(a1sFlags.LogLevel, "logLevel", "l", "info", "Log level (debug, info, warn, error)")
-	rootCmd.Flags().StringVar(a1sFlags.LogFile, "logFile", "", "Log file path")
+	rootCmd.Flags().StringVarP(a1sFlags.LogLevel, "log-level", "l", config.DefaultLogLevel, "Log level (debug, info, warn, error)")
+	rootCmd.Flags().StringVar(a1sFlags.LogFile, "log-file", "", "Log file path (default ~/.local/state/a1s/a1s.log)")
+	rootCmd.Flags().SetNormalizeFunc(legacyFlagNames)
 	rootCmd.Flags().StringVarP(a1sFlags.Command, "command", "c", "", "Startup command/view")
 	rootCmd.Flags().BoolVar(a1sFlags.ReadOnly, "readonly", false, "Enable read-only mode")
 	rootCmd.Flags().BoolVar(a1sFlags.Write, "write", false, "Enable write mode (overrides readonly)")
 	rootCmd.Flags().BoolVar(a1sFlags.Headless, "headless", false, "Run in headless mode")
+	rootCmd.Flags().BoolVar(a1sFlags.NoColor, "no-color", false, "Render without colors (env "+config.NoColorEnv+")")
+	rootCmd.Flags().BoolVar(a1sFlags.DryRun, "dry-run", false, "Rehearse destructive operations without changing anything")
+
+	// AWS-specific flags, shared with the non-interactive commands
+	rootCmd.PersistentFlags().StringVar(a1sFlags.Profile, "profile", "", "AWS profile to use")
+	rootCmd.PersistentFlags().StringVar(a1sFlags.Region, "region", "", "AWS region to use")
+	rootCmd.PersistentFlags().BoolVarP(a1sFlags.AllRegions, "all-regions", "A", false, "Show resources from all regions")
+	rootCmd.PersistentFlags().StringVar(a1sFlags.EndpointURL, "endpoint-url", "", "Custom AWS endpoint URL, e.g. LocalStack (env "+aws.EndpointURLEnv+")")
+}
 
-	// AWS-specific flags
-	rootCmd.Flags().StringVar(a1sFlags.Profile, "profile", "", "AWS profile to use")
-	rootCmd.Flags().StringVar(a1sFlags.Region, "region", "", "AWS region to use")
-	rootCmd.Flags().BoolVarP(a1sFlags.AllRegions, "all-regions", "A", false, "Show resources from all regions")
+// legacyFlagNames keeps the former camel-case log flags working.
+func legacyFlagNames(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+	switch name {
+	case "logLevel":
+		name = "log-level"
+	case "logFile":
+		name = "log-file"
+	}
+	return pflag.NormalizedName(name)
 }
 
 func main() {
@@ -69,57 +90,114 @@ func main() {
 	}
 }
 
-func run(cmd *cobra.Command, args []string) error {
+// session holds the configuration and AWS client shared by the TUI and the
+// non-interactive commands.
+type session struct {
+	cfg        *config.Config
+	client     *aws.APIClient
+	profile    string
+	region     string
+	noProfiles bool
+	logFile    io.Closer
+}
+
+// newSession loads the configuration and creates the AWS client.
+func newSession() (*session, error) {
 	// 1. Initialize locations
 	if err := config.InitLocs(); err != nil {
-		return fmt.Errorf("failed to initialize locations: %w", err)
+		return nil, fmt.Errorf("failed to initialize locations: %w", err)
 	}
 
 	// 2. Initialize log location
 	if err := config.InitLogLoc(); err != nil {
-		return fmt.Errorf("failed to initialize log location: %w", err)
+		return nil, fmt.Errorf("failed to initialize log location: %w", err)
 	}
 
-	// 3. Load AWS profile settings
-	awsSettings, err := aws.NewProfileManager()
+	// Log to a file only, the TUI owns the terminal
+	logFile, err := config.InitLogger(*a1sFlags.LogFile, *a1sFlags.LogLevel)
 	if err != nil {
-		return fmt.Errorf("failed to load AWS profiles: %w", err)
+		return nil, err
+	}
+
+	// 3. Load AWS profile settings. Without any profiles a1s still starts,
+	// degraded, so the user can fix ~/.aws and :reconnect.
+	awsSettings, err := aws.NewProfileManager()
+	noProfiles := errors.Is(err, aws.ErrNoProfiles)
+	if err != nil && !noProfiles {
+		return nil, fmt.Errorf("failed to load AWS profiles: %w", err)
 	}
 
 	// 4. Create and load configuration
 	cfg := config.NewConfig(awsSettings)
 	if err := cfg.Load(config.AppConfigFile, false); err != nil {
-		return fmt.Errorf("failed to load configuration: %w", err)
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
 	}
 
+	// Restore the last session's profile/region (best effort)
+	_ = cfg.LoadState(config.AppStateFile)
+
 	// 5. Apply CLI overrides
 	cfg.A1s.Override(a1sFlags)
 
 	// 6. Refine configuration (apply precedence logic)
-	if err := cfg.Refine(a1sFlags, awsSettings); err != nil {
-		return fmt.Errorf("failed to refine configuration: %w", err)
+	if !noProfiles {
+		if err := cfg.Refine(a1sFlags, awsSettings); err != nil {
+			return nil, fmt.Errorf("failed to refine configuration: %w", err)
+		}
 	}
 
-	// 7. Save configuration
-	_ = cfg.Save(false)
-
-	// 8. Create AWS client
+	// 7. Create AWS client
 	profile := cfg.A1s.ActiveProfile()
 	region := cfg.A1s.ActiveRegion()
+	if region == "" {
+		region = *a1sFlags.Region
+	}
+	if region == "" {
+		region = aws.EnvRegion()
+	}
 	if region == "" {
 		region = aws.DefaultRegion
 	}
 
+	endpointURL := *a1sFlags.EndpointURL
+	if endpointURL == "" {
+		endpointURL = os.Getenv(aws.EndpointURLEnv)
+	}
+
 	clientCfg := &aws.ClientConfig{
-		Profile: profile,
-		Region:  region,
-		Timeout: 30 * time.Second,
+		Profile:     profile,
+		Region:      region,
+		Timeout:     30 * time.Second,
+		EndpointURL: endpointURL,
 	}
 
 	apiClient, err := aws.NewAPIClient(awsSettings, clientCfg)
 	if err != nil {
-		return fmt.Errorf("failed to create AWS client: %w", err)
+		return nil, fmt.Errorf("failed to create AWS client: %w", err)
+	}
+
+	return &session{
+		cfg:        cfg,
+		client:     apiClient,
+		profile:    profile,
+		region:     region,
+		noProfiles: noProfiles,
+		logFile:    logFile,
+	}, nil
+}
+
+func run(cmd *cobra.Command, args []string) error {
+	s, err := newSession()
+	if err != nil {
+		return err
 	}
+	cfg, apiClient := s.cfg, s.client
+	profile, region, noProfiles := s.profile, s.region, s.noProfiles
+	defer s.logFile.Close()
+	slog.Info("Starting a1s", "version", appVersion, "profile", profile, "region", region)
+
+	// 8. Save configuration
+	_ = cfg.Save(false)
 
 	// 9. Create factory from client
 	factory := dao.NewFactory(apiClient)
@@ -138,7 +216,9 @@ func run(cmd *cobra.Command, args []string) error {
 		accountID = apiClient.AccountID()
 	}
 
-	// 12. Set account info
+	// 12. Set account info, noting where the profile and region came from
+	profileOrigin, regionOrigin := cfg.Origins()
+	app.SetOrigins(profile, profileOrigin, region, regionOrigin)
 	app.SetAccountInfo(
 		profile,
 		region,
@@ -146,6 +226,24 @@ func run(cmd *cobra.Command, args []string) error {
 		appVersion,
 	)
 
+	for _, w := range cfg.Warnings() {
+		app.Flash().Warn(w)
+	}
+	if noProfiles && accountID == "" {
+		app.Flash().Err(fmt.Errorf("no AWS credentials found: run aws configure, then :reconnect"))
+	}
+
 	// 13. Run the application
-	return app.Run()
+	runErr := app.Run()
+
+	// 14. Persist the command history and active profile/region for the next run.
+	// History is saved even when no client connected; the last profile/region are kept then.
+	cfg.SetCommandHistory(app.CommandHistory())
+	var lastProfile, lastRegion string
+	if client := factory.Client(); client != nil {
+		lastProfile, lastRegion = client.ActiveProfile(), client.ActiveRegion()
+	}
+	_ = cfg.SaveState(lastProfile, lastRegion)
+
+	return runErr
 }
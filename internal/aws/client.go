@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 	"sync"
 	"time"
@@ -12,10 +13,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go"
 )
@@ -25,7 +28,9 @@ type Error string
 const (
 	ErrNoCredentials      = Error("no AWS credentials found")
 	ErrExpiredCredentials = Error("AWS credentials have expired")
+	ErrSSOLoginRequired   = Error("SSO login required or token expired")
 	ErrNoConnection       = Error("no connection to AWS")
+	ErrNoProfiles         = Error("no AWS profiles found")
 	ErrInvalidProfile     = Error("invalid AWS profile")
 	ErrInvalidRegion      = Error("invalid AWS region")
 )
@@ -37,28 +42,55 @@ func (e Error) Error() string {
 type Connection interface {
 	Config() *ClientConfig
 	ConnectionOK() bool
+	ConnectionErr() error
+	Reset()
+	Reconnect() error
 	CheckConnectivity() bool
 	SwitchProfile(profile string) error
 	SwitchRegion(region string) error
+	ForProfile(profile string) (Connection, error)
 	ActiveProfile() string
 	ActiveRegion() string
 	AccountID() string
+	CallerIdentity() CallerIdentity
+	ProfileChain(profile string) ([]*Profile, error)
 	ProfileNames() []string
 	ProfileRegion(profile string) string
+	ProfileIsSSO(profile string) bool
 	EC2(region string) *ec2.Client
 	S3() *s3.Client
 	S3Regional(region string) *s3.Client
+	BucketRegion(ctx context.Context, bucket string) (string, error)
 	IAM() *iam.Client
 	EKS(region string) *eks.Client
 	STS(region string) *sts.Client
 	CloudControl(region string) *cloudcontrol.Client
 	CloudFormation(region string) *cloudformation.Client
+	GetMetricData(ctx context.Context, region string, queries []MetricQuery, start, end time.Time) ([]MetricSeries, error)
+	RunSSMCommand(ctx context.Context, region, instanceID, command string) (*SSMCommandResult, error)
+	DescribeInstanceInformation(ctx context.Context, region string, instanceIDs ...string) ([]SSMInstanceInfo, error)
 }
 
+// EndpointURLEnv names the environment variable holding a custom endpoint URL.
+const EndpointURLEnv = "A1S_ENDPOINT_URL"
+
+// ClientConfig holds the resolved startup profile and region; config.Refine
+// decides them as flag > environment > config file > default.
 type ClientConfig struct {
 	Profile string
 	Region  string
 	Timeout time.Duration
+	// EndpointURL points every service client at a custom endpoint such as
+	// LocalStack or a VPC endpoint. S3 then uses path-style addressing, which
+	// LocalStack needs unless its virtual-host DNS is set up.
+	EndpointURL string
+}
+
+// CallerIdentity is who STS says the active credentials belong to.
+type CallerIdentity struct {
+	Account string
+	Arn     string
+	UserID  string
 }
 
 type ServiceClients struct {
@@ -69,17 +101,25 @@ type ServiceClients struct {
 	stsClient            *sts.Client
 	cloudcontrolClient   *cloudcontrol.Client
 	cloudformationClient *cloudformation.Client
+	cloudwatchClient     *cloudwatch.Client
+	ssmClient            *ssm.Client
 	awsConfig            aws.Config
 	createdAt            time.Time
 }
 
+// BucketRegionTTL is how long a bucket's region is cached.
+const BucketRegionTTL = 30 * time.Minute
+
 type APIClient struct {
-	config    *ClientConfig
-	settings  ProfileSettings
-	clients   map[string]*ServiceClients
-	accountID string
-	connOK    bool
-	mx        sync.RWMutex
+	config        *ClientConfig
+	settings      ProfileSettings
+	clients       map[string]*ServiceClients
+	bucketRegions *ResourceCache
+	accountID     string
+	identity      CallerIdentity
+	connOK        bool
+	connErr       error
+	mx            sync.RWMutex
 }
 
 // NewAPIClient creates a new APIClient instance with the provided settings and configuration.
@@ -92,9 +132,10 @@ func NewAPIClient(settings ProfileSettings, cfg *ClientConfig) (*APIClient, erro
 	}
 
 	client := &APIClient{
-		config:   cfg,
-		settings: settings,
-		clients:  make(map[string]*ServiceClients),
+		config:        cfg,
+		settings:      settings,
+		clients:       make(map[string]*ServiceClients),
+		bucketRegions: NewResourceCache(&CacheConfig{DefaultTTL: BucketRegionTTL}),
 	}
 
 	return client, nil
@@ -122,9 +163,10 @@ func (c *APIClient) Config() *ClientConfig {
 	defer c.mx.RUnlock()
 	// Return a copy
 	return &ClientConfig{
-		Profile: c.config.Profile,
-		Region:  c.config.Region,
-		Timeout: c.config.Timeout,
+		Profile:     c.config.Profile,
+		Region:      c.config.Region,
+		Timeout:     c.config.Timeout,
+		EndpointURL: c.config.EndpointURL,
 	}
 }
 
@@ -135,6 +177,13 @@ func (c *APIClient) ConnectionOK() bool {
 	return c.connOK
 }
 
+// ConnectionErr returns the error from the last failed connectivity check.
+func (c *APIClient) ConnectionErr() error {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	return c.connErr
+}
+
 // CheckConnectivity verifies connectivity to AWS by calling STS GetCallerIdentity.
 // It caches the account ID on success.
 func (c *APIClient) CheckConnectivity() bool {
@@ -145,10 +194,16 @@ func (c *APIClient) CheckConnectivity() bool {
 		defer cancel()
 	}
 
-	stsClient := c.STS(c.config.Region)
+	// Multi-region mode has no endpoint of its own
+	region := c.config.Region
+	if region == "" || region == RegionAll {
+		region = DefaultRegion
+	}
+	stsClient := c.STS(region)
 	if stsClient == nil {
 		c.mx.Lock()
 		c.connOK = false
+		c.connErr = ErrNoConnection
 		c.mx.Unlock()
 		return false
 	}
@@ -157,16 +212,25 @@ func (c *APIClient) CheckConnectivity() bool {
 	if err != nil {
 		c.mx.Lock()
 		c.connOK = false
+		c.connErr = WrapAWSError(err, "GetCallerIdentity")
 		c.mx.Unlock()
+		slog.Error("Connectivity check failed", "profile", c.config.Profile, "region", region, "err", err)
 		return false
 	}
 
 	c.mx.Lock()
 	c.connOK = true
+	c.connErr = nil
 	if result.Account != nil {
 		c.accountID = *result.Account
 	}
+	c.identity = CallerIdentity{
+		Account: aws.ToString(result.Account),
+		Arn:     aws.ToString(result.Arn),
+		UserID:  aws.ToString(result.UserId),
+	}
 	c.mx.Unlock()
+	slog.Debug("Connectivity check passed", "profile", c.config.Profile, "region", region, "arn", aws.ToString(result.Arn))
 
 	return true
 }
@@ -194,11 +258,26 @@ func (c *APIClient) SwitchProfile(profile string) error {
 	// Update configuration
 	c.config.Profile = profile
 	c.connOK = false
+	c.connErr = nil
 	c.accountID = ""
+	c.identity = CallerIdentity{}
 
 	return nil
 }
 
+// ForProfile returns a separate connection for another profile, sharing
+// this one's region, timeout and endpoint. Switching either connection
+// leaves the other alone.
+func (c *APIClient) ForProfile(profile string) (Connection, error) {
+	if _, err := c.settings.GetProfile(profile); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidProfile, profile)
+	}
+
+	cfg := c.Config()
+	cfg.Profile = profile
+	return NewAPIClient(c.settings, cfg)
+}
+
 // SwitchRegion switches to a new AWS region.
 // Clients are lazily created per region, so this just updates the active region.
 func (c *APIClient) SwitchRegion(region string) error {
@@ -234,6 +313,22 @@ func (c *APIClient) AccountID() string {
 	return c.accountID
 }
 
+// CallerIdentity returns the identity from the last successful
+// connectivity check, or a zero value before one.
+func (c *APIClient) CallerIdentity() CallerIdentity {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	return c.identity
+}
+
+// ProfileChain returns the assume-role chain of a profile, see RoleChain.
+func (c *APIClient) ProfileChain(profile string) ([]*Profile, error) {
+	if c.settings == nil {
+		return nil, ErrNoProfiles
+	}
+	return RoleChain(c.settings, profile)
+}
+
 // ProfileNames returns all available profile names.
 func (c *APIClient) ProfileNames() []string {
 	if c.settings == nil {
@@ -262,6 +357,18 @@ func (c *APIClient) ProfileRegion(profile string) string {
 	return p.DefaultRegion
 }
 
+// ProfileIsSSO reports whether a profile uses IAM Identity Center credentials.
+func (c *APIClient) ProfileIsSSO(profile string) bool {
+	if c.settings == nil {
+		return false
+	}
+	p, err := c.settings.GetProfile(profile)
+	if err != nil || p == nil {
+		return false
+	}
+	return p.IsSSO()
+}
+
 // EC2 returns an EC2 client for the specified region.
 func (c *APIClient) EC2(region string) *ec2.Client {
 	clients, err := c.getClients(region)
@@ -293,6 +400,39 @@ func (c *APIClient) S3Regional(region string) *s3.Client {
 	return clients.s3Client
 }
 
+// BucketRegion returns the region a bucket lives in, caching the answer per
+// profile so repeated operations on a bucket skip GetBucketLocation.
+func (c *APIClient) BucketRegion(ctx context.Context, bucket string) (string, error) {
+	c.mx.RLock()
+	key := c.config.Profile + ":" + bucket
+	cache := c.bucketRegions
+	c.mx.RUnlock()
+
+	if region, ok := cache.Get(key); ok {
+		return region.(string), nil
+	}
+
+	client := c.S3()
+	if client == nil {
+		return "", fmt.Errorf("failed to get S3 client")
+	}
+	output, err := client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		return "", WrapAWSError(err, "get bucket location")
+	}
+
+	// AWS returns empty string for us-east-1
+	region := string(output.LocationConstraint)
+	if region == "" {
+		region = DefaultRegion
+	}
+	cache.Set(key, region)
+
+	return region, nil
+}
+
 // IAM returns an IAM client (uses us-east-1 as IAM is a global service).
 func (c *APIClient) IAM() *iam.Client {
 	clients, err := c.getClients(DefaultRegion)
@@ -344,8 +484,39 @@ func (c *APIClient) Reset() {
 	defer c.mx.Unlock()
 
 	c.clients = make(map[string]*ServiceClients)
+	c.bucketRegions = NewResourceCache(&CacheConfig{DefaultTTL: BucketRegionTTL})
 	c.connOK = false
+	c.connErr = nil
 	c.accountID = ""
+	c.identity = CallerIdentity{}
+}
+
+// Reconnect reloads profiles from disk, drops cached clients and re-checks
+// connectivity, so fixes to ~/.aws apply without restarting.
+// If the active profile no longer exists, the default profile is used.
+func (c *APIClient) Reconnect() error {
+	if r, ok := c.settings.(interface{ Reload() error }); ok {
+		if err := r.Reload(); err != nil && !errors.Is(err, ErrNoProfiles) {
+			return err
+		}
+	}
+
+	c.mx.Lock()
+	if _, err := c.settings.GetProfile(c.config.Profile); err != nil {
+		if name, err := c.settings.CurrentProfileName(); err == nil {
+			c.config.Profile = name
+		}
+	}
+	c.mx.Unlock()
+
+	c.Reset()
+	if c.CheckConnectivity() {
+		return nil
+	}
+	if err := c.ConnectionErr(); err != nil {
+		return err
+	}
+	return ErrNoConnection
 }
 
 // getClients retrieves or creates service clients for the specified region.
@@ -393,11 +564,21 @@ func (c *APIClient) createClients(profile, region string) (*ServiceClients, erro
 	cfg, err := config.LoadDefaultConfig(ctx,
 		config.WithRegion(region),
 		config.WithSharedConfigProfile(profile),
+		config.WithRetryer(newRetryer),
 	)
 	if err != nil {
 		return nil, WrapAWSError(err, "load AWS config")
 	}
 
+	// Custom endpoints apply to every service built from cfg
+	var s3Opts []func(*s3.Options)
+	if c.config.EndpointURL != "" {
+		cfg.BaseEndpoint = aws.String(c.config.EndpointURL)
+		s3Opts = append(s3Opts, func(o *s3.Options) {
+			o.UsePathStyle = true
+		})
+	}
+
 	clients := &ServiceClients{
 		awsConfig: cfg,
 		createdAt: time.Now(),
@@ -405,35 +586,44 @@ func (c *APIClient) createClients(profile, region string) (*ServiceClients, erro
 
 	// Create service clients
 	clients.ec2Client = ec2.NewFromConfig(cfg)
-	clients.s3Client = s3.NewFromConfig(cfg)
+	clients.s3Client = s3.NewFromConfig(cfg, s3Opts...)
 	clients.iamClient = iam.NewFromConfig(cfg)
 	clients.eksClient = eks.NewFromConfig(cfg)
 	clients.stsClient = sts.NewFromConfig(cfg)
 	clients.cloudcontrolClient = cloudcontrol.NewFromConfig(cfg)
 	clients.cloudformationClient = cloudformation.NewFromConfig(cfg)
+	clients.cloudwatchClient = cloudwatch.NewFromConfig(cfg)
+	clients.ssmClient = ssm.NewFromConfig(cfg)
 
 	return clients, nil
 }
 
 // WrapAWSError wraps AWS SDK errors with additional context.
+// The original error stays in the chain so callers can still reach the
+// smithy.APIError with errors.As.
 func WrapAWSError(err error, operation string) error {
 	if err == nil {
 		return nil
 	}
+	slog.Debug("AWS call failed", "operation", operation, "err", err)
+
+	if IsSSOTokenError(err) {
+		return fmt.Errorf("%w: %s: %w", ErrSSOLoginRequired, operation, err)
+	}
 
 	var apiErr smithy.APIError
 	if errors.As(err, &apiErr) {
-		switch apiErr.ErrorCode() {
-		case "AccessDenied", "AccessDeniedException":
+		switch code := apiErr.ErrorCode(); {
+		case code == "InvalidClientTokenId":
+			return fmt.Errorf("%w: %s: %w", ErrNoCredentials, operation, err)
+		case ClassifyErrorCode(code) == ErrKindAccessDenied:
 			return fmt.Errorf("access denied for %s: %w", operation, err)
-		case "ExpiredToken", "ExpiredTokenException":
-			return fmt.Errorf("%w: %s", ErrExpiredCredentials, operation)
-		case "ThrottlingException":
+		case ClassifyErrorCode(code) == ErrKindExpiredToken:
+			return fmt.Errorf("%w: %s: %w", ErrExpiredCredentials, operation, err)
+		case ClassifyErrorCode(code) == ErrKindThrottling:
 			return fmt.Errorf("rate limited during %s: %w", operation, err)
-		case "InvalidClientTokenId":
-			return fmt.Errorf("%w: %s", ErrNoCredentials, operation)
 		default:
-			return fmt.Errorf("%s failed: %s (%s)", operation, apiErr.ErrorMessage(), apiErr.ErrorCode())
+			return fmt.Errorf("%s failed: %w", operation, err)
 		}
 	}
 
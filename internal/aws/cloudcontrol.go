@@ -9,8 +9,10 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	cctypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
 )
 
 // Cloud Control errors
@@ -50,9 +52,22 @@ func GetResourceState(ctx context.Context, client *cloudcontrol.Client, typeName
 	return props, nil
 }
 
-// UpdateResourceState updates a resource using a JSON Patch document.
-// The patchDocument should be a RFC 6902 JSON Patch array.
-func UpdateResourceState(ctx context.Context, client *cloudcontrol.Client, typeName, identifier, patchDocument string) error {
+// updatePollInterval is how often an in-flight Cloud Control request is
+// polled when the service gives no retry hint.
+const updatePollInterval = 2 * time.Second
+
+// UpdateProgress is a progress event of a Cloud Control update request.
+type UpdateProgress struct {
+	Status  string // PENDING, IN_PROGRESS, SUCCESS, FAILED, ...
+	Message string
+	Elapsed time.Duration
+}
+
+// UpdateResourceState updates a resource using a JSON Patch document and
+// waits for the request to finish, reporting each progress event to progress
+// (which may be nil). The patchDocument should be a RFC 6902 JSON Patch array.
+// If ctx expires first, the error says the update is still in progress.
+func UpdateResourceState(ctx context.Context, client *cloudcontrol.Client, typeName, identifier, patchDocument string, progress func(UpdateProgress)) error {
 	if client == nil {
 		return errors.New("cloudcontrol client is nil")
 	}
@@ -63,19 +78,65 @@ func UpdateResourceState(ctx context.Context, client *cloudcontrol.Client, typeN
 		PatchDocument: &patchDocument,
 	}
 
+	start := time.Now()
 	result, err := client.UpdateResource(ctx, input)
 	if err != nil {
 		return fmt.Errorf("%w: %s: %v", ErrUpdateResourceFailed, typeName, err)
 	}
 
-	// Check operation status
-	if result.ProgressEvent != nil && result.ProgressEvent.ErrorCode != "" {
-		return fmt.Errorf("%w: %s: %s", ErrUpdateResourceFailed,
-			result.ProgressEvent.ErrorCode,
-			safeString(result.ProgressEvent.StatusMessage))
+	event := result.ProgressEvent
+	for {
+		if event == nil {
+			return nil
+		}
+		if progress != nil {
+			progress(UpdateProgress{
+				Status:  string(event.OperationStatus),
+				Message: safeString(event.StatusMessage),
+				Elapsed: time.Since(start),
+			})
+		}
+
+		switch event.OperationStatus {
+		case cctypes.OperationStatusSuccess:
+			return nil
+		case cctypes.OperationStatusFailed, cctypes.OperationStatusCancelComplete:
+			return fmt.Errorf("%w: %s: %s", ErrUpdateResourceFailed,
+				event.ErrorCode, safeString(event.StatusMessage))
+		}
+		if event.OperationStatus == "" && event.ErrorCode != "" {
+			return fmt.Errorf("%w: %s: %s", ErrUpdateResourceFailed,
+				event.ErrorCode, safeString(event.StatusMessage))
+		}
+		if event.RequestToken == nil {
+			return nil
+		}
+
+		wait := updatePollInterval
+		if event.RetryAfter != nil {
+			if d := time.Until(*event.RetryAfter); d > 0 && d < wait*5 {
+				wait = d
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("update of %s still in progress after %s (request %s): %w",
+				identifier, time.Since(start).Round(time.Second), *event.RequestToken, ctx.Err())
+		case <-time.After(wait):
+		}
+
+		status, err := client.GetResourceRequestStatus(ctx, &cloudcontrol.GetResourceRequestStatusInput{
+			RequestToken: event.RequestToken,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("update of %s still in progress after %s (request %s): %w",
+					identifier, time.Since(start).Round(time.Second), *event.RequestToken, ctx.Err())
+			}
+			return fmt.Errorf("%w: %s: %v", ErrUpdateResourceFailed, typeName, err)
+		}
+		event = status.ProgressEvent
 	}
-
-	return nil
 }
 
 // ExtractIdentifier extracts the Cloud Control identifier from a resource path.
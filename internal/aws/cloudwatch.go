@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package aws
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+// MetricQuery selects one CloudWatch metric statistic.
+type MetricQuery struct {
+	ID         string
+	Namespace  string
+	MetricName string
+	Dimensions map[string]string
+	Stat       string
+	Period     time.Duration
+}
+
+// MetricSeries holds the datapoints returned for one MetricQuery,
+// oldest first.
+type MetricSeries struct {
+	ID         string
+	Label      string
+	Timestamps []time.Time
+	Values     []float64
+}
+
+// GetMetricData fetches the given metrics between start and end.
+// Series are returned in query order; metrics without data have no values.
+func (c *APIClient) GetMetricData(ctx context.Context, region string, queries []MetricQuery, start, end time.Time) ([]MetricSeries, error) {
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	clients, err := c.getClients(region)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*MetricSeries, len(queries))
+	series := make([]MetricSeries, len(queries))
+	for i, q := range queries {
+		series[i].ID = q.ID
+		series[i].Label = q.MetricName
+		byID[q.ID] = &series[i]
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		MetricDataQueries: metricDataQueries(queries),
+		StartTime:         aws.Time(start),
+		EndTime:           aws.Time(end),
+		ScanBy:            types.ScanByTimestampAscending,
+	}
+	for {
+		out, err := clients.cloudwatchClient.GetMetricData(ctx, input)
+		if err != nil {
+			return nil, WrapAWSError(err, "GetMetricData")
+		}
+
+		for _, r := range out.MetricDataResults {
+			s, ok := byID[aws.ToString(r.Id)]
+			if !ok {
+				continue
+			}
+			if label := aws.ToString(r.Label); label != "" {
+				s.Label = label
+			}
+			n := min(len(r.Timestamps), len(r.Values))
+			s.Timestamps = append(s.Timestamps, r.Timestamps[:n]...)
+			s.Values = append(s.Values, r.Values[:n]...)
+		}
+
+		if aws.ToString(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	for i := range series {
+		sort.Sort(byTimestamp{&series[i]})
+	}
+	return series, nil
+}
+
+// metricDataQueries converts queries to their CloudWatch form.
+func metricDataQueries(queries []MetricQuery) []types.MetricDataQuery {
+	out := make([]types.MetricDataQuery, len(queries))
+	for i, q := range queries {
+		names := make([]string, 0, len(q.Dimensions))
+		for name := range q.Dimensions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		dims := make([]types.Dimension, len(names))
+		for j, name := range names {
+			dims[j] = types.Dimension{Name: aws.String(name), Value: aws.String(q.Dimensions[name])}
+		}
+
+		out[i] = types.MetricDataQuery{
+			Id: aws.String(q.ID),
+			MetricStat: &types.MetricStat{
+				Metric: &types.Metric{
+					Namespace:  aws.String(q.Namespace),
+					MetricName: aws.String(q.MetricName),
+					Dimensions: dims,
+				},
+				Period: aws.Int32(int32(q.Period / time.Second)),
+				Stat:   aws.String(q.Stat),
+			},
+		}
+	}
+	return out
+}
+
+// byTimestamp sorts a series' datapoints oldest first.
+type byTimestamp struct{ s *MetricSeries }
+
+func (b byTimestamp) Len() int { return len(b.s.Timestamps) }
+func (b byTimestamp) Less(i, j int) bool {
+	return b.s.Timestamps[i].Before(b.s.Timestamps[j])
+}
+func (b byTimestamp) Swap(i, j int) {
+	b.s.Timestamps[i], b.s.Timestamps[j] = b.s.Timestamps[j], b.s.Timestamps[i]
+	b.s.Values[i], b.s.Values[j] = b.s.Values[j], b.s.Values[i]
+}
@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	// consoleHost is the global AWS Management Console host.
+	consoleHost = "https://console.aws.amazon.com"
+	// s3ConsoleHost is the S3 console host, which is global.
+	s3ConsoleHost = "https://s3.console.aws.amazon.com"
+)
+
+// ConsoleURL returns the AWS Management Console deep link for a resource.
+// The id is the resource's identifying value (instance ID, bucket name, IAM name, ...);
+// accountID is only needed for account-scoped links such as customer managed policies.
+func ConsoleURL(service, resource, region, id, accountID string) (string, error) {
+	if region == "" || region == RegionAll {
+		region = DefaultRegion
+	}
+	host := fmt.Sprintf("https://%s.console.aws.amazon.com", region)
+	q := url.QueryEscape
+
+	switch service + "/" + resource {
+	case "ec2/instance":
+		return fmt.Sprintf("%s/ec2/home?region=%s#InstanceDetails:instanceId=%s", host, region, q(id)), nil
+	case "ec2/volume":
+		return fmt.Sprintf("%s/ec2/home?region=%s#VolumeDetails:volumeId=%s", host, region, q(id)), nil
+	case "ec2/securitygroup", "vpc/securitygroup":
+		return fmt.Sprintf("%s/ec2/home?region=%s#SecurityGroup:groupId=%s", host, region, q(id)), nil
+	case "vpc/vpc":
+		return fmt.Sprintf("%s/vpcconsole/home?region=%s#VpcDetails:VpcId=%s", host, region, q(id)), nil
+	case "vpc/subnet":
+		return fmt.Sprintf("%s/vpcconsole/home?region=%s#SubnetDetails:subnetId=%s", host, region, q(id)), nil
+	case "ssm/instance":
+		return fmt.Sprintf("%s/systems-manager/fleet-manager/managed-nodes/%s/general?region=%s", host, url.PathEscape(id), region), nil
+	case "eks/cluster":
+		return fmt.Sprintf("%s/eks/home?region=%s#/clusters/%s", host, region, url.PathEscape(id)), nil
+	case "s3/bucket":
+		return fmt.Sprintf("%s/s3/buckets/%s", s3ConsoleHost, url.PathEscape(id)), nil
+	case "iam/user":
+		return fmt.Sprintf("%s/iam/home#/users/details/%s", consoleHost, url.PathEscape(id)), nil
+	case "iam/role":
+		return fmt.Sprintf("%s/iam/home#/roles/details/%s", consoleHost, url.PathEscape(id)), nil
+	case "iam/policy":
+		if accountID == "" {
+			return "", fmt.Errorf("account ID unknown, cannot link policy %s", id)
+		}
+		arn := fmt.Sprintf("arn:aws:iam::%s:policy/%s", accountID, id)
+		return fmt.Sprintf("%s/iam/home#/policies/details/%s", consoleHost, q(arn)), nil
+	}
+
+	return "", fmt.Errorf("console links not supported for %s/%s", service, resource)
+}
+
+// S3ObjectConsoleURL returns the console deep link for an S3 object.
+func S3ObjectConsoleURL(bucket, key string) string {
+	return fmt.Sprintf("%s/s3/object/%s?prefix=%s", s3ConsoleHost, url.PathEscape(bucket), url.QueryEscape(key))
+}
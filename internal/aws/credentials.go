@@ -17,6 +17,27 @@ const (
 	CredentialSourceEnvironment
 )
 
+// Environment variables the AWS CLI and SDKs read for profile and region.
+const (
+	ProfileEnv       = "AWS_PROFILE"
+	RegionEnv        = "AWS_REGION"
+	DefaultRegionEnv = "AWS_DEFAULT_REGION"
+)
+
+// EnvProfile returns the profile named by AWS_PROFILE, if any.
+func EnvProfile() string {
+	return os.Getenv(ProfileEnv)
+}
+
+// EnvRegion returns the region from AWS_REGION or, failing that,
+// AWS_DEFAULT_REGION, matching the SDK's lookup order.
+func EnvRegion() string {
+	if region := os.Getenv(RegionEnv); region != "" {
+		return region
+	}
+	return os.Getenv(DefaultRegionEnv)
+}
+
 type CredentialInfo struct {
 	Profile         string
 	Source          CredentialSource
@@ -92,7 +113,7 @@ func (d *CredentialDiscovery) DiscoverProfiles() ([]string, error) {
 // GetDefaultProfile returns the default profile to use.
 // First checks AWS_PROFILE environment variable, then returns "default".
 func (d *CredentialDiscovery) GetDefaultProfile() (string, error) {
-	if profile := os.Getenv("AWS_PROFILE"); profile != "" {
+	if profile := EnvProfile(); profile != "" {
 		return profile, nil
 	}
 	return "default", nil
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/smithy-go"
+)
+
+// dryRunKey marks a context as a dry run.
+type dryRunKey struct{}
+
+// WithDryRun returns a context under which mutating calls only rehearse.
+// EC2 calls are sent with DryRun set, so AWS still checks permissions and
+// parameters; calls to services without a dry-run option are skipped.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether ctx is a dry run.
+func IsDryRun(ctx context.Context) bool {
+	dry, _ := ctx.Value(dryRunKey{}).(bool)
+	return dry
+}
+
+// DryRunFlag returns the EC2 DryRun parameter for ctx, nil outside dry runs.
+func DryRunFlag(ctx context.Context) *bool {
+	if !IsDryRun(ctx) {
+		return nil
+	}
+	dry := true
+	return &dry
+}
+
+// DryRunResult maps the DryRunOperation error EC2 returns when a dry run
+// would have succeeded to nil.
+func DryRunResult(err error) error {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode() == "DryRunOperation" {
+		return nil
+	}
+	return err
+}
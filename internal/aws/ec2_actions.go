@@ -6,16 +6,19 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
 
-// StartInstance starts an EC2 instance.
+// StartInstance starts an EC2 instance. Like the other instance actions it
+// only validates the request under a dry-run context.
 func StartInstance(ctx context.Context, client *ec2.Client, instanceID string) error {
 	_, err := client.StartInstances(ctx, &ec2.StartInstancesInput{
 		InstanceIds: []string{instanceID},
+		DryRun:      DryRunFlag(ctx),
 	})
-	if err != nil {
+	if err = DryRunResult(err); err != nil {
 		return fmt.Errorf("failed to start instance %s: %w", instanceID, err)
 	}
 	return nil
@@ -25,8 +28,9 @@ func StartInstance(ctx context.Context, client *ec2.Client, instanceID string) e
 func StopInstance(ctx context.Context, client *ec2.Client, instanceID string) error {
 	_, err := client.StopInstances(ctx, &ec2.StopInstancesInput{
 		InstanceIds: []string{instanceID},
+		DryRun:      DryRunFlag(ctx),
 	})
-	if err != nil {
+	if err = DryRunResult(err); err != nil {
 		return fmt.Errorf("failed to stop instance %s: %w", instanceID, err)
 	}
 	return nil
@@ -36,8 +40,9 @@ func StopInstance(ctx context.Context, client *ec2.Client, instanceID string) er
 func RebootInstance(ctx context.Context, client *ec2.Client, instanceID string) error {
 	_, err := client.RebootInstances(ctx, &ec2.RebootInstancesInput{
 		InstanceIds: []string{instanceID},
+		DryRun:      DryRunFlag(ctx),
 	})
-	if err != nil {
+	if err = DryRunResult(err); err != nil {
 		return fmt.Errorf("failed to reboot instance %s: %w", instanceID, err)
 	}
 	return nil
@@ -47,9 +52,32 @@ func RebootInstance(ctx context.Context, client *ec2.Client, instanceID string)
 func TerminateInstance(ctx context.Context, client *ec2.Client, instanceID string) error {
 	_, err := client.TerminateInstances(ctx, &ec2.TerminateInstancesInput{
 		InstanceIds: []string{instanceID},
+		DryRun:      DryRunFlag(ctx),
 	})
-	if err != nil {
+	if err = DryRunResult(err); err != nil {
 		return fmt.Errorf("failed to terminate instance %s: %w", instanceID, err)
 	}
 	return nil
 }
+
+// WaitInstanceRunning blocks until an EC2 instance is running or maxWait elapses.
+func WaitInstanceRunning(ctx context.Context, client *ec2.Client, instanceID string, maxWait time.Duration) error {
+	err := ec2.NewInstanceRunningWaiter(client).Wait(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	}, maxWait)
+	if err != nil {
+		return fmt.Errorf("waiting for instance %s to run: %w", instanceID, err)
+	}
+	return nil
+}
+
+// WaitInstanceStopped blocks until an EC2 instance is stopped or maxWait elapses.
+func WaitInstanceStopped(ctx context.Context, client *ec2.Client, instanceID string, maxWait time.Duration) error {
+	err := ec2.NewInstanceStoppedWaiter(client).Wait(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	}, maxWait)
+	if err != nil {
+		return fmt.Errorf("waiting for instance %s to stop: %w", instanceID, err)
+	}
+	return nil
+}
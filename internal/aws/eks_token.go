@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package aws
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// eksTokenPrefix marks a bearer token as an EKS IAM token.
+const eksTokenPrefix = "k8s-aws-v1."
+
+// EKSToken returns a Kubernetes bearer token for an EKS cluster, as
+// `aws eks get-token` does: a presigned STS GetCallerIdentity URL bound to
+// the cluster name. Tokens are valid for about 15 minutes.
+func EKSToken(ctx context.Context, client *sts.Client, clusterName string) (string, error) {
+	req, err := sts.NewPresignClient(client).PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
+		func(po *sts.PresignOptions) {
+			po.ClientOptions = append(po.ClientOptions, func(o *sts.Options) {
+				o.APIOptions = append(o.APIOptions,
+					smithyhttp.AddHeaderValue("x-k8s-aws-id", clusterName),
+					smithyhttp.AddHeaderValue("X-Amz-Expires", "60"),
+				)
+			})
+		})
+	if err != nil {
+		return "", fmt.Errorf("failed to create EKS token for %s: %w", clusterName, err)
+	}
+	return eksTokenPrefix + base64.RawURLEncoding.EncodeToString([]byte(req.URL)), nil
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	ssotypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	ssooidctypes "github.com/aws/aws-sdk-go-v2/service/ssooidc/types"
+	"github.com/aws/smithy-go"
+)
+
+// ErrorKind classifies AWS API error codes.
+type ErrorKind int
+
+const (
+	// ErrKindOther is any error code without a dedicated kind.
+	ErrKindOther ErrorKind = iota
+	// ErrKindAccessDenied means the caller lacks permission.
+	ErrKindAccessDenied
+	// ErrKindExpiredToken means the credentials are expired or invalid.
+	ErrKindExpiredToken
+	// ErrKindThrottling means the request was rate limited.
+	ErrKindThrottling
+	// ErrKindNotFound means the requested resource does not exist.
+	ErrKindNotFound
+)
+
+// ClassifyErrorCode maps a smithy API error code to its kind.
+func ClassifyErrorCode(code string) ErrorKind {
+	switch code {
+	case "AccessDenied", "AccessDeniedException", "UnauthorizedOperation",
+		"UnauthorizedAccess", "AuthorizationError", "Forbidden":
+		return ErrKindAccessDenied
+	case "ExpiredToken", "ExpiredTokenException", "RequestExpired",
+		"InvalidClientTokenId", "UnrecognizedClientException":
+		return ErrKindExpiredToken
+	case "Throttling", "ThrottlingException", "RequestLimitExceeded",
+		"TooManyRequestsException", "SlowDown":
+		return ErrKindThrottling
+	case "NotFound", "NoSuchBucket", "NoSuchKey", "NoSuchEntity",
+		"ResourceNotFoundException", "NotFoundException":
+		return ErrKindNotFound
+	}
+
+	// EC2 reports missing resources as e.g. InvalidInstanceID.NotFound
+	if strings.HasSuffix(code, ".NotFound") {
+		return ErrKindNotFound
+	}
+	return ErrKindOther
+}
+
+// IsSSOTokenError reports whether err comes from a missing, expired or
+// invalid cached SSO token, meaning the user must run "aws sso login".
+// The SSO portal rejects a stale access token with UnauthorizedException and
+// the OIDC service refuses to refresh one with the grant/token exceptions.
+func IsSSOTokenError(err error) bool {
+	var (
+		tokenErr        *ssocreds.InvalidTokenError
+		unauthorizedErr *ssotypes.UnauthorizedException
+		grantErr        *ssooidctypes.InvalidGrantException
+		expiredErr      *ssooidctypes.ExpiredTokenException
+		clientErr       *ssooidctypes.UnauthorizedClientException
+	)
+	return errors.As(err, &tokenErr) ||
+		errors.As(err, &unauthorizedErr) ||
+		errors.As(err, &grantErr) ||
+		errors.As(err, &expiredErr) ||
+		errors.As(err, &clientErr)
+}
+
+// IsExpiredCredentials reports whether err means the credentials are expired
+// or invalid, including temporary STS/MFA sessions and SSO tokens.
+func IsExpiredCredentials(err error) bool {
+	if errors.Is(err, ErrExpiredCredentials) || errors.Is(err, ErrSSOLoginRequired) || IsSSOTokenError(err) {
+		return true
+	}
+
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && ClassifyErrorCode(apiErr.ErrorCode()) == ErrKindExpiredToken
+}
@@ -0,0 +1,39 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	ssotypes "github.com/aws/aws-sdk-go-v2/service/sso/types"
+	ssooidctypes "github.com/aws/aws-sdk-go-v2/service/ssooidc/types"
+)
+
+func TestIsSSOTokenError(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"invalid token": {err: &ssocreds.InvalidTokenError{}, want: true},
+		"portal unauthorized": {
+			err:  fmt.Errorf("operation error SSO: GetRoleCredentials, %w", &ssotypes.UnauthorizedException{}),
+			want: true,
+		},
+		"refresh rejected": {
+			err:  fmt.Errorf("refresh cached SSO token failed, %w", &ssooidctypes.InvalidGrantException{}),
+			want: true,
+		},
+		"refresh token expired": {err: &ssooidctypes.ExpiredTokenException{}, want: true},
+		"mentions sso session":  {err: errors.New("failed to load sso session config"), want: false},
+		"unrelated":             {err: errors.New("connection reset"), want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsSSOTokenError(tt.err); got != tt.want {
+				t.Errorf("IsSSOTokenError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,13 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"gopkg.in/ini.v1"
 )
 
@@ -25,6 +27,52 @@ type Profile struct {
 	AccountID     string
 	RoleARN       string
 	SourceProfile string
+	SSOSession    string
+	SSOStartURL   string
+	SSOAccountID  string
+	SSORoleName   string
+}
+
+// IsSSO reports whether the profile signs in through IAM Identity Center.
+// Credentials for such profiles come from the SDK's cached SSO token.
+func (p *Profile) IsSSO() bool {
+	return p.SSOSession != "" || p.SSOStartURL != "" || p.SSOAccountID != ""
+}
+
+// RoleChain returns the profiles credentials pass through to reach name,
+// starting with the one holding the base credentials (keys or SSO) and
+// ending with name. Each later profile assumes its RoleARN using the
+// credentials of the one before it.
+func RoleChain(settings ProfileSettings, name string) ([]*Profile, error) {
+	var chain []*Profile
+	seen := make(map[string]bool)
+	for {
+		if seen[name] {
+			return nil, fmt.Errorf("source_profile loop at profile %q", name)
+		}
+		seen[name] = true
+
+		p, err := settings.GetProfile(name)
+		if err != nil {
+			return nil, err
+		}
+		chain = append([]*Profile{p}, chain...)
+
+		// A profile naming itself as source uses its own keys for the role
+		if p.RoleARN == "" || p.SourceProfile == "" || p.SourceProfile == p.Name {
+			return chain, nil
+		}
+		name = p.SourceProfile
+	}
+}
+
+// RoleAccount returns the account ID in a role ARN, or "" if it has none.
+func RoleAccount(roleARN string) string {
+	a, err := arn.Parse(roleARN)
+	if err != nil {
+		return ""
+	}
+	return a.AccountID
 }
 
 type ProfileManager struct {
@@ -53,27 +101,70 @@ var commonRegions = []string{
 	"ap-south-1",
 	"ca-central-1",
 	"sa-east-1",
+	"af-south-1",
+	"ap-east-1",
+	"ap-south-2",
+	"ap-southeast-3",
+	"ap-southeast-4",
+	"ap-southeast-5",
+	"ap-southeast-7",
+	"ca-west-1",
+	"eu-central-2",
+	"eu-south-1",
+	"eu-south-2",
+	"il-central-1",
+	"me-central-1",
+	"me-south-1",
+	"mx-central-1",
+	"us-gov-east-1",
+	"us-gov-west-1",
+	"cn-north-1",
+	"cn-northwest-1",
 }
 
 // NewProfileManager creates a new ProfileManager instance and initializes it with profiles
 // from credentials and config files.
+// When no profiles exist it returns an empty, usable manager along with
+// ErrNoProfiles, so callers can start without credentials and Reload later.
 func NewProfileManager() (*ProfileManager, error) {
 	m := &ProfileManager{
 		profiles: make(map[string]*Profile),
 	}
 
+	if err := m.Reload(); err != nil {
+		if errors.Is(err, ErrNoProfiles) {
+			return m, err
+		}
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Reload re-reads profiles from the credentials and config files, e.g. after
+// the user ran "aws configure". The active profile is kept if it still exists.
+func (m *ProfileManager) Reload() error {
 	discovery := NewCredentialDiscovery()
 
 	// Discover all available profiles
 	discoveredProfiles, err := discovery.DiscoverProfiles()
 	if err != nil {
-		return nil, fmt.Errorf("failed to discover profiles: %w", err)
+		return fmt.Errorf("failed to discover profiles: %w", err)
 	}
 
 	if len(discoveredProfiles) == 0 {
-		return nil, fmt.Errorf("no AWS profiles found")
+		m.mx.Lock()
+		m.profiles = make(map[string]*Profile)
+		m.activeProfile = ""
+		m.activeRegion = DefaultRegion
+		if region := EnvRegion(); region != "" {
+			m.activeRegion = region
+		}
+		m.mx.Unlock()
+		return ErrNoProfiles
 	}
 
+	profiles := make(map[string]*Profile, len(discoveredProfiles))
 	// Load profile details
 	for _, profileName := range discoveredProfiles {
 		profile := &Profile{
@@ -84,7 +175,7 @@ func NewProfileManager() (*ProfileManager, error) {
 		// Get credential info for role and source profile information
 		credInfo, err := discovery.GetCredentialInfo(profileName)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get credential info for profile %q: %w", profileName, err)
+			return fmt.Errorf("failed to get credential info for profile %q: %w", profileName, err)
 		}
 
 		profile.RoleARN = credInfo.RoleARN
@@ -98,24 +189,38 @@ func NewProfileManager() (*ProfileManager, error) {
 			profile.DefaultRegion = "us-east-1"
 		}
 
-		m.profiles[profileName] = profile
+		profiles[profileName] = profile
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.profiles = profiles
+	if p, exists := profiles[m.activeProfile]; exists {
+		if m.activeRegion == "" {
+			m.activeRegion = p.DefaultRegion
+		}
+		return nil
 	}
 
-	// Set active profile
+	// Set active profile, honoring AWS_PROFILE and AWS_REGION/AWS_DEFAULT_REGION
 	defaultProfile, err := discovery.GetDefaultProfile()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get default profile: %w", err)
+		return fmt.Errorf("failed to get default profile: %w", err)
 	}
 
 	// Verify default profile exists
-	if _, exists := m.profiles[defaultProfile]; !exists {
-		return nil, fmt.Errorf("default profile %q not found", defaultProfile)
+	if _, exists := profiles[defaultProfile]; !exists {
+		return fmt.Errorf("default profile %q not found", defaultProfile)
 	}
 
 	m.activeProfile = defaultProfile
-	m.activeRegion = m.profiles[defaultProfile].DefaultRegion
+	m.activeRegion = profiles[defaultProfile].DefaultRegion
+	if region := EnvRegion(); region != "" {
+		m.activeRegion = region
+	}
 
-	return m, nil
+	return nil
 }
 
 // loadConfigFile loads profile configuration from the AWS config file.
@@ -165,6 +270,23 @@ func (m *ProfileManager) loadConfigFile(profileName string, profile *Profile) er
 		profile.SourceProfile = section.Key("source_profile").String()
 	}
 
+	// Load IAM Identity Center (SSO) settings
+	if section.HasKey("sso_session") {
+		profile.SSOSession = section.Key("sso_session").String()
+	}
+	if section.HasKey("sso_start_url") {
+		profile.SSOStartURL = section.Key("sso_start_url").String()
+	}
+	if section.HasKey("sso_account_id") {
+		profile.SSOAccountID = section.Key("sso_account_id").String()
+	}
+	if section.HasKey("sso_role_name") {
+		profile.SSORoleName = section.Key("sso_role_name").String()
+	}
+	if profile.AccountID == "" {
+		profile.AccountID = profile.SSOAccountID
+	}
+
 	return nil
 }
 
@@ -244,6 +366,10 @@ func (m *ProfileManager) GetProfile(name string) (*Profile, error) {
 		AccountID:     p.AccountID,
 		RoleARN:       p.RoleARN,
 		SourceProfile: p.SourceProfile,
+		SSOSession:    p.SSOSession,
+		SSOStartURL:   p.SSOStartURL,
+		SSOAccountID:  p.SSOAccountID,
+		SSORoleName:   p.SSORoleName,
 	}
 
 	// Copy regions slice
@@ -168,6 +168,27 @@ func (m *RegionManager) ValidateRegion(region string) error {
 	return nil
 }
 
+// KnownRegions returns the regions a1s knows about, most common first.
+func KnownRegions() []string {
+	result := make([]string, len(commonRegions))
+	copy(result, commonRegions)
+	return result
+}
+
+// CheckRegion returns ErrInvalidRegion unless region is "all" or one of
+// KnownRegions.
+func CheckRegion(region string) error {
+	if region == RegionAll {
+		return nil
+	}
+	for _, r := range commonRegions {
+		if r == region {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrInvalidRegion, region)
+}
+
 // IsGlobalService checks if a service is a global service.
 func IsGlobalService(service string) bool {
 	return GlobalServices[service]
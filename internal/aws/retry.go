@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	// MaxRetryAttempts is the number of tries a call makes before giving up.
+	MaxRetryAttempts = 4
+
+	retryBaseDelay = 200 * time.Millisecond
+	retryMaxDelay  = 5 * time.Second
+)
+
+// newRetryer returns the retryer every service client uses: throttling and
+// 5xx failures are retried with exponential backoff and full jitter, up to
+// MaxRetryAttempts tries in all. The SDK sleeps between attempts with the
+// call's context, so a cancelled or expired context ends the retries.
+func newRetryer() aws.Retryer {
+	return retry.NewStandard(retryOptions)
+}
+
+// retryOptions applies a1s's retry policy to the SDK's standard retryer.
+func retryOptions(o *retry.StandardOptions) {
+	o.MaxAttempts = MaxRetryAttempts
+	o.MaxBackoff = retryMaxDelay
+	o.Backoff = jitterBackoff{}
+	o.Retryables = append(o.Retryables, retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
+		if IsRetryable(err) {
+			return aws.TrueTernary
+		}
+		return aws.UnknownTernary
+	}))
+}
+
+// IsRetryable reports whether err is a throttling or server-side failure.
+func IsRetryable(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && ClassifyErrorCode(apiErr.ErrorCode()) == ErrKindThrottling {
+		return true
+	}
+
+	var respErr interface{ HTTPStatusCode() int }
+	if errors.As(err, &respErr) && respErr.HTTPStatusCode() >= 500 {
+		return true
+	}
+	return false
+}
+
+// jitterBackoff delays retries by backoff.
+type jitterBackoff struct{}
+
+// BackoffDelay returns the delay before the given retry attempt, counted from 1.
+func (jitterBackoff) BackoffDelay(attempt int, _ error) (time.Duration, error) {
+	return backoff(attempt - 1), nil
+}
+
+// backoff returns a random delay up to base*2^attempt, capped at retryMaxDelay.
+func backoff(attempt int) time.Duration {
+	ceiling := retryBaseDelay << attempt
+	if ceiling > retryMaxDelay || ceiling <= 0 {
+		ceiling = retryMaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(ceiling)) + 1)
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// stubSTS answers every request with the same status and body, counting calls.
+type stubSTS struct {
+	status int
+	body   string
+	calls  int
+}
+
+func (s *stubSTS) Do(req *http.Request) (*http.Response, error) {
+	s.calls++
+	return &http.Response{
+		StatusCode: s.status,
+		Header:     http.Header{"Content-Type": {"text/xml"}},
+		Body:       io.NopCloser(strings.NewReader(s.body)),
+		Request:    req,
+	}, nil
+}
+
+func stsError(code string) string {
+	return `<ErrorResponse><Error><Type>Sender</Type><Code>` + code +
+		`</Code><Message>test</Message></Error><RequestId>1</RequestId></ErrorResponse>`
+}
+
+// noDelay retries at once so tests don't sleep.
+type noDelay struct{}
+
+func (noDelay) BackoffDelay(int, error) (time.Duration, error) { return 0, nil }
+
+func newTestSTS(stub *stubSTS) *sts.Client {
+	return sts.NewFromConfig(aws.Config{
+		Region:      DefaultRegion,
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:  stub,
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(retryOptions, func(o *retry.StandardOptions) {
+				o.Backoff = noDelay{}
+			})
+		},
+	})
+}
+
+func TestRetryerGivesUp(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		code   string
+		calls  int
+	}{
+		"throttling":        {status: http.StatusBadRequest, code: "Throttling", calls: MaxRetryAttempts},
+		"request limit":     {status: http.StatusBadRequest, code: "RequestLimitExceeded", calls: MaxRetryAttempts},
+		"server error":      {status: http.StatusServiceUnavailable, code: "ServiceUnavailable", calls: MaxRetryAttempts},
+		"access denied":     {status: http.StatusForbidden, code: "AccessDenied", calls: 1},
+		"invalid parameter": {status: http.StatusBadRequest, code: "ValidationError", calls: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stub := &stubSTS{status: tt.status, body: stsError(tt.code)}
+			_, err := newTestSTS(stub).GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if stub.calls != tt.calls {
+				t.Errorf("got %d attempts, want %d", stub.calls, tt.calls)
+			}
+		})
+	}
+}
+
+func TestRetryerStopsOnCancel(t *testing.T) {
+	stub := &stubSTS{status: http.StatusBadRequest, body: stsError("Throttling")}
+	client := sts.NewFromConfig(aws.Config{
+		Region:      DefaultRegion,
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:  stub,
+		Retryer:     newRetryer,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("retries ran for %s past a 50ms deadline", elapsed)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		ceiling := retryBaseDelay << attempt
+		if ceiling > retryMaxDelay {
+			ceiling = retryMaxDelay
+		}
+		for i := 0; i < 100; i++ {
+			if d := backoff(attempt); d <= 0 || d > ceiling {
+				t.Fatalf("attempt %d: delay %s outside (0, %s]", attempt, d, ceiling)
+			}
+		}
+	}
+
+	if d, _ := (jitterBackoff{}).BackoffDelay(1, nil); d > retryBaseDelay {
+		t.Errorf("first retry delay %s exceeds %s", d, retryBaseDelay)
+	}
+}
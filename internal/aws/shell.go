@@ -5,19 +5,63 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"os/signal"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
 
+// ErrNoPublicIP reports an instance that cannot be reached directly.
+var ErrNoPublicIP = errors.New("no public IP")
+
+// sshUsersByOwner maps the account IDs of well-known AMI publishers to the
+// login of their images.
+var sshUsersByOwner = map[string]string{
+	"099720109477": "ubuntu",   // Canonical
+	"136693071363": "admin",    // Debian
+	"075585003325": "core",     // Flatcar
+	"137112412989": "ec2-user", // Amazon Linux
+	"309956199498": "ec2-user", // Red Hat
+	"013907871322": "ec2-user", // SUSE
+}
+
+// sshUsersByName maps AMI name or description fragments to logins, checked
+// in order.
+var sshUsersByName = []struct {
+	fragment, user string
+}{
+	{"ubuntu", "ubuntu"},
+	{"debian", "admin"},
+	{"flatcar", "core"},
+	{"coreos", "core"},
+	{"centos", "centos"},
+	{"fedora", "fedora"},
+	{"rocky", "rocky"},
+	{"bitnami", "bitnami"},
+	{"amzn", "ec2-user"},
+	{"al2023", "ec2-user"},
+	{"amazon linux", "ec2-user"},
+	{"rhel", "ec2-user"},
+	{"red hat", "ec2-user"},
+	{"suse", "ec2-user"},
+	{"sles", "ec2-user"},
+	{"almalinux", "ec2-user"},
+}
+
 // SSHConfig holds SSH connection configuration.
 type SSHConfig struct {
 	User    string // SSH user (ec2-user, ubuntu, etc.)
 	KeyPath string // Path to SSH private key
 	Port    int    // SSH port (default 22)
+
+	// ProxyJump is the bastion to connect through, as [user@]host[:port].
+	// Empty connects directly.
+	ProxyJump string
 }
 
 // DefaultSSHConfig returns default SSH configuration.
@@ -42,7 +86,7 @@ func GetInstancePublicIP(ctx context.Context, client *ec2.Client, instanceID str
 
 	instance := output.Reservations[0].Instances[0]
 	if instance.PublicIpAddress == nil {
-		return "", fmt.Errorf("instance %s has no public IP", instanceID)
+		return "", fmt.Errorf("instance %s has %w", instanceID, ErrNoPublicIP)
 	}
 
 	return *instance.PublicIpAddress, nil
@@ -69,61 +113,59 @@ func GetInstancePrivateIP(ctx context.Context, client *ec2.Client, instanceID st
 	return *instance.PrivateIpAddress, nil
 }
 
-// DetectSSHUser attempts to detect the SSH username based on the instance's AMI.
-// Common defaults:
-// - Amazon Linux: ec2-user
-// - Ubuntu: ubuntu
-// - CentOS: centos
-// - RHEL: ec2-user
-// - Debian: admin
-// - SUSE: ec2-user
-func DetectSSHUser(ctx context.Context, client *ec2.Client, instanceID string) string {
+// DetectSSHUser detects the SSH login of an instance from its AMI: the
+// platform, then the publisher account, then the image name and description.
+// ok is false when the AMI cannot be described or is not a known Linux
+// distribution, so the caller should fall back to a configured or
+// user-provided login.
+func DetectSSHUser(ctx context.Context, client *ec2.Client, instanceID string) (user string, ok bool) {
 	output, err := client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
 		InstanceIds: []string{instanceID},
 	})
 	if err != nil || len(output.Reservations) == 0 || len(output.Reservations[0].Instances) == 0 {
-		return "ec2-user" // default
+		return "", false
 	}
 
 	instance := output.Reservations[0].Instances[0]
 	if instance.ImageId == nil {
-		return "ec2-user"
+		return "", false
 	}
 
-	// Try to get AMI details
 	amiOutput, err := client.DescribeImages(ctx, &ec2.DescribeImagesInput{
 		ImageIds: []string{*instance.ImageId},
 	})
 	if err != nil || len(amiOutput.Images) == 0 {
-		return "ec2-user"
-	}
-
-	image := amiOutput.Images[0]
-	name := ""
-	if image.Name != nil {
-		name = strings.ToLower(*image.Name)
-	}
-	desc := ""
-	if image.Description != nil {
-		desc = strings.ToLower(*image.Description)
-	}
-
-	// Check for known patterns
-	combined := name + " " + desc
-	switch {
-	case strings.Contains(combined, "ubuntu"):
-		return "ubuntu"
-	case strings.Contains(combined, "debian"):
-		return "admin"
-	case strings.Contains(combined, "centos"):
-		return "centos"
-	case strings.Contains(combined, "fedora"):
-		return "fedora"
-	case strings.Contains(combined, "suse"):
-		return "ec2-user"
-	default:
-		return "ec2-user"
+		return "", false
+	}
+
+	return sshUserForImage(amiOutput.Images[0])
+}
+
+// sshUserForImage returns the login of a known Linux AMI.
+func sshUserForImage(image types.Image) (string, bool) {
+	if image.Platform == types.PlatformValuesWindows {
+		return "", false
+	}
+
+	if image.OwnerId != nil {
+		if user, ok := sshUsersByOwner[*image.OwnerId]; ok {
+			return user, true
+		}
+	}
+
+	combined := ""
+	for _, s := range []*string{image.Name, image.Description, image.PlatformDetails} {
+		if s != nil {
+			combined += " " + strings.ToLower(*s)
+		}
 	}
+	for _, n := range sshUsersByName {
+		if strings.Contains(combined, n.fragment) {
+			return n.user, true
+		}
+	}
+
+	return "", false
 }
 
 // BuildSSHCommand builds the SSH command arguments.
@@ -138,6 +180,10 @@ func BuildSSHCommand(ip string, cfg *SSHConfig) []string {
 		args = append(args, "-p", fmt.Sprintf("%d", cfg.Port))
 	}
 
+	if cfg.ProxyJump != "" {
+		args = append(args, "-J", cfg.ProxyJump)
+	}
+
 	// Add common options
 	args = append(args, "-o", "StrictHostKeyChecking=accept-new")
 
@@ -170,6 +216,54 @@ func ExecSSH(ip string, cfg *SSHConfig) error {
 	return cmd.Run()
 }
 
+// BuildSSMPortForwardCommand builds the AWS SSM start-session arguments that
+// forward localPort to remotePort on the instance.
+func BuildSSMPortForwardCommand(instanceID, region string, remotePort, localPort int) []string {
+	args := []string{
+		"ssm", "start-session", "--target", instanceID,
+		"--document-name", "AWS-StartPortForwardingSession",
+		"--parameters", fmt.Sprintf("portNumber=%d,localPortNumber=%d", remotePort, localPort),
+	}
+	if region != "" {
+		args = append(args, "--region", region)
+	}
+	return args
+}
+
+// ExecSSMPortForward spawns an SSM port-forwarding session, which runs until
+// interrupted. The AWS CLI hands it to the session-manager-plugin, so both
+// must be installed. This should be called with TUI suspended.
+func ExecSSMPortForward(instanceID, region string, remotePort, localPort int) error {
+	if _, err := exec.LookPath("session-manager-plugin"); err != nil {
+		return fmt.Errorf("session-manager-plugin not found: %w", err)
+	}
+
+	// Ctrl-C ends the session; keep it from ending a1s too.
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	defer signal.Stop(sigs)
+
+	args := BuildSSMPortForwardCommand(instanceID, region, remotePort, localPort)
+	cmd := exec.Command("aws", args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil && !interrupted(sigs) {
+		return err
+	}
+	return nil
+}
+
+// interrupted reports whether an interrupt was received on sigs.
+func interrupted(sigs <-chan os.Signal) bool {
+	select {
+	case <-sigs:
+		return true
+	default:
+		return false
+	}
+}
+
 // ExecSSM spawns an SSM session. This should be called with TUI suspended.
 func ExecSSM(instanceID, region string) error {
 	args := BuildSSMCommand(instanceID, region)
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+const (
+	// ssmPollInterval is how often a running command's invocation is checked.
+	ssmPollInterval = 2 * time.Second
+	// ssmRunShellScript is the SSM document that runs shell commands.
+	ssmRunShellScript = "AWS-RunShellScript"
+)
+
+// SSMCommandResult holds the outcome of a command run on one instance.
+// SSM truncates the captured output to 24000 characters.
+type SSMCommandResult struct {
+	InstanceID   string
+	CommandID    string
+	Status       string // Success, Failed, TimedOut, Cancelled or DryRun
+	ResponseCode int
+	Stdout       string
+	Stderr       string
+}
+
+// OK reports whether the command ran and exited zero.
+func (r *SSMCommandResult) OK() bool {
+	return r.Status == "Success"
+}
+
+// RunSSMCommand runs a shell command on an SSM-managed instance with
+// AWS-RunShellScript and waits for it to finish. Under a dry run the
+// command is not sent.
+func (c *APIClient) RunSSMCommand(ctx context.Context, region, instanceID, command string) (*SSMCommandResult, error) {
+	if IsDryRun(ctx) {
+		return &SSMCommandResult{InstanceID: instanceID, Status: "DryRun"}, nil
+	}
+
+	clients, err := c.getClients(region)
+	if err != nil {
+		return nil, err
+	}
+
+	// SendCommand is not retried: a retry after a lost response would run
+	// the command twice.
+	out, err := clients.ssmClient.SendCommand(ctx, &ssm.SendCommandInput{
+		InstanceIds:  []string{instanceID},
+		DocumentName: aws.String(ssmRunShellScript),
+		Parameters:   map[string][]string{"commands": {command}},
+		Comment:      aws.String("a1s run command"),
+	}, func(o *ssm.Options) {
+		o.RetryMaxAttempts = 1
+	})
+	if err != nil {
+		return nil, WrapAWSError(err, "SendCommand")
+	}
+	if out.Command == nil {
+		return nil, fmt.Errorf("SendCommand returned no command for %s", instanceID)
+	}
+
+	return c.waitSSMCommand(ctx, clients.ssmClient, aws.ToString(out.Command.CommandId), instanceID)
+}
+
+// waitSSMCommand polls a command's invocation until it settles.
+func (c *APIClient) waitSSMCommand(ctx context.Context, client *ssm.Client, commandID, instanceID string) (*SSMCommandResult, error) {
+	in := &ssm.GetCommandInvocationInput{
+		CommandId:  aws.String(commandID),
+		InstanceId: aws.String(instanceID),
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("command %s on %s: %w", commandID, instanceID, ctx.Err())
+		case <-time.After(ssmPollInterval):
+		}
+
+		out, err := client.GetCommandInvocation(ctx, in)
+		var notYet *types.InvocationDoesNotExist
+		if errors.As(err, &notYet) {
+			// The invocation shows up shortly after SendCommand returns.
+			continue
+		}
+		if err != nil {
+			return nil, WrapAWSError(err, "GetCommandInvocation")
+		}
+
+		switch out.Status {
+		case types.CommandInvocationStatusPending, types.CommandInvocationStatusInProgress,
+			types.CommandInvocationStatusDelayed, types.CommandInvocationStatusCancelling:
+			continue
+		}
+		return &SSMCommandResult{
+			InstanceID:   instanceID,
+			CommandID:    commandID,
+			Status:       string(out.Status),
+			ResponseCode: int(out.ResponseCode),
+			Stdout:       aws.ToString(out.StandardOutputContent),
+			Stderr:       aws.ToString(out.StandardErrorContent),
+		}, nil
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// SSMInstanceInfo describes a node registered with SSM, as reported by
+// DescribeInstanceInformation.
+type SSMInstanceInfo struct {
+	InstanceID      string     `json:"InstanceId"`
+	ComputerName    string     `json:"ComputerName"`
+	PingStatus      string     `json:"PingStatus"` // Online, ConnectionLost or Inactive
+	LastPing        *time.Time `json:"LastPingDateTime,omitempty"`
+	AgentVersion    string     `json:"AgentVersion"`
+	IsLatestVersion bool       `json:"IsLatestVersion"`
+	PlatformType    string     `json:"PlatformType"`
+	PlatformName    string     `json:"PlatformName"`
+	PlatformVersion string     `json:"PlatformVersion"`
+	ResourceType    string     `json:"ResourceType"`
+	IPAddress       string     `json:"IPAddress"`
+	IAMRole         string     `json:"IamRole"`
+}
+
+// Online reports whether the agent is reachable, so SSM sessions and
+// commands can be used.
+func (i *SSMInstanceInfo) Online() bool {
+	return i.PingStatus == string(types.PingStatusOnline)
+}
+
+// DescribeInstanceInformation lists the nodes whose SSM agent is registered
+// in a region. Instances without a registered agent are not listed. Passing
+// instanceIDs narrows the call to those nodes instead of the whole fleet.
+func (c *APIClient) DescribeInstanceInformation(ctx context.Context, region string, instanceIDs ...string) ([]SSMInstanceInfo, error) {
+	clients, err := c.getClients(region)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []SSMInstanceInfo
+	in := &ssm.DescribeInstanceInformationInput{MaxResults: aws.Int32(50)}
+	if len(instanceIDs) > 0 {
+		in.Filters = []types.InstanceInformationStringFilter{
+			{Key: aws.String("InstanceIds"), Values: instanceIDs},
+		}
+	}
+	for {
+		out, err := clients.ssmClient.DescribeInstanceInformation(ctx, in)
+		if err != nil {
+			return nil, WrapAWSError(err, "DescribeInstanceInformation")
+		}
+
+		for _, info := range out.InstanceInformationList {
+			infos = append(infos, ssmInstanceInfo(info))
+		}
+		if aws.ToString(out.NextToken) == "" {
+			return infos, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// ssmInstanceInfo converts SDK instance information to SSMInstanceInfo.
+func ssmInstanceInfo(info types.InstanceInformation) SSMInstanceInfo {
+	return SSMInstanceInfo{
+		InstanceID:      aws.ToString(info.InstanceId),
+		ComputerName:    aws.ToString(info.ComputerName),
+		PingStatus:      string(info.PingStatus),
+		LastPing:        info.LastPingDateTime,
+		AgentVersion:    aws.ToString(info.AgentVersion),
+		IsLatestVersion: aws.ToBool(info.IsLatestVersion),
+		PlatformType:    string(info.PlatformType),
+		PlatformName:    aws.ToString(info.PlatformName),
+		PlatformVersion: aws.ToString(info.PlatformVersion),
+		ResourceType:    string(info.ResourceType),
+		IPAddress:       aws.ToString(info.IPAddress),
+		IAMRole:         aws.ToString(info.IamRole),
+	}
+}
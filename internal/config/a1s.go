@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
@@ -18,13 +19,19 @@ const (
 type A1s struct {
 	RefreshRate    float32     `yaml:"refreshRate"`
 	APITimeout     string      `yaml:"apiTimeout"`
+	Timeouts       Timeouts    `yaml:"timeouts"`
 	ReadOnly       bool        `yaml:"readOnly"`
 	DefaultView    string      `yaml:"defaultView"`
 	DefaultProfile string      `yaml:"defaultProfile"`
 	DefaultRegion  string      `yaml:"defaultRegion"`
 	UI             data.UI     `yaml:"ui"`
+	SSH            data.SSH    `yaml:"ssh"`
 	Logger         data.Logger `yaml:"logger"`
 
+	// DryRun rehearses destructive operations instead of running them.
+	// Set from --dry-run and never saved.
+	DryRun bool `yaml:"-"`
+
 	// Internal state (not serialized)
 	activeProfile string
 	activeRegion  string
@@ -38,6 +45,7 @@ func NewA1s() *A1s {
 	return &A1s{
 		RefreshRate: DefaultRefreshRate,
 		APITimeout:  DefaultAPITimeout.String(),
+		Timeouts:    NewTimeouts(),
 		ReadOnly:    false,
 		DefaultView: DefaultView,
 		dir:         data.NewDir(),
@@ -56,6 +64,7 @@ func (a *A1s) Validate() {
 	if a.APITimeout == "" {
 		a.APITimeout = DefaultAPITimeout.String()
 	}
+	a.Timeouts.Validate()
 
 	if a.DefaultView == "" {
 		a.DefaultView = DefaultView
@@ -175,6 +184,14 @@ func (a *A1s) Override(flags *data.Flags) {
 	if flags.Region != nil && *flags.Region != "" {
 		a.DefaultRegion = *flags.Region
 	}
+
+	if (flags.NoColor != nil && *flags.NoColor) || os.Getenv(NoColorEnv) != "" {
+		a.UI.NoColor = true
+	}
+
+	if flags.DryRun != nil {
+		a.DryRun = *flags.DryRun
+	}
 }
 
 // GetAPITimeout returns the parsed API timeout duration.
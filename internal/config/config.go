@@ -14,9 +14,24 @@ type Config struct {
 	A1s      *A1s             `yaml:"a1s"`
 	conn     aws.Connection
 	settings aws.ProfileSettings
-	mx       sync.RWMutex
+	state    *State
+	warnings []string
+
+	profileOrigin string
+	regionOrigin  string
+
+	mx sync.RWMutex
 }
 
+// Where Refine found the active profile and region.
+const (
+	OriginFlag    = "flag"
+	OriginEnv     = "env"
+	OriginSession = "last session"
+	OriginConfig  = "config"
+	OriginDefault = "default"
+)
+
 // NewConfig creates a new Config with the given profile settings.
 func NewConfig(settings aws.ProfileSettings) *Config {
 	return &Config{
@@ -81,10 +96,92 @@ func (c *Config) Save(force bool) error {
 	return nil
 }
 
+// LoadState loads the persisted session state used by Refine to restore
+// the last profile and region.
+func (c *Config) LoadState(path string) error {
+	state, err := LoadState(path)
+	if err != nil {
+		return err
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.state = state
+	return nil
+}
+
+// SaveState persists the given profile and region as the last session,
+// along with the command history. An empty profile or region keeps the
+// previously saved one.
+func (c *Config) SaveState(profile, region string) error {
+	c.mx.RLock()
+	if c.state != nil {
+		if profile == "" {
+			profile = c.state.LastProfile
+		}
+		if region == "" {
+			region = c.state.LastRegion
+		}
+	}
+	c.mx.RUnlock()
+
+	state := &State{
+		LastProfile:    profile,
+		LastRegion:     region,
+		CommandHistory: c.CommandHistory(),
+	}
+	if err := state.Save(AppStateFile); err != nil {
+		return err
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.state = state
+	return nil
+}
+
+// CommandHistory returns the persisted command history, oldest first.
+func (c *Config) CommandHistory() []string {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	if c.state == nil {
+		return nil
+	}
+	return c.state.CommandHistory
+}
+
+// SetCommandHistory sets the command history to persist with the session state.
+func (c *Config) SetCommandHistory(cmds []string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.state == nil {
+		c.state = &State{}
+	}
+	c.state.CommandHistory = cmds
+}
+
+// Warnings returns non-fatal issues found while refining the configuration.
+func (c *Config) Warnings() []string {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	return c.warnings
+}
+
+// Origins reports where Refine found the active profile and region, e.g.
+// OriginEnv when AWS_PROFILE was set. Both are empty before Refine runs.
+func (c *Config) Origins() (profile, region string) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	return c.profileOrigin, c.regionOrigin
+}
+
 // Refine applies CLI flags and AWS settings to determine the final configuration.
 // This implements the configuration precedence logic:
-// - Profile: CLI --profile > config defaultProfile > AWS default
-// - Region: CLI --all-regions > CLI --region > profile config > profile default
+// - Profile: CLI --profile > AWS_PROFILE > last session > config defaultProfile > AWS default
+// - Region: CLI --all-regions > CLI --region > AWS_REGION/AWS_DEFAULT_REGION > last session > config defaultRegion > profile default
+// Where each value came from is recorded for Origins.
 func (c *Config) Refine(flags *data.Flags, settings aws.ProfileSettings) error {
 	c.mx.Lock()
 	defer c.mx.Unlock()
@@ -96,19 +193,23 @@ func (c *Config) Refine(flags *data.Flags, settings aws.ProfileSettings) error {
 	// Update settings
 	c.settings = settings
 
-	// Determine profile using precedence: CLI > config default > AWS default
-	profile := ""
+	// Determine profile using precedence
+	profile, restored := "", false
 	if flags != nil && flags.Profile != nil && *flags.Profile != "" {
-		profile = *flags.Profile
+		profile, c.profileOrigin = *flags.Profile, OriginFlag
+	} else if env := aws.EnvProfile(); env != "" {
+		profile, c.profileOrigin = env, OriginEnv
+	} else if last := c.lastProfile(settings); last != "" {
+		profile, restored, c.profileOrigin = last, true, OriginSession
 	} else if c.A1s.DefaultProfile != "" {
-		profile = c.A1s.DefaultProfile
+		profile, c.profileOrigin = c.A1s.DefaultProfile, OriginConfig
 	} else {
 		// Use AWS default profile
 		awsDefault, err := settings.CurrentProfileName()
 		if err != nil {
 			return fmt.Errorf("failed to get AWS default profile: %w", err)
 		}
-		profile = awsDefault
+		profile, c.profileOrigin = awsDefault, OriginDefault
 	}
 
 	// Verify profile exists
@@ -121,20 +222,25 @@ func (c *Config) Refine(flags *data.Flags, settings aws.ProfileSettings) error {
 	region := ""
 	if flags != nil && flags.AllRegions != nil && *flags.AllRegions {
 		// --all-regions takes highest precedence, sets to "all"
-		region = "all"
+		region, c.regionOrigin = "all", OriginFlag
 	} else if flags != nil && flags.Region != nil && *flags.Region != "" {
 		// CLI --region takes next precedence
-		region = *flags.Region
+		region, c.regionOrigin = *flags.Region, OriginFlag
+	} else if env := aws.EnvRegion(); env != "" {
+		region, c.regionOrigin = env, OriginEnv
+	} else if restored && c.state.LastRegion != "" {
+		// Last session's region, only alongside its profile
+		region, c.regionOrigin = c.state.LastRegion, OriginSession
 	} else if c.A1s.DefaultRegion != "" {
 		// Config default region
-		region = c.A1s.DefaultRegion
+		region, c.regionOrigin = c.A1s.DefaultRegion, OriginConfig
 	} else {
 		// Fall back to profile's default region
 		profileData, err := settings.GetProfile(profile)
 		if err != nil {
 			return fmt.Errorf("failed to get profile data: %w", err)
 		}
-		region = profileData.DefaultRegion
+		region, c.regionOrigin = profileData.DefaultRegion, OriginDefault
 	}
 
 	// Ensure region is set
@@ -155,6 +261,26 @@ func (c *Config) Refine(flags *data.Flags, settings aws.ProfileSettings) error {
 	return nil
 }
 
+// lastProfile returns the last session's profile if it still exists.
+// A stale profile records a warning and yields an empty string.
+func (c *Config) lastProfile(settings aws.ProfileSettings) string {
+	if c.state == nil || c.state.LastProfile == "" {
+		return ""
+	}
+
+	names, err := settings.ProfileNames()
+	if err != nil {
+		return ""
+	}
+	if _, ok := names[c.state.LastProfile]; !ok {
+		c.warnings = append(c.warnings,
+			fmt.Sprintf("Saved profile %q no longer exists, using default", c.state.LastProfile))
+		return ""
+	}
+
+	return c.state.LastProfile
+}
+
 // Connection returns the AWS connection.
 func (c *Config) Connection() aws.Connection {
 	c.mx.RLock()
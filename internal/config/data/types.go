@@ -27,6 +27,9 @@ type Flags struct {
 	Profile     *string  // AWS profile to use
 	Region      *string  // AWS region to use
 	AllRegions  *bool    // Query all regions
+	EndpointURL *string  // Custom AWS endpoint URL (e.g. LocalStack)
+	NoColor     *bool    // Render without colors
+	DryRun      *bool    // Rehearse destructive operations
 }
 
 // UI represents user interface configuration settings.
@@ -35,7 +38,28 @@ type UI struct {
 	Headless    bool   `yaml:"headless"`
 	Logoless    bool   `yaml:"logoless"`
 	Crumbsless  bool   `yaml:"crumbsless"`
-	Skin        string `yaml:"skin"`
+	Skin        string `yaml:"skin"` // default, light, no-color or a file in the skins directory
+
+	// FuzzyCommands also suggests commands matching the typed letters in order.
+	FuzzyCommands bool `yaml:"fuzzyCommands"`
+
+	// FlashDelay is how long info and warning messages stay, e.g. "10s".
+	// Errors stay until dismissed or replaced.
+	FlashDelay string `yaml:"flashDelay"`
+
+	// NoColor renders plain text. Set from --no-color or NO_COLOR and
+	// never saved.
+	NoColor bool `yaml:"-"`
+}
+
+// SSH represents SSH connection settings.
+type SSH struct {
+	// User is the login used when it cannot be detected from the AMI.
+	User string `yaml:"user"`
+
+	// Bastion is the jump host, as [user@]host[:port], used to reach
+	// instances that only have a private IP.
+	Bastion string `yaml:"bastion"`
 }
 
 // Logger represents logging configuration settings.
@@ -65,5 +89,8 @@ func NewFlags() *Flags {
 		Profile:     new(string),
 		Region:      new(string),
 		AllRegions:  new(bool),
+		EndpointURL: new(string),
+		NoColor:     new(bool),
+		DryRun:      new(bool),
 	}
 }
@@ -28,6 +28,9 @@ var (
 	// AppAliasesFile is ~/.config/a1s/aliases.yaml
 	AppAliasesFile string
 
+	// AppViewsFile is ~/.config/a1s/views.yaml
+	AppViewsFile string
+
 	// AppSkinsDir is ~/.config/a1s/skins
 	AppSkinsDir string
 
@@ -39,6 +42,9 @@ var (
 
 	// AppDumpsDir is ~/.local/state/a1s/screen-dumps
 	AppDumpsDir string
+
+	// AppStateFile is ~/.local/state/a1s/state.yaml
+	AppStateFile string
 )
 
 // InitLocs initializes all application directory paths.
@@ -71,12 +77,14 @@ func InitLocs() error {
 	AppConfigFile = filepath.Join(AppConfigDir, "a1s.yaml")
 	AppHotkeysFile = filepath.Join(AppConfigDir, "hotkeys.yaml")
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
+	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
 	AppSkinsDir = filepath.Join(AppConfigDir, "skins")
 
 	// Set data and state directories
 	AppProfilesDir = filepath.Join(AppDataDir, "profiles")
 	AppLogFile = filepath.Join(AppStateDir, "a1s.log")
 	AppDumpsDir = filepath.Join(AppStateDir, "screen-dumps")
+	AppStateFile = filepath.Join(AppStateDir, "state.yaml")
 
 	// Set default profiles directory in data package to avoid circular import
 	data.SetDefaultProfilesDir(AppProfilesDir)
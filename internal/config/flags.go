@@ -10,6 +10,10 @@ const DefaultRefreshRate = 2.0
 // DefaultLogLevel is the default logging level.
 const DefaultLogLevel = "info"
 
+// NoColorEnv disables colors when set to any non-empty value, see
+// https://no-color.org.
+const NoColorEnv = "NO_COLOR"
+
 // NewFlags creates a new Flags instance with default values set.
 func NewFlags() *data.Flags {
 	refreshRate := float32(DefaultRefreshRate)
@@ -22,6 +26,9 @@ func NewFlags() *data.Flags {
 	profile := ""
 	region := ""
 	allRegions := false
+	endpointURL := ""
+	noColor := false
+	dryRun := false
 
 	return &data.Flags{
 		RefreshRate: &refreshRate,
@@ -34,6 +41,9 @@ func NewFlags() *data.Flags {
 		Profile:     &profile,
 		Region:      &region,
 		AllRegions:  &allRegions,
+		EndpointURL: &endpointURL,
+		NoColor:     &noColor,
+		DryRun:      &dryRun,
 	}
 }
 
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// kubeconfigLists are the named entry lists merged between kubeconfigs.
+var kubeconfigLists = []string{"clusters", "contexts", "users"}
+
+// KubeconfigPath returns the kubeconfig file to merge into: the first entry
+// of $KUBECONFIG if set, otherwise ~/.kube/config.
+func KubeconfigPath() string {
+	if env := os.Getenv("KUBECONFIG"); env != "" {
+		if paths := filepath.SplitList(env); len(paths) > 0 && paths[0] != "" {
+			return paths[0]
+		}
+	}
+	return filepath.Join(userHomeDir(), ".kube", "config")
+}
+
+// MergeKubeconfig merges the clusters, contexts and users of kubeconfig into the
+// file at path, replacing entries of the same name and keeping all others.
+// The file is created if missing. The merged file's current context is switched
+// to the new one, which is returned.
+func MergeKubeconfig(path string, kubeconfig []byte) (string, error) {
+	src, err := parseKubeconfig(kubeconfig)
+	if err != nil {
+		return "", fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+
+	current := scalarValue(src, "current-context")
+	if current == "" {
+		return "", fmt.Errorf("kubeconfig has no current context")
+	}
+
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	dst, err := parseKubeconfig(existing)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if len(dst.Content) == 0 {
+		dst = src
+	} else {
+		for _, key := range kubeconfigLists {
+			mergeNamedList(dst, src, key)
+		}
+		setScalar(dst, "current-context", current)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(dst); err != nil {
+		return "", fmt.Errorf("failed to encode kubeconfig: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return current, nil
+}
+
+// parseKubeconfig returns the top-level mapping node of a kubeconfig.
+// Empty input yields an empty mapping.
+func parseKubeconfig(raw []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("expected a mapping at the top level")
+	}
+	return root, nil
+}
+
+// mappingValue returns the value node for key in a mapping node.
+func mappingValue(m *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// scalarValue returns the scalar value for key, or "" if absent.
+func scalarValue(m *yaml.Node, key string) string {
+	if v := mappingValue(m, key); v != nil && v.Kind == yaml.ScalarNode {
+		return v.Value
+	}
+	return ""
+}
+
+// setScalar sets key to a string value, adding it if absent.
+func setScalar(m *yaml.Node, key, value string) {
+	if v := mappingValue(m, key); v != nil {
+		v.Kind, v.Tag, v.Value, v.Content = yaml.ScalarNode, "!!str", value, nil
+		return
+	}
+	m.Content = append(m.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value},
+	)
+}
+
+// mergeNamedList merges the src list at key into dst, matching entries by name.
+func mergeNamedList(dst, src *yaml.Node, key string) {
+	srcList := mappingValue(src, key)
+	if srcList == nil || srcList.Kind != yaml.SequenceNode {
+		return
+	}
+
+	dstList := mappingValue(dst, key)
+	if dstList == nil || dstList.Kind != yaml.SequenceNode {
+		if dstList == nil {
+			dstList = &yaml.Node{}
+			dst.Content = append(dst.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, dstList)
+		}
+		// A null or malformed list is replaced outright
+		*dstList = yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	}
+
+	for _, entry := range srcList.Content {
+		name := scalarValue(entry, "name")
+		replaced := false
+		for i, existing := range dstList.Content {
+			if name != "" && scalarValue(existing, "name") == name {
+				dstList.Content[i] = entry
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			dstList.Content = append(dstList.Content, entry)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/a1s/a1s/internal/config/data"
+)
+
+// State holds session state persisted across runs.
+type State struct {
+	LastProfile string `yaml:"lastProfile"`
+	LastRegion  string `yaml:"lastRegion"`
+
+	// CommandHistory holds recently executed commands, oldest first.
+	CommandHistory []string `yaml:"commandHistory,omitempty"`
+}
+
+// LoadState loads the session state from the given path.
+// A missing file yields an empty state.
+func LoadState(path string) (*State, error) {
+	s := &State{}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return s, nil
+	}
+
+	if err := data.LoadYAML(path, s); err != nil {
+		return nil, fmt.Errorf("failed to load state from %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Save writes the session state to the given path.
+func (s *State) Save(path string) error {
+	if path == "" {
+		return fmt.Errorf("no state file path configured")
+	}
+
+	if err := data.SaveYAML(path, s); err != nil {
+		return fmt.Errorf("failed to save state to %s: %w", path, err)
+	}
+
+	return nil
+}
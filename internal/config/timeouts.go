@@ -0,0 +1,101 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Timeout kinds, one per class of AWS operation.
+const (
+	TimeoutList     = "list"
+	TimeoutGet      = "get"
+	TimeoutAction   = "action"
+	TimeoutTransfer = "transfer"
+)
+
+// Default timeouts per operation kind.
+const (
+	DefaultListTimeout     = 30 * time.Second
+	DefaultGetTimeout      = 30 * time.Second
+	DefaultActionTimeout   = 2 * time.Minute
+	DefaultTransferTimeout = 5 * time.Minute
+)
+
+// Timeouts bounds how long AWS calls may run, by kind of operation.
+// Values are Go durations such as "45s" or "2m".
+type Timeouts struct {
+	List     string `yaml:"list"`
+	Get      string `yaml:"get"`
+	Action   string `yaml:"action"`
+	Transfer string `yaml:"transfer"`
+}
+
+// NewTimeouts returns the default timeouts.
+func NewTimeouts() Timeouts {
+	return Timeouts{
+		List:     DefaultListTimeout.String(),
+		Get:      DefaultGetTimeout.String(),
+		Action:   DefaultActionTimeout.String(),
+		Transfer: DefaultTransferTimeout.String(),
+	}
+}
+
+// TimeoutKinds lists the valid timeout kinds.
+func TimeoutKinds() []string {
+	return []string{TimeoutList, TimeoutGet, TimeoutAction, TimeoutTransfer}
+}
+
+// Validate replaces missing or unparsable timeouts with their defaults.
+func (t *Timeouts) Validate() {
+	defaults := NewTimeouts()
+	for _, kind := range TimeoutKinds() {
+		field, def := t.field(kind), defaults.field(kind)
+		if d, err := time.ParseDuration(*field); err != nil || d <= 0 {
+			*field = *def
+		}
+	}
+}
+
+// Duration returns the timeout for a kind, falling back to its default.
+func (t Timeouts) Duration(kind string) time.Duration {
+	field := t.field(kind)
+	if field == nil {
+		return DefaultAPITimeout
+	}
+	if d, err := time.ParseDuration(*field); err == nil && d > 0 {
+		return d
+	}
+
+	defaults := NewTimeouts()
+	d, _ := time.ParseDuration(*defaults.field(kind))
+	return d
+}
+
+// Set changes the timeout for a kind.
+func (t *Timeouts) Set(kind string, d time.Duration) error {
+	if d <= 0 {
+		return fmt.Errorf("timeout must be positive")
+	}
+	field := t.field(kind)
+	if field == nil {
+		return fmt.Errorf("unknown timeout %q (valid: %s)", kind, strings.Join(TimeoutKinds(), ", "))
+	}
+	*field = d.String()
+	return nil
+}
+
+// field returns the setting backing a timeout kind, or nil if unknown.
+func (t *Timeouts) field(kind string) *string {
+	switch kind {
+	case TimeoutList:
+		return &t.List
+	case TimeoutGet:
+		return &t.Get
+	case TimeoutAction:
+		return &t.Action
+	case TimeoutTransfer:
+		return &t.Transfer
+	}
+	return nil
+}
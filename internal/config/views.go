@@ -0,0 +1,63 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/a1s/a1s/internal/config/data"
+)
+
+// ViewSetting customizes how a resource view is displayed.
+type ViewSetting struct {
+	// Columns lists the columns to show, in order, by header name.
+	Columns []string `yaml:"columns"`
+}
+
+// Views holds per-resource view settings keyed by resource, e.g.
+//
+//	views:
+//	  ec2/instance:
+//	    columns: [NAME, ID, STATE, VPC, PRIVATE IP]
+type Views struct {
+	Views map[string]ViewSetting `yaml:"views"`
+}
+
+// LoadViews loads view settings from the given path.
+// A missing file yields no settings.
+func LoadViews(path string) (*Views, error) {
+	v := &Views{Views: make(map[string]ViewSetting)}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return v, nil
+	}
+
+	if err := data.LoadYAML(path, v); err != nil {
+		return nil, fmt.Errorf("failed to load views from %s: %w", path, err)
+	}
+	if v.Views == nil {
+		v.Views = make(map[string]ViewSetting)
+	}
+
+	return v, nil
+}
+
+// Columns returns the configured columns of a resource, upper-cased to
+// match header names, or nil when the resource keeps its defaults.
+func (v *Views) Columns(resource string) []string {
+	if v == nil {
+		return nil
+	}
+
+	setting, ok := v.Views[resource]
+	if !ok || len(setting.Columns) == 0 {
+		return nil
+	}
+
+	cols := make([]string, 0, len(setting.Columns))
+	for _, c := range setting.Columns {
+		if c = strings.ToUpper(strings.TrimSpace(c)); c != "" {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
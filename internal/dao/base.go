@@ -83,6 +83,34 @@ func (r *AWSResource) getFactory() Factory {
 	return r.Factory
 }
 
+// accountID returns the account ID of the active connection, or "" until
+// a connectivity check has resolved it.
+func (r *AWSResource) accountID() string {
+	f := r.getFactory()
+	if f == nil || f.Client() == nil {
+		return ""
+	}
+	return f.Client().AccountID()
+}
+
+// ownerOr returns the owner account reported on a resource, or account
+// when the API left it out.
+func ownerOr(owner *string, account string) string {
+	if owner != nil && *owner != "" {
+		return *owner
+	}
+	return account
+}
+
+// ec2ARN builds an EC2 ARN for resource, e.g. "instance/i-0abc". An unknown
+// account is written as "*".
+func ec2ARN(region, account, resource string) string {
+	if account == "" {
+		account = "*"
+	}
+	return fmt.Sprintf("arn:aws:ec2:%s:%s:%s", region, account, resource)
+}
+
 // getCache returns the resource cache in a thread-safe manner.
 func (r *AWSResource) getCache() *ResourceCache {
 	r.mx.RLock()
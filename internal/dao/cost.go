@@ -0,0 +1,182 @@
+package dao
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Cost estimates are rough hints, not billing data. They use a small static
+// table of us-east-1 on-demand Linux prices and ignore region differences,
+// data transfer, snapshots, Savings Plans, reserved and spot pricing.
+
+// hoursPerMonth is the number of hours AWS bills for an average month.
+const hoursPerMonth = 730
+
+// Fixed hourly charges for networking resources.
+const (
+	natGatewayHourly = 0.045 // per NAT gateway, excluding data processed
+	publicIPv4Hourly = 0.005 // per public IPv4 address, attached or not
+)
+
+// largeHourly is the hourly on-demand price of the .large size per
+// instance family. Other sizes scale linearly, see sizeFactor.
+var largeHourly = map[string]float64{
+	"t2":  0.0928,
+	"t3":  0.0832,
+	"t3a": 0.0752,
+	"t4g": 0.0672,
+	"m5":  0.096,
+	"m5a": 0.086,
+	"m6a": 0.0864,
+	"m6g": 0.077,
+	"m6i": 0.096,
+	"m7g": 0.0816,
+	"m7i": 0.1008,
+	"c5":  0.085,
+	"c5a": 0.077,
+	"c6a": 0.0765,
+	"c6g": 0.068,
+	"c6i": 0.085,
+	"c7g": 0.0725,
+	"c7i": 0.08925,
+	"r5":  0.126,
+	"r5a": 0.113,
+	"r6a": 0.1134,
+	"r6g": 0.1008,
+	"r6i": 0.126,
+	"r7g": 0.1071,
+	"r7i": 0.1323,
+	"i3":  0.156,
+}
+
+// sizeFactor relates instance sizes to .large; NN.xlarge is 2*NN.
+var sizeFactor = map[string]float64{
+	"nano":   1.0 / 16,
+	"micro":  1.0 / 8,
+	"small":  1.0 / 4,
+	"medium": 1.0 / 2,
+	"large":  1,
+	"xlarge": 2,
+}
+
+// volumeGBMonth is the monthly price per provisioned GB by EBS volume type.
+var volumeGBMonth = map[types.VolumeType]float64{
+	types.VolumeTypeGp2:      0.10,
+	types.VolumeTypeGp3:      0.08,
+	types.VolumeTypeIo1:      0.125,
+	types.VolumeTypeIo2:      0.125,
+	types.VolumeTypeSt1:      0.045,
+	types.VolumeTypeSc1:      0.015,
+	types.VolumeTypeStandard: 0.05,
+}
+
+// Provisioned performance charges per month.
+const (
+	gp3FreeIOPS       = 3000
+	gp3FreeThroughput = 125   // MiB/s
+	gp3IOPSMonth      = 0.005 // per IOPS above gp3FreeIOPS
+	gp3MiBpsMonth     = 0.04  // per MiB/s above gp3FreeThroughput
+	pioIOPSMonth      = 0.065 // per provisioned io1/io2 IOPS
+)
+
+// HasCostEstimate reports whether EstimatedMonthlyCost can price a resource.
+func HasCostEstimate(rid *ResourceID) bool {
+	if rid == nil {
+		return false
+	}
+	switch rid.String() {
+	case "ec2/instance", "ec2/volume":
+		return true
+	}
+	return false
+}
+
+// EstimatedMonthlyCost returns an approximate monthly cost in USD for EC2
+// instances, EBS volumes, NAT gateways and Elastic IPs. It returns false
+// when the object cannot be priced, e.g. for an unknown instance type.
+// Stopped instances cost nothing; their volumes are priced separately.
+func EstimatedMonthlyCost(obj AWSObject) (float64, bool) {
+	if obj == nil {
+		return 0, false
+	}
+
+	switch raw := obj.GetRaw().(type) {
+	case types.Instance:
+		if raw.State != nil {
+			switch raw.State.Name {
+			case types.InstanceStateNameStopped, types.InstanceStateNameStopping,
+				types.InstanceStateNameTerminated, types.InstanceStateNameShuttingDown:
+				return 0, true
+			}
+		}
+		hourly, ok := instanceHourly(string(raw.InstanceType))
+		if !ok {
+			return 0, false
+		}
+		return hourly * hoursPerMonth, true
+
+	case types.Volume:
+		return volumeMonthly(raw)
+
+	case types.NatGateway:
+		if raw.State == types.NatGatewayStateDeleted {
+			return 0, true
+		}
+		// Each NAT gateway also holds a public IPv4 address.
+		return (natGatewayHourly + publicIPv4Hourly) * hoursPerMonth, true
+
+	case types.Address:
+		return publicIPv4Hourly * hoursPerMonth, true
+	}
+
+	return 0, false
+}
+
+// instanceHourly estimates the hourly price of an instance type such as
+// "m5.2xlarge" from its family's .large price.
+func instanceHourly(instanceType string) (float64, bool) {
+	family, size, ok := strings.Cut(instanceType, ".")
+	if !ok {
+		return 0, false
+	}
+	base, ok := largeHourly[family]
+	if !ok {
+		return 0, false
+	}
+
+	if factor, ok := sizeFactor[size]; ok {
+		return base * factor, true
+	}
+	if n, ok := strings.CutSuffix(size, "xlarge"); ok {
+		if count, err := strconv.Atoi(n); err == nil && count > 0 {
+			return base * sizeFactor["xlarge"] * float64(count), true
+		}
+	}
+	return 0, false
+}
+
+// volumeMonthly prices a volume's storage and provisioned performance.
+func volumeMonthly(v types.Volume) (float64, bool) {
+	perGB, ok := volumeGBMonth[v.VolumeType]
+	if !ok || v.Size == nil {
+		return 0, false
+	}
+
+	cost := perGB * float64(aws.ToInt32(v.Size))
+	iops := float64(aws.ToInt32(v.Iops))
+	switch v.VolumeType {
+	case types.VolumeTypeGp3:
+		if iops > gp3FreeIOPS {
+			cost += (iops - gp3FreeIOPS) * gp3IOPSMonth
+		}
+		if tp := float64(aws.ToInt32(v.Throughput)); tp > gp3FreeThroughput {
+			cost += (tp - gp3FreeThroughput) * gp3MiBpsMonth
+		}
+	case types.VolumeTypeIo1, types.VolumeTypeIo2:
+		cost += iops * pioIOPSMonth
+	}
+	return cost, true
+}
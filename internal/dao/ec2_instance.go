@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
+	awsinternal "github.com/a1s/a1s/internal/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
 )
 
 func init() {
@@ -21,6 +24,13 @@ type EC2Instance struct {
 
 // List returns all EC2 instances in the specified region.
 func (e *EC2Instance) List(ctx context.Context, region string) ([]AWSObject, error) {
+	return e.ListFiltered(ctx, region, nil)
+}
+
+// ListFiltered returns the EC2 instances in the specified region matching filters,
+// e.g. {"tag:Environment": "prod", "instance-state-name": "running,stopped"}.
+// A NameFilter prefix is sent as a "tag:Name" wildcard filter.
+func (e *EC2Instance) ListFiltered(ctx context.Context, region string, filters map[string]string) ([]AWSObject, error) {
 	f := e.getFactory()
 	if f == nil {
 		return nil, fmt.Errorf("factory not initialized")
@@ -31,9 +41,12 @@ func (e *EC2Instance) List(ctx context.Context, region string) ([]AWSObject, err
 		return nil, fmt.Errorf("failed to get EC2 client for region %s", region)
 	}
 
-	input := &ec2.DescribeInstancesInput{}
+	input := &ec2.DescribeInstancesInput{
+		Filters: ec2Filters(filters),
+	}
 	paginator := ec2.NewDescribeInstancesPaginator(client, input)
 
+	account := e.accountID()
 	var instances []AWSObject
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
@@ -43,7 +56,7 @@ func (e *EC2Instance) List(ctx context.Context, region string) ([]AWSObject, err
 
 		for _, reservation := range output.Reservations {
 			for _, instance := range reservation.Instances {
-				instances = append(instances, instanceToAWSObject(instance, region))
+				instances = append(instances, instanceToAWSObject(instance, region, ownerOr(reservation.OwnerId, account)))
 			}
 		}
 	}
@@ -81,8 +94,8 @@ func (e *EC2Instance) Get(ctx context.Context, path string) (AWSObject, error) {
 		return nil, fmt.Errorf("instance not found: %s", instanceID)
 	}
 
-	instance := output.Reservations[0].Instances[0]
-	return instanceToAWSObject(instance, region), nil
+	reservation := output.Reservations[0]
+	return instanceToAWSObject(reservation.Instances[0], region, ownerOr(reservation.OwnerId, e.accountID())), nil
 }
 
 // Describe returns a formatted description of the EC2 instance.
@@ -128,6 +141,33 @@ func (e *EC2Instance) Describe(path string) (string, error) {
 		sb.WriteString(fmt.Sprintf("Launch Time: %s\n", obj.GetCreatedAt().Format("2006-01-02 15:04:05")))
 	}
 
+	if instance.KeyName != nil {
+		sb.WriteString(fmt.Sprintf("Key Name: %s\n", *instance.KeyName))
+	}
+
+	if instance.IamInstanceProfile != nil && instance.IamInstanceProfile.Arn != nil {
+		sb.WriteString(fmt.Sprintf("IAM Instance Profile: %s\n", *instance.IamInstanceProfile.Arn))
+	}
+
+	if len(instance.SecurityGroups) > 0 {
+		sb.WriteString("Security Groups:\n")
+		for _, sg := range instance.SecurityGroups {
+			sb.WriteString(fmt.Sprintf("  %s (%s)\n", awsinternal.StringValue(sg.GroupId), awsinternal.StringValue(sg.GroupName)))
+		}
+	}
+
+	if len(instance.BlockDeviceMappings) > 0 {
+		sb.WriteString("Block Devices:\n")
+		for _, bd := range instance.BlockDeviceMappings {
+			if bd.Ebs == nil {
+				sb.WriteString(fmt.Sprintf("  %s\n", awsinternal.StringValue(bd.DeviceName)))
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("  %s: %s (delete on termination: %t)\n",
+				awsinternal.StringValue(bd.DeviceName), awsinternal.StringValue(bd.Ebs.VolumeId), awsinternal.BoolValue(bd.Ebs.DeleteOnTermination)))
+		}
+	}
+
 	if len(obj.GetTags()) > 0 {
 		sb.WriteString("Tags:\n")
 		for k, v := range obj.GetTags() {
@@ -138,6 +178,78 @@ func (e *EC2Instance) Describe(path string) (string, error) {
 	return sb.String(), nil
 }
 
+// Related returns the security groups, subnet, EBS volumes and IAM role of an instance.
+func (e *EC2Instance) Related(ctx context.Context, obj AWSObject) ([]Relation, error) {
+	instance, ok := obj.GetRaw().(types.Instance)
+	if !ok {
+		return nil, fmt.Errorf("invalid instance object")
+	}
+	region := obj.GetRegion()
+
+	var rels []Relation
+	for _, sg := range instance.SecurityGroups {
+		rels = append(rels, Relation{
+			RID:    VPCSecurityGroupRID,
+			Path:   region + "/" + awsinternal.StringValue(sg.GroupId),
+			Detail: awsinternal.StringValue(sg.GroupName),
+		})
+	}
+
+	if instance.SubnetId != nil {
+		detail := ""
+		if instance.Placement != nil {
+			detail = awsinternal.StringValue(instance.Placement.AvailabilityZone)
+		}
+		rels = append(rels, Relation{
+			RID:    SubnetRID,
+			Path:   region + "/" + *instance.SubnetId,
+			Detail: detail,
+		})
+	}
+
+	for _, bd := range instance.BlockDeviceMappings {
+		if bd.Ebs == nil || bd.Ebs.VolumeId == nil {
+			continue
+		}
+		rels = append(rels, Relation{
+			RID:    EC2VolumeRID,
+			Path:   region + "/" + *bd.Ebs.VolumeId,
+			Detail: awsinternal.StringValue(bd.DeviceName),
+		})
+	}
+
+	if instance.IamInstanceProfile == nil || instance.IamInstanceProfile.Arn == nil {
+		return rels, nil
+	}
+
+	// The instance only references its profile, the roles live on the profile
+	arn := *instance.IamInstanceProfile.Arn
+	profile := arn[strings.LastIndex(arn, "/")+1:]
+	f := e.getFactory()
+	if f == nil {
+		return rels, fmt.Errorf("%w: factory not initialized", ErrPartialList)
+	}
+	iamClient := f.Client().IAM()
+	if iamClient == nil {
+		return rels, fmt.Errorf("%w: failed to get IAM client", ErrPartialList)
+	}
+	out, err := iamClient.GetInstanceProfile(ctx, &iam.GetInstanceProfileInput{
+		InstanceProfileName: &profile,
+	})
+	if err != nil {
+		return rels, fmt.Errorf("%w: %w", ErrPartialList, awsinternal.WrapAWSError(err, "GetInstanceProfile"))
+	}
+	for _, role := range out.InstanceProfile.Roles {
+		rels = append(rels, Relation{
+			RID:    IAMRoleRID,
+			Path:   awsinternal.StringValue(role.RoleName),
+			Detail: "via " + profile,
+		})
+	}
+
+	return rels, nil
+}
+
 // ToJSON returns a JSON representation of the EC2 instance.
 func (e *EC2Instance) ToJSON(path string) (string, error) {
 	obj, err := e.Get(context.Background(), path)
@@ -172,10 +284,11 @@ func (e *EC2Instance) Delete(ctx context.Context, path string, force bool) error
 
 	input := &ec2.TerminateInstancesInput{
 		InstanceIds: []string{instanceID},
+		DryRun:      awsinternal.DryRunFlag(ctx),
 	}
 
 	_, err = client.TerminateInstances(ctx, input)
-	if err != nil {
+	if err = awsinternal.DryRunResult(err); err != nil {
 		return fmt.Errorf("failed to terminate instance %s: %w", instanceID, err)
 	}
 
@@ -258,6 +371,32 @@ func (e *EC2Instance) Reboot(ctx context.Context, instanceID string) error {
 	return nil
 }
 
+// ModifyInstanceType changes the instance type of a stopped EC2 instance.
+func (e *EC2Instance) ModifyInstanceType(ctx context.Context, region, instanceID, newType string) error {
+	f := e.getFactory()
+	if f == nil {
+		return fmt.Errorf("factory not initialized")
+	}
+
+	client := f.Client().EC2(region)
+	if client == nil {
+		return fmt.Errorf("failed to get EC2 client for region %s", region)
+	}
+
+	input := &ec2.ModifyInstanceAttributeInput{
+		InstanceId:   &instanceID,
+		InstanceType: &types.AttributeValue{Value: &newType},
+		DryRun:       awsinternal.DryRunFlag(ctx),
+	}
+
+	_, err := client.ModifyInstanceAttribute(ctx, input)
+	if err = awsinternal.DryRunResult(err); err != nil {
+		return fmt.Errorf("failed to change type of instance %s to %s: %w", instanceID, newType, err)
+	}
+
+	return nil
+}
+
 // GetConsoleOutput retrieves the console output for an EC2 instance.
 func (e *EC2Instance) GetConsoleOutput(ctx context.Context, instanceID string) (string, error) {
 	f := e.getFactory()
@@ -287,8 +426,48 @@ func (e *EC2Instance) GetConsoleOutput(ctx context.Context, instanceID string) (
 	return *output.Output, nil
 }
 
-// instanceToAWSObject converts an EC2 instance to an AWSObject.
-func instanceToAWSObject(instance types.Instance, region string) AWSObject {
+// GetTags returns the tags of an EC2 instance (path format: "region/instance-id").
+func (e *EC2Instance) GetTags(ctx context.Context, path string) (map[string]string, error) {
+	region, instanceID, err := parseEC2Path(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f := e.getFactory()
+	if f == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	client := f.Client().EC2(region)
+	if client == nil {
+		return nil, fmt.Errorf("failed to get EC2 client for region %s", region)
+	}
+
+	return getEC2Tags(ctx, client, instanceID)
+}
+
+// SetTags replaces the tags of an EC2 instance (path format: "region/instance-id").
+func (e *EC2Instance) SetTags(ctx context.Context, path string, tags map[string]string) error {
+	region, instanceID, err := parseEC2Path(path)
+	if err != nil {
+		return err
+	}
+
+	f := e.getFactory()
+	if f == nil {
+		return fmt.Errorf("factory not initialized")
+	}
+
+	client := f.Client().EC2(region)
+	if client == nil {
+		return fmt.Errorf("failed to get EC2 client for region %s", region)
+	}
+
+	return setEC2Tags(ctx, client, instanceID, tags)
+}
+
+// instanceToAWSObject converts an EC2 instance owned by account to an AWSObject.
+func instanceToAWSObject(instance types.Instance, region, account string) AWSObject {
 	tags := make(map[string]string)
 	for _, tag := range instance.Tags {
 		if tag.Key != nil && tag.Value != nil {
@@ -298,9 +477,7 @@ func instanceToAWSObject(instance types.Instance, region string) AWSObject {
 
 	var arn string
 	if instance.InstanceId != nil {
-		// ARN format: arn:aws:ec2:region:account-id:instance/instance-id
-		// We don't have account ID here, so we'll construct a partial ARN
-		arn = fmt.Sprintf("arn:aws:ec2:%s::instance/%s", region, *instance.InstanceId)
+		arn = ec2ARN(region, account, "instance/"+*instance.InstanceId)
 	}
 
 	var id string
@@ -321,6 +498,41 @@ func instanceToAWSObject(instance types.Instance, region string) AWSObject {
 	}
 }
 
+// ec2Filters converts a filter map to EC2 API filters.
+// Values are split on commas so one filter can match several values.
+func ec2Filters(filters map[string]string) []types.Filter {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(filters))
+	for k := range filters {
+		if k != NameFilter {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	result := make([]types.Filter, 0, len(keys)+1)
+	for _, k := range keys {
+		name := k
+		result = append(result, types.Filter{
+			Name:   &name,
+			Values: strings.Split(filters[k], ","),
+		})
+	}
+	if prefix, ok := filters[NameFilter]; ok {
+		if _, ok := filters["tag:Name"]; !ok {
+			name := "tag:Name"
+			result = append(result, types.Filter{
+				Name:   &name,
+				Values: []string{prefix + "*"},
+			})
+		}
+	}
+	return result
+}
+
 // extractNameTag extracts the "Name" tag value from a list of tags.
 func extractNameTag(tags []types.Tag) string {
 	for _, tag := range tags {
@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// getEC2Tags returns the tags of any EC2 resource (instance, volume, ...).
+func getEC2Tags(ctx context.Context, client *ec2.Client, resourceID string) (map[string]string, error) {
+	input := &ec2.DescribeTagsInput{
+		Filters: []types.Filter{
+			{Name: stringPtr("resource-id"), Values: []string{resourceID}},
+		},
+	}
+
+	tags := make(map[string]string)
+	paginator := ec2.NewDescribeTagsPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe tags for %s: %w", resourceID, err)
+		}
+		for _, tag := range output.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// setEC2Tags replaces the tags of an EC2 resource with the given set.
+// Only changed keys are sent: removed keys are deleted, new or modified keys are created.
+func setEC2Tags(ctx context.Context, client *ec2.Client, resourceID string, tags map[string]string) error {
+	current, err := getEC2Tags(ctx, client, resourceID)
+	if err != nil {
+		return err
+	}
+
+	var removed []types.Tag
+	for k := range current {
+		if _, ok := tags[k]; !ok {
+			removed = append(removed, types.Tag{Key: stringPtr(k)})
+		}
+	}
+
+	var changed []types.Tag
+	for k, v := range tags {
+		if old, ok := current[k]; !ok || old != v {
+			changed = append(changed, types.Tag{Key: stringPtr(k), Value: stringPtr(v)})
+		}
+	}
+
+	if len(removed) > 0 {
+		_, err := client.DeleteTags(ctx, &ec2.DeleteTagsInput{
+			Resources: []string{resourceID},
+			Tags:      removed,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete tags for %s: %w", resourceID, err)
+		}
+	}
+
+	if len(changed) > 0 {
+		_, err := client.CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: []string{resourceID},
+			Tags:      changed,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create tags for %s: %w", resourceID, err)
+		}
+	}
+
+	return nil
+}
@@ -34,6 +34,7 @@ func (v *EC2Volume) List(ctx context.Context, region string) ([]AWSObject, error
 		return nil, fmt.Errorf("failed to get EC2 client for region: %s", region)
 	}
 
+	account := v.accountID()
 	var objects []AWSObject
 	paginator := ec2.NewDescribeVolumesPaginator(client, &ec2.DescribeVolumesInput{})
 
@@ -44,7 +45,7 @@ func (v *EC2Volume) List(ctx context.Context, region string) ([]AWSObject, error
 		}
 
 		for _, volume := range page.Volumes {
-			objects = append(objects, volumeToAWSObject(volume, region))
+			objects = append(objects, volumeToAWSObject(volume, region, account))
 		}
 	}
 
@@ -79,7 +80,7 @@ func (v *EC2Volume) Get(ctx context.Context, path string) (AWSObject, error) {
 		return nil, fmt.Errorf("volume not found: %s", volumeID)
 	}
 
-	return volumeToAWSObject(result.Volumes[0], region), nil
+	return volumeToAWSObject(result.Volumes[0], region, v.accountID()), nil
 }
 
 // Describe returns a human-readable description of the volume.
@@ -184,8 +185,9 @@ func (v *EC2Volume) Delete(ctx context.Context, path string, force bool) error {
 
 	_, err = client.DeleteVolume(ctx, &ec2.DeleteVolumeInput{
 		VolumeId: aws.String(volumeID),
+		DryRun:   awsinternal.DryRunFlag(ctx),
 	})
-	if err != nil {
+	if err = awsinternal.DryRunResult(err); err != nil {
 		return awsinternal.WrapAWSError(err, "DeleteVolume")
 	}
 
@@ -208,8 +210,9 @@ func (v *EC2Volume) Attach(ctx context.Context, region, volumeID, instanceID, de
 		VolumeId:   aws.String(volumeID),
 		InstanceId: aws.String(instanceID),
 		Device:     aws.String(device),
+		DryRun:     awsinternal.DryRunFlag(ctx),
 	})
-	if err != nil {
+	if err = awsinternal.DryRunResult(err); err != nil {
 		return awsinternal.WrapAWSError(err, "AttachVolume")
 	}
 
@@ -231,14 +234,127 @@ func (v *EC2Volume) Detach(ctx context.Context, region, volumeID string, force b
 	_, err := client.DetachVolume(ctx, &ec2.DetachVolumeInput{
 		VolumeId: aws.String(volumeID),
 		Force:    aws.Bool(force),
+		DryRun:   awsinternal.DryRunFlag(ctx),
 	})
-	if err != nil {
+	if err = awsinternal.DryRunResult(err); err != nil {
 		return awsinternal.WrapAWSError(err, "DetachVolume")
 	}
 
 	return nil
 }
 
+// Modify changes the size, type, IOPS or throughput of a volume. Zero values
+// and an empty type leave that setting unchanged. EBS applies the change
+// asynchronously, see Modification for its progress.
+func (v *EC2Volume) Modify(ctx context.Context, region, volumeID string, size int32, volType string, iops, throughput int32) error {
+	f := v.getFactory()
+	if f == nil {
+		return fmt.Errorf("factory not initialized")
+	}
+
+	client := f.Client().EC2(region)
+	if client == nil {
+		return fmt.Errorf("failed to get EC2 client for region: %s", region)
+	}
+
+	result, err := client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+		VolumeIds: []string{volumeID},
+	})
+	if err != nil {
+		return awsinternal.WrapAWSError(err, "DescribeVolumes")
+	}
+	if len(result.Volumes) == 0 {
+		return fmt.Errorf("volume not found: %s", volumeID)
+	}
+	if err := validateVolumeModify(result.Volumes[0], size, volType, iops, throughput); err != nil {
+		return err
+	}
+
+	input := &ec2.ModifyVolumeInput{
+		VolumeId: aws.String(volumeID),
+		DryRun:   awsinternal.DryRunFlag(ctx),
+	}
+	if size > 0 {
+		input.Size = aws.Int32(size)
+	}
+	if volType != "" {
+		input.VolumeType = types.VolumeType(volType)
+	}
+	if iops > 0 {
+		input.Iops = aws.Int32(iops)
+	}
+	if throughput > 0 {
+		input.Throughput = aws.Int32(throughput)
+	}
+
+	_, err = client.ModifyVolume(ctx, input)
+	if err = awsinternal.DryRunResult(err); err != nil {
+		return awsinternal.WrapAWSError(err, "ModifyVolume")
+	}
+
+	return nil
+}
+
+// validateVolumeModify rejects changes EBS does not allow: shrinking a
+// volume, IOPS on types without provisioned IOPS and throughput other than
+// on gp3.
+func validateVolumeModify(current types.Volume, size int32, volType string, iops, throughput int32) error {
+	if size > 0 && size < aws.ToInt32(current.Size) {
+		return fmt.Errorf("cannot shrink volume from %d GiB to %d GiB", aws.ToInt32(current.Size), size)
+	}
+
+	target := types.VolumeType(volType)
+	if volType == "" {
+		target = current.VolumeType
+	}
+	switch target {
+	case types.VolumeTypeIo1, types.VolumeTypeIo2, types.VolumeTypeGp3:
+	default:
+		if iops > 0 {
+			return fmt.Errorf("IOPS can only be set for io1, io2 and gp3 volumes, not %s", target)
+		}
+	}
+	if throughput > 0 && target != types.VolumeTypeGp3 {
+		return fmt.Errorf("throughput can only be set for gp3 volumes, not %s", target)
+	}
+
+	return nil
+}
+
+// Modification returns the most recent modification of a volume, or nil if
+// it was never modified.
+func (v *EC2Volume) Modification(ctx context.Context, region, volumeID string) (*types.VolumeModification, error) {
+	f := v.getFactory()
+	if f == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	client := f.Client().EC2(region)
+	if client == nil {
+		return nil, fmt.Errorf("failed to get EC2 client for region: %s", region)
+	}
+
+	result, err := client.DescribeVolumesModifications(ctx, &ec2.DescribeVolumesModificationsInput{
+		VolumeIds: []string{volumeID},
+	})
+	if err != nil {
+		if isAPIError(err, "InvalidVolumeModification.NotFound") {
+			return nil, nil
+		}
+		return nil, awsinternal.WrapAWSError(err, "DescribeVolumesModifications")
+	}
+
+	var latest *types.VolumeModification
+	for i := range result.VolumesModifications {
+		m := &result.VolumesModifications[i]
+		if latest == nil || aws.ToTime(m.StartTime).After(aws.ToTime(latest.StartTime)) {
+			latest = m
+		}
+	}
+
+	return latest, nil
+}
+
 // CreateSnapshot creates a snapshot of the specified volume.
 func (v *EC2Volume) CreateSnapshot(ctx context.Context, region, volumeID, description string) (string, error) {
 	f := v.getFactory()
@@ -253,15 +369,19 @@ func (v *EC2Volume) CreateSnapshot(ctx context.Context, region, volumeID, descri
 
 	input := &ec2.CreateSnapshotInput{
 		VolumeId: aws.String(volumeID),
+		DryRun:   awsinternal.DryRunFlag(ctx),
 	}
 	if description != "" {
 		input.Description = aws.String(description)
 	}
 
 	result, err := client.CreateSnapshot(ctx, input)
-	if err != nil {
+	if err = awsinternal.DryRunResult(err); err != nil {
 		return "", awsinternal.WrapAWSError(err, "CreateSnapshot")
 	}
+	if awsinternal.IsDryRun(ctx) {
+		return "", nil
+	}
 
 	if result.SnapshotId == nil {
 		return "", fmt.Errorf("snapshot creation returned no snapshot ID")
@@ -270,8 +390,48 @@ func (v *EC2Volume) CreateSnapshot(ctx context.Context, region, volumeID, descri
 	return *result.SnapshotId, nil
 }
 
+// GetTags returns the tags of an EBS volume (path format: "region/volume-id").
+func (v *EC2Volume) GetTags(ctx context.Context, path string) (map[string]string, error) {
+	region, volumeID, err := parseVolumePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f := v.getFactory()
+	if f == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	client := f.Client().EC2(region)
+	if client == nil {
+		return nil, fmt.Errorf("failed to get EC2 client for region: %s", region)
+	}
+
+	return getEC2Tags(ctx, client, volumeID)
+}
+
+// SetTags replaces the tags of an EBS volume (path format: "region/volume-id").
+func (v *EC2Volume) SetTags(ctx context.Context, path string, tags map[string]string) error {
+	region, volumeID, err := parseVolumePath(path)
+	if err != nil {
+		return err
+	}
+
+	f := v.getFactory()
+	if f == nil {
+		return fmt.Errorf("factory not initialized")
+	}
+
+	client := f.Client().EC2(region)
+	if client == nil {
+		return fmt.Errorf("failed to get EC2 client for region: %s", region)
+	}
+
+	return setEC2Tags(ctx, client, volumeID, tags)
+}
+
 // volumeToAWSObject converts an EC2 Volume to an AWSObject.
-func volumeToAWSObject(volume types.Volume, region string) AWSObject {
+func volumeToAWSObject(volume types.Volume, region, account string) AWSObject {
 	tags := make(map[string]string)
 	var name string
 
@@ -284,9 +444,7 @@ func volumeToAWSObject(volume types.Volume, region string) AWSObject {
 		}
 	}
 
-	// Build ARN: arn:aws:ec2:region:account-id:volume/volume-id
-	// Note: We don't have account ID in the volume object, so we construct a partial ARN
-	arn := fmt.Sprintf("arn:aws:ec2:%s::volume/%s", region, aws.ToString(volume.VolumeId))
+	arn := ec2ARN(region, account, "volume/"+aws.ToString(volume.VolumeId))
 
 	return &BaseAWSObject{
 		ARN:       arn,
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	awsinternal "github.com/a1s/a1s/internal/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 )
@@ -21,7 +22,23 @@ type EKSCluster struct {
 }
 
 // List returns all EKS clusters in the specified region.
+// Clusters are described concurrently; if some describes fail, the remaining
+// clusters are returned together with an error wrapping ErrPartialList.
 func (e *EKSCluster) List(ctx context.Context, region string) ([]AWSObject, error) {
+	return e.ListFiltered(ctx, region, nil)
+}
+
+// ListFiltered returns the EKS clusters in the specified region whose name
+// starts with the NameFilter prefix. EKS has no server-side filters, so
+// names are matched before the per-cluster describe calls.
+func (e *EKSCluster) ListFiltered(ctx context.Context, region string, filters map[string]string) ([]AWSObject, error) {
+	for k := range filters {
+		if k != NameFilter {
+			return nil, fmt.Errorf("unsupported EKS cluster filter %q (only a name prefix is supported)", k)
+		}
+	}
+	prefix := filters[NameFilter]
+
 	client := e.Client().EKS(region)
 	if client == nil {
 		return nil, fmt.Errorf("failed to get EKS client for region %s", region)
@@ -30,33 +47,74 @@ func (e *EKSCluster) List(ctx context.Context, region string) ([]AWSObject, erro
 	input := &eks.ListClustersInput{}
 	paginator := eks.NewListClustersPaginator(client, input)
 
-	var clusters []AWSObject
+	var names []string
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list clusters: %w", err)
 		}
-
-		// For each cluster name, fetch detailed information
-		for _, clusterName := range output.Clusters {
-			describeInput := &eks.DescribeClusterInput{
-				Name: &clusterName,
-			}
-
-			describeOutput, err := client.DescribeCluster(ctx, describeInput)
-			if err != nil {
-				return nil, fmt.Errorf("failed to describe cluster %s: %w", clusterName, err)
+		for _, name := range output.Clusters {
+			if strings.HasPrefix(name, prefix) {
+				names = append(names, name)
 			}
+		}
+	}
 
-			if describeOutput.Cluster != nil {
-				clusters = append(clusters, clusterToAWSObject(describeOutput.Cluster, region))
-			}
+	// Fetch detailed information for each cluster name
+	clusters, err := fetchConcurrently(ctx, len(names), func(ctx context.Context, i int) (AWSObject, error) {
+		describeOutput, err := client.DescribeCluster(ctx, &eks.DescribeClusterInput{
+			Name: &names[i],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe cluster %s: %w", names[i], err)
+		}
+		if describeOutput.Cluster == nil {
+			return nil, nil
 		}
+		return clusterToAWSObject(describeOutput.Cluster, region), nil
+	})
+	if err != nil {
+		return clusters, fmt.Errorf("%w: %w", ErrPartialList, err)
 	}
 
 	return clusters, nil
 }
 
+// Related returns the node groups of a cluster.
+func (e *EKSCluster) Related(ctx context.Context, obj AWSObject) ([]Relation, error) {
+	f := e.getFactory()
+	if f == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	region := obj.GetRegion()
+	eksClient := f.Client().EKS(region)
+	if eksClient == nil {
+		return nil, fmt.Errorf("failed to get EKS client for region %s", region)
+	}
+
+	var rels []Relation
+	cluster := obj.GetName()
+	paginator := eks.NewListNodegroupsPaginator(eksClient, &eks.ListNodegroupsInput{
+		ClusterName: &cluster,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return rels, awsinternal.WrapAWSError(err, "ListNodegroups")
+		}
+		for _, ng := range page.Nodegroups {
+			rels = append(rels, Relation{
+				RID:    EKSNodeGroupRID,
+				Path:   region + "/" + cluster + "/" + ng,
+				Detail: cluster,
+			})
+		}
+	}
+
+	return rels, nil
+}
+
 // Get retrieves a single EKS cluster by path (format: "region/cluster-name").
 func (e *EKSCluster) Get(ctx context.Context, path string) (AWSObject, error) {
 	region, clusterName, err := parseClusterPath(path)
@@ -163,6 +221,10 @@ func (e *EKSCluster) ToJSON(path string) (string, error) {
 // Delete deletes an EKS cluster.
 // If force is true, it will first delete all nodegroups and fargate profiles.
 func (e *EKSCluster) Delete(ctx context.Context, path string, force bool) error {
+	if awsinternal.IsDryRun(ctx) {
+		return nil
+	}
+
 	region, clusterName, err := parseClusterPath(path)
 	if err != nil {
 		return err
@@ -248,9 +310,13 @@ func (e *EKSCluster) Delete(ctx context.Context, path string, force bool) error
 	return nil
 }
 
-// GetKubeconfig generates a kubeconfig YAML for the cluster.
-func (e *EKSCluster) GetKubeconfig(ctx context.Context, clusterName string) (string, error) {
-	region := e.Region()
+// GetKubeconfig generates a kubeconfig YAML for the cluster by path (region/cluster-name).
+func (e *EKSCluster) GetKubeconfig(ctx context.Context, path string) (string, error) {
+	region, clusterName, err := parseClusterPath(path)
+	if err != nil {
+		return "", err
+	}
+
 	client := e.Client().EKS(region)
 	if client == nil {
 		return "", fmt.Errorf("failed to get EKS client for region %s", region)
@@ -380,8 +446,14 @@ func parseClusterPath(path string) (region, clusterName string, err error) {
 }
 
 // generateKubeconfig generates a kubeconfig YAML for the cluster.
+// Entries are named after the cluster ARN, as `aws eks update-kubeconfig` does,
+// so clusters of the same name in other accounts or regions don't collide.
 func generateKubeconfig(cluster *types.Cluster, region string) string {
 	clusterName := safeString(cluster.Name)
+	name := clusterName
+	if cluster.Arn != nil {
+		name = *cluster.Arn
+	}
 	endpoint := safeString(cluster.Endpoint)
 	ca := ""
 	if cluster.CertificateAuthority != nil {
@@ -417,12 +489,12 @@ users:
 `,
 		ca,
 		endpoint,
-		clusterName,
-		clusterName,
-		clusterName,
-		clusterName,
-		clusterName,
-		clusterName,
+		name,
+		name,
+		name,
+		name,
+		name,
+		name,
 		clusterName,
 		region,
 	)
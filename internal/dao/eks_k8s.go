@@ -0,0 +1,200 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	awsinternal "github.com/a1s/a1s/internal/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var (
+	// ErrK8sUnreachable indicates the cluster API endpoint could not be reached,
+	// e.g. a private endpoint without VPN or VPC access.
+	ErrK8sUnreachable = errors.New("cluster API endpoint unreachable")
+
+	// ErrK8sUnauthorized indicates the cluster rejected the IAM identity.
+	ErrK8sUnauthorized = errors.New("cluster denied access to this IAM identity")
+)
+
+// k8sPingTimeout bounds the reachability check before listing.
+const k8sPingTimeout = 5 * time.Second
+
+// K8sClient is a read-only Kubernetes client for an EKS cluster.
+// It authenticates with the same IAM token the generated kubeconfig obtains
+// through `aws eks get-token`.
+type K8sClient struct {
+	cluster   string
+	clientset kubernetes.Interface
+}
+
+// K8sNode is a Kubernetes node summary.
+type K8sNode struct {
+	Name         string
+	Ready        bool
+	InstanceType string
+	Zone         string
+	Version      string
+	InternalIP   string
+	CreatedAt    time.Time
+}
+
+// K8sNamespace is a Kubernetes namespace summary.
+type K8sNamespace struct {
+	Name      string
+	Phase     string
+	CreatedAt time.Time
+}
+
+// K8sClient builds a Kubernetes client for a cluster by path (region/cluster-name)
+// and checks that the cluster API is reachable.
+func (e *EKSCluster) K8sClient(ctx context.Context, path string) (*K8sClient, error) {
+	region, clusterName, err := parseClusterPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f := e.getFactory()
+	if f == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	eksClient := f.Client().EKS(region)
+	if eksClient == nil {
+		return nil, fmt.Errorf("failed to get EKS client for region %s", region)
+	}
+	output, err := eksClient.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: &clusterName})
+	if err != nil {
+		return nil, awsinternal.WrapAWSError(err, "DescribeCluster")
+	}
+	if output.Cluster == nil {
+		return nil, fmt.Errorf("cluster not found: %s", clusterName)
+	}
+	if output.Cluster.Status != types.ClusterStatusActive {
+		return nil, fmt.Errorf("cluster %s is %s", clusterName, strings.ToLower(string(output.Cluster.Status)))
+	}
+
+	stsClient := f.Client().STS(region)
+	if stsClient == nil {
+		return nil, fmt.Errorf("failed to get STS client for region %s", region)
+	}
+	token, err := awsinternal.EKSToken(ctx, stsClient, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := newK8sClient(output.Cluster, region, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.ping(ctx); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// newK8sClient creates a client from the kubeconfig generateKubeconfig
+// writes, authenticating with token instead of running the aws CLI.
+func newK8sClient(cluster *types.Cluster, region, token string) (*K8sClient, error) {
+	if safeString(cluster.Endpoint) == "" {
+		return nil, fmt.Errorf("cluster %s has no API endpoint", safeString(cluster.Name))
+	}
+
+	cfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(generateKubeconfig(cluster, region)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig for cluster %s: %w", safeString(cluster.Name), err)
+	}
+	cfg.ExecProvider = nil
+	cfg.BearerToken = token
+
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client for %s: %w", safeString(cluster.Name), err)
+	}
+	return &K8sClient{cluster: safeString(cluster.Name), clientset: clientset}, nil
+}
+
+// ping checks that the cluster API answers and accepts the token.
+func (k *K8sClient) ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, k8sPingTimeout)
+	defer cancel()
+
+	_, err := k.clientset.Discovery().RESTClient().Get().AbsPath("/version").DoRaw(ctx)
+	return k.wrapError(err, "GET /version")
+}
+
+// Nodes lists the cluster's nodes.
+func (k *K8sClient) Nodes(ctx context.Context) ([]K8sNode, error) {
+	list, err := k.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, k.wrapError(err, "list nodes")
+	}
+
+	nodes := make([]K8sNode, 0, len(list.Items))
+	for _, item := range list.Items {
+		node := K8sNode{
+			Name:         item.Name,
+			InstanceType: item.Labels[corev1.LabelInstanceTypeStable],
+			Zone:         item.Labels[corev1.LabelTopologyZone],
+			Version:      item.Status.NodeInfo.KubeletVersion,
+			CreatedAt:    item.CreationTimestamp.Time,
+		}
+		for _, c := range item.Status.Conditions {
+			if c.Type == corev1.NodeReady {
+				node.Ready = c.Status == corev1.ConditionTrue
+			}
+		}
+		for _, a := range item.Status.Addresses {
+			if a.Type == corev1.NodeInternalIP {
+				node.InternalIP = a.Address
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// Namespaces lists the cluster's namespaces.
+func (k *K8sClient) Namespaces(ctx context.Context) ([]K8sNamespace, error) {
+	list, err := k.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, k.wrapError(err, "list namespaces")
+	}
+
+	namespaces := make([]K8sNamespace, 0, len(list.Items))
+	for _, item := range list.Items {
+		namespaces = append(namespaces, K8sNamespace{
+			Name:      item.Name,
+			Phase:     string(item.Status.Phase),
+			CreatedAt: item.CreationTimestamp.Time,
+		})
+	}
+	return namespaces, nil
+}
+
+// wrapError maps 401/403 to ErrK8sUnauthorized and failures that never got
+// an API answer to ErrK8sUnreachable.
+func (k *K8sClient) wrapError(err error, op string) error {
+	var status apierrors.APIStatus
+	switch {
+	case err == nil:
+		return nil
+	case apierrors.IsUnauthorized(err) || apierrors.IsForbidden(err):
+		return fmt.Errorf("%w %s (%v): add an EKS access entry or aws-auth mapping", ErrK8sUnauthorized, k.cluster, err)
+	case errors.As(err, &status):
+		return fmt.Errorf("%s: %w", op, err)
+	case errors.Is(err, context.Canceled):
+		return err
+	default:
+		return fmt.Errorf("%w: %s: %w", ErrK8sUnreachable, k.cluster, err)
+	}
+}
@@ -0,0 +1,155 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestK8sClientNodes(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	clientset := fake.NewSimpleClientset(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "ip-10-0-1-5",
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					corev1.LabelInstanceTypeStable: "m5.large",
+					corev1.LabelTopologyZone:       "us-east-1a",
+				},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse},
+					{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				},
+				Addresses: []corev1.NodeAddress{
+					{Type: corev1.NodeExternalIP, Address: "54.1.2.3"},
+					{Type: corev1.NodeInternalIP, Address: "10.0.1.5"},
+				},
+				NodeInfo: corev1.NodeSystemInfo{KubeletVersion: "v1.29.0-eks"},
+			},
+		},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "ip-10-0-1-6"}},
+	)
+	k := &K8sClient{cluster: "demo", clientset: clientset}
+
+	nodes, err := k.Nodes(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 2 {
+		t.Fatalf("got %d nodes, want 2", len(nodes))
+	}
+
+	want := K8sNode{
+		Name:         "ip-10-0-1-5",
+		Ready:        true,
+		InstanceType: "m5.large",
+		Zone:         "us-east-1a",
+		Version:      "v1.29.0-eks",
+		InternalIP:   "10.0.1.5",
+		CreatedAt:    created,
+	}
+	got := nodes[0]
+	got.CreatedAt = got.CreatedAt.UTC()
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if nodes[1].Ready {
+		t.Errorf("node without a Ready condition reported ready")
+	}
+}
+
+func TestK8sClientNamespaces(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-system"},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		},
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "old"},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+		},
+	)
+	k := &K8sClient{cluster: "demo", clientset: clientset}
+
+	namespaces, err := k.Namespaces(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	phases := make(map[string]string, len(namespaces))
+	for _, ns := range namespaces {
+		phases[ns.Name] = ns.Phase
+	}
+	if phases["kube-system"] != "Active" || phases["old"] != "Terminating" || len(phases) != 2 {
+		t.Errorf("got phases %v", phases)
+	}
+}
+
+func TestK8sClientErrors(t *testing.T) {
+	resource := schema.GroupResource{Resource: "nodes"}
+	tests := map[string]struct {
+		err  error
+		want error
+	}{
+		"unauthorized": {err: apierrors.NewUnauthorized("bad token"), want: ErrK8sUnauthorized},
+		"forbidden":    {err: apierrors.NewForbidden(resource, "", errors.New("no access")), want: ErrK8sUnauthorized},
+		"unreachable":  {err: fmt.Errorf("dial tcp 10.0.0.1:443: i/o timeout"), want: ErrK8sUnreachable},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			clientset.PrependReactor("list", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, tt.err
+			})
+			k := &K8sClient{cluster: "demo", clientset: clientset}
+
+			if _, err := k.Nodes(context.Background()); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("other API errors pass through", func(t *testing.T) {
+		clientset := fake.NewSimpleClientset()
+		clientset.PrependReactor("list", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewInternalError(errors.New("etcd down"))
+		})
+		k := &K8sClient{cluster: "demo", clientset: clientset}
+
+		_, err := k.Nodes(context.Background())
+		if err == nil || errors.Is(err, ErrK8sUnreachable) || errors.Is(err, ErrK8sUnauthorized) || !apierrors.IsInternalError(err) {
+			t.Errorf("got %v, want the API error", err)
+		}
+	})
+}
+
+func TestNewK8sClient(t *testing.T) {
+	cluster := &types.Cluster{
+		Name:     awssdk.String("demo"),
+		Arn:      awssdk.String("arn:aws:eks:us-east-1:123456789012:cluster/demo"),
+		Endpoint: awssdk.String("https://ABC.gr7.us-east-1.eks.amazonaws.com"),
+	}
+	if _, err := newK8sClient(cluster, "us-east-1", "k8s-aws-v1.token"); err != nil {
+		t.Fatalf("newK8sClient: %v", err)
+	}
+
+	cluster.Endpoint = nil
+	if _, err := newK8sClient(cluster, "us-east-1", "k8s-aws-v1.token"); err == nil {
+		t.Error("expected an error for a cluster without an endpoint")
+	}
+}
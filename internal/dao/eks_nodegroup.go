@@ -3,9 +3,11 @@ package dao
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
+	awsinternal "github.com/a1s/a1s/internal/aws"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
@@ -26,6 +28,8 @@ type Scalable interface {
 }
 
 // List retrieves all EKS node groups across all clusters in the specified region.
+// Node groups are described concurrently; if some clusters or describes fail,
+// the remaining node groups are returned with an error wrapping ErrPartialList.
 func (n *EKSNodeGroup) List(ctx context.Context, region string) ([]AWSObject, error) {
 	f := n.getFactory()
 	if f == nil {
@@ -44,9 +48,15 @@ func (n *EKSNodeGroup) List(ctx context.Context, region string) ([]AWSObject, er
 		return nil, fmt.Errorf("failed to list EKS clusters: %w", err)
 	}
 
-	var objects []AWSObject
-
-	// For each cluster, list its node groups
+	// Collect (cluster, node group) pairs so the describes can run in parallel
+	type nodegroupRef struct {
+		cluster string
+		name    string
+	}
+	var (
+		refs     []nodegroupRef
+		listErrs []error
+	)
 	for _, clusterName := range clustersResult.Clusters {
 		ngInput := &eks.ListNodegroupsInput{
 			ClusterName: aws.String(clusterName),
@@ -54,28 +64,37 @@ func (n *EKSNodeGroup) List(ctx context.Context, region string) ([]AWSObject, er
 
 		ngResult, err := eksClient.ListNodegroups(ctx, ngInput)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list node groups for cluster %s: %w", clusterName, err)
+			listErrs = append(listErrs, fmt.Errorf("failed to list node groups for cluster %s: %w", clusterName, err))
+			continue
 		}
 
-		// Describe each node group
 		for _, ngName := range ngResult.Nodegroups {
-			describeInput := &eks.DescribeNodegroupInput{
-				ClusterName:   aws.String(clusterName),
-				NodegroupName: aws.String(ngName),
-			}
-
-			describeResult, err := eksClient.DescribeNodegroup(ctx, describeInput)
-			if err != nil {
-				return nil, fmt.Errorf("failed to describe node group %s in cluster %s: %w", ngName, clusterName, err)
-			}
-
-			if describeResult.Nodegroup != nil {
-				obj := nodegroupToAWSObject(describeResult.Nodegroup, region, clusterName)
-				objects = append(objects, obj)
-			}
+			refs = append(refs, nodegroupRef{cluster: clusterName, name: ngName})
+		}
+	}
+
+	// Describe each node group
+	objects, err := fetchConcurrently(ctx, len(refs), func(ctx context.Context, i int) (AWSObject, error) {
+		ref := refs[i]
+		describeResult, err := eksClient.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(ref.cluster),
+			NodegroupName: aws.String(ref.name),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe node group %s in cluster %s: %w", ref.name, ref.cluster, err)
 		}
+		if describeResult.Nodegroup == nil {
+			return nil, nil
+		}
+		return nodegroupToAWSObject(describeResult.Nodegroup, region, ref.cluster), nil
+	})
+	if err != nil {
+		listErrs = append(listErrs, err)
 	}
 
+	if len(listErrs) > 0 {
+		return objects, fmt.Errorf("%w: %w", ErrPartialList, errors.Join(listErrs...))
+	}
 	return objects, nil
 }
 
@@ -184,6 +203,10 @@ func (n *EKSNodeGroup) ToJSON(path string) (string, error) {
 
 // Delete deletes an EKS node group.
 func (n *EKSNodeGroup) Delete(ctx context.Context, path string, force bool) error {
+	if awsinternal.IsDryRun(ctx) {
+		return nil
+	}
+
 	region, clusterName, nodegroupName, err := parseNodegroupPath(path)
 	if err != nil {
 		return err
@@ -230,7 +253,7 @@ func (n *EKSNodeGroup) Scale(ctx context.Context, path string, desiredSize int32
 	}
 
 	// Get current scaling config to preserve min/max
-	min, max, _, err := n.GetScalingConfig(ctx, clusterName, nodegroupName)
+	min, max, _, err := n.GetScalingConfig(ctx, path)
 	if err != nil {
 		return fmt.Errorf("failed to get current scaling config: %w", err)
 	}
@@ -261,14 +284,19 @@ func (n *EKSNodeGroup) Scale(ctx context.Context, path string, desiredSize int32
 	return nil
 }
 
-// GetScalingConfig retrieves the current scaling configuration for a node group.
-func (n *EKSNodeGroup) GetScalingConfig(ctx context.Context, clusterName, nodegroupName string) (min, max, desired int32, err error) {
+// GetScalingConfig retrieves the current scaling configuration for a node group
+// by path (region/cluster-name/nodegroup-name).
+func (n *EKSNodeGroup) GetScalingConfig(ctx context.Context, path string) (min, max, desired int32, err error) {
+	region, clusterName, nodegroupName, err := parseNodegroupPath(path)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
 	f := n.getFactory()
 	if f == nil {
 		return 0, 0, 0, fmt.Errorf("factory not initialized")
 	}
 
-	region := f.Region()
 	eksClient := f.Client().EKS(region)
 	if eksClient == nil {
 		return 0, 0, 0, fmt.Errorf("failed to get EKS client for region %s", region)
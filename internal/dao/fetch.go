@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// maxConcurrentFetches bounds the number of per-resource API calls in flight.
+const maxConcurrentFetches = 8
+
+// ErrPartialList indicates a listing where some resources could not be fetched.
+// The objects returned alongside it are still valid and can be displayed.
+var ErrPartialList = errors.New("some resources could not be fetched")
+
+// fetchConcurrently calls fetch for each index in [0, n) with bounded concurrency.
+// Results preserve index order; nil objects are skipped. Failed fetches are
+// dropped from the result and their errors joined into the returned error.
+func fetchConcurrently(ctx context.Context, n int, fetch func(ctx context.Context, i int) (AWSObject, error)) ([]AWSObject, error) {
+	return fetchWithWorkers(ctx, n, maxConcurrentFetches, fetch)
+}
+
+// fetchWithWorkers is fetchConcurrently with an explicit concurrency bound.
+func fetchWithWorkers(ctx context.Context, n, workers int, fetch func(ctx context.Context, i int) (AWSObject, error)) ([]AWSObject, error) {
+	results := make([]AWSObject, n)
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, workers)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i], errs[i] = fetch(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+
+	objects := make([]AWSObject, 0, n)
+	var failed []error
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			failed = append(failed, errs[i])
+			continue
+		}
+		if results[i] != nil {
+			objects = append(objects, results[i])
+		}
+	}
+
+	if len(failed) > 0 {
+		return objects, errors.Join(failed...)
+	}
+	return objects, nil
+}
@@ -179,6 +179,10 @@ func (p *IAMPolicy) ToJSON(path string) (string, error) {
 // Delete deletes an IAM policy.
 // If force is true, detaches from all users/roles/groups and deletes all non-default versions first.
 func (p *IAMPolicy) Delete(ctx context.Context, path string, force bool) error {
+	if aws.IsDryRun(ctx) {
+		return nil
+	}
+
 	policyARN := parsePolicyPath(path)
 
 	client := p.Client().IAM()
@@ -296,6 +300,109 @@ func (p *IAMPolicy) GetPolicyDocument(ctx context.Context, policyARN string) (st
 	return decoded, nil
 }
 
+// ValidateEdit checks the edited PolicyDocument of an AWS::IAM::ManagedPolicy.
+func (p *IAMPolicy) ValidateEdit(props map[string]interface{}) error {
+	doc, ok := props["PolicyDocument"]
+	if !ok {
+		return fmt.Errorf("PolicyDocument is required")
+	}
+	if err := ValidatePolicyDocument(doc); err != nil {
+		return fmt.Errorf("PolicyDocument: %w", err)
+	}
+	return nil
+}
+
+// ValidatePolicyDocument checks the basic structure of an IAM identity policy:
+// a known Version and statements with Effect, Action and Resource.
+// doc may be a decoded JSON object or a JSON string.
+func ValidatePolicyDocument(doc interface{}) error {
+	if raw, ok := doc.(string); ok {
+		if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+	}
+
+	policy, ok := doc.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("must be a JSON object")
+	}
+
+	switch version := policy["Version"]; version {
+	case "2012-10-17", "2008-10-17":
+	case nil:
+		return fmt.Errorf("Version is required (use \"2012-10-17\")")
+	default:
+		return fmt.Errorf("unsupported Version %v (use \"2012-10-17\")", version)
+	}
+
+	var statements []interface{}
+	switch st := policy["Statement"].(type) {
+	case []interface{}:
+		statements = st
+	case map[string]interface{}:
+		statements = []interface{}{st}
+	case nil:
+		return fmt.Errorf("Statement is required")
+	default:
+		return fmt.Errorf("Statement must be an array of objects")
+	}
+	if len(statements) == 0 {
+		return fmt.Errorf("Statement must not be empty")
+	}
+
+	for i, s := range statements {
+		statement, ok := s.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("Statement[%d] must be an object", i)
+		}
+		if effect := statement["Effect"]; effect != "Allow" && effect != "Deny" {
+			return fmt.Errorf("Statement[%d]: Effect must be \"Allow\" or \"Deny\", got %v", i, effect)
+		}
+		if err := checkPolicyElement(statement, "Action", "NotAction"); err != nil {
+			return fmt.Errorf("Statement[%d]: %w", i, err)
+		}
+		if err := checkPolicyElement(statement, "Resource", "NotResource"); err != nil {
+			return fmt.Errorf("Statement[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// checkPolicyElement verifies that exactly one of name or notName is set to a
+// string or a non-empty array of strings.
+func checkPolicyElement(statement map[string]interface{}, name, notName string) error {
+	value, hasName := statement[name]
+	notValue, hasNot := statement[notName]
+	switch {
+	case hasName && hasNot:
+		return fmt.Errorf("%s and %s cannot both be set", name, notName)
+	case hasNot:
+		name, value = notName, notValue
+	case !hasName:
+		return fmt.Errorf("%s or %s is required", name, notName)
+	}
+
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return fmt.Errorf("%s must not be empty", name)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return fmt.Errorf("%s must not be empty", name)
+		}
+		for _, item := range v {
+			if s, ok := item.(string); !ok || s == "" {
+				return fmt.Errorf("%s must contain only non-empty strings", name)
+			}
+		}
+	default:
+		return fmt.Errorf("%s must be a string or an array of strings", name)
+	}
+	return nil
+}
+
 // ListVersions returns all versions of the policy.
 func (p *IAMPolicy) ListVersions(ctx context.Context, policyARN string) ([]PolicyVersion, error) {
 	client := p.Client().IAM()
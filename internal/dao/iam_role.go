@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/a1s/a1s/internal/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 )
@@ -159,6 +160,10 @@ func (r *IAMRole) ToJSON(path string) (string, error) {
 // Delete deletes an IAM role. If force is true, detaches all policies,
 // deletes inline policies, and removes from instance profiles first.
 func (r *IAMRole) Delete(ctx context.Context, path string, force bool) error {
+	if aws.IsDryRun(ctx) {
+		return nil
+	}
+
 	roleName := parseRolePath(path)
 
 	f := r.getFactory()
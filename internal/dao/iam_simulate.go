@@ -0,0 +1,149 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsinternal "github.com/a1s/a1s/internal/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// PolicyDecision is the simulated outcome of one action on one resource.
+type PolicyDecision struct {
+	Action   string
+	Resource string
+	// Decision is "allowed", "explicitDeny" or "implicitDeny".
+	Decision string
+	// Statements lists the policy statements that decided the outcome.
+	Statements []MatchedStatement
+	// MissingContext lists condition keys the simulation had no value for.
+	MissingContext []string
+}
+
+// Allowed reports whether the action is allowed.
+func (d PolicyDecision) Allowed() bool {
+	return d.Decision == string(types.PolicyEvaluationDecisionTypeAllowed)
+}
+
+// MatchedStatement locates a policy statement that matched a simulation.
+type MatchedStatement struct {
+	PolicyID   string
+	PolicyType string
+	StartLine  int
+	EndLine    int
+}
+
+// String formats the statement as "policy (type) lines a-b".
+func (s MatchedStatement) String() string {
+	out := s.PolicyID
+	if s.PolicyType != "" {
+		out += " (" + s.PolicyType + ")"
+	}
+	if s.StartLine > 0 {
+		out += fmt.Sprintf(" lines %d-%d", s.StartLine, s.EndLine)
+	}
+	return out
+}
+
+// SimulatePolicy simulates whether user may perform actions on resource
+// with the policies attached to it, directly or through its groups. An
+// empty resource simulates against "*".
+func (i *IAMUser) SimulatePolicy(ctx context.Context, username string, actions []string, resource string) ([]PolicyDecision, error) {
+	client := i.Client().IAM()
+	if client == nil {
+		return nil, fmt.Errorf("failed to get IAM client")
+	}
+
+	output, err := client.GetUser(ctx, &iam.GetUserInput{UserName: &username})
+	if err != nil {
+		return nil, awsinternal.WrapAWSError(err, "get user")
+	}
+	if output.User == nil || output.User.Arn == nil {
+		return nil, fmt.Errorf("IAM user %s not found", username)
+	}
+
+	return simulatePrincipalPolicy(ctx, client, *output.User.Arn, actions, resource)
+}
+
+// SimulatePolicy simulates whether the role may perform actions on
+// resource with its attached and inline policies. An empty resource
+// simulates against "*".
+func (r *IAMRole) SimulatePolicy(ctx context.Context, roleName string, actions []string, resource string) ([]PolicyDecision, error) {
+	f := r.getFactory()
+	if f == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	client := f.Client().IAM()
+	if client == nil {
+		return nil, fmt.Errorf("failed to get IAM client")
+	}
+
+	output, err := client.GetRole(ctx, &iam.GetRoleInput{RoleName: &roleName})
+	if err != nil {
+		return nil, awsinternal.WrapAWSError(err, "get role")
+	}
+	if output.Role == nil || output.Role.Arn == nil {
+		return nil, fmt.Errorf("IAM role %s not found", roleName)
+	}
+
+	return simulatePrincipalPolicy(ctx, client, *output.Role.Arn, actions, resource)
+}
+
+// simulatePrincipalPolicy runs the IAM policy simulator for a principal.
+func simulatePrincipalPolicy(ctx context.Context, client *iam.Client, principalARN string, actions []string, resource string) ([]PolicyDecision, error) {
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("at least one action is required")
+	}
+
+	input := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: &principalARN,
+		ActionNames:     actions,
+	}
+	if resource != "" {
+		input.ResourceArns = []string{resource}
+	}
+
+	var decisions []PolicyDecision
+	paginator := iam.NewSimulatePrincipalPolicyPaginator(client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, awsinternal.WrapAWSError(err, "simulate principal policy")
+		}
+		for _, result := range page.EvaluationResults {
+			decisions = append(decisions, toPolicyDecision(result))
+		}
+	}
+
+	return decisions, nil
+}
+
+// toPolicyDecision converts an IAM evaluation result.
+func toPolicyDecision(result types.EvaluationResult) PolicyDecision {
+	d := PolicyDecision{
+		Action:         aws.ToString(result.EvalActionName),
+		Resource:       aws.ToString(result.EvalResourceName),
+		Decision:       string(result.EvalDecision),
+		MissingContext: result.MissingContextValues,
+	}
+
+	for _, st := range result.MatchedStatements {
+		m := MatchedStatement{
+			PolicyID:   aws.ToString(st.SourcePolicyId),
+			PolicyType: strings.ToLower(string(st.SourcePolicyType)),
+		}
+		if st.StartPosition != nil {
+			m.StartLine = int(st.StartPosition.Line)
+		}
+		if st.EndPosition != nil {
+			m.EndLine = int(st.EndPosition.Line)
+		}
+		d.Statements = append(d.Statements, m)
+	}
+
+	return d
+}
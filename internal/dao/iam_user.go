@@ -3,8 +3,11 @@ package dao
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/a1s/a1s/internal/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -129,6 +132,10 @@ func (i *IAMUser) ToJSON(path string) (string, error) {
 
 // Delete deletes an IAM user. If force is true, cleans up all dependencies first.
 func (i *IAMUser) Delete(ctx context.Context, path string, force bool) error {
+	if aws.IsDryRun(ctx) {
+		return nil
+	}
+
 	username := parseUserPath(path)
 	if username == "" {
 		return fmt.Errorf("username cannot be empty")
@@ -304,6 +311,18 @@ type AccessKeyMetadata struct {
 	AccessKeyID string
 	Status      string
 	CreateDate  string
+	CreatedAt   *time.Time
+	LastUsed    *time.Time // nil if never used or not fetched
+}
+
+// Stale reports whether the key is older than maxAge or has not been used for maxAge.
+// Keys that were never used are judged by their age alone.
+func (k AccessKeyMetadata) Stale(maxAge time.Duration) bool {
+	cutoff := time.Now().Add(-maxAge)
+	if k.CreatedAt != nil && k.CreatedAt.Before(cutoff) {
+		return true
+	}
+	return k.LastUsed != nil && k.LastUsed.Before(cutoff)
 }
 
 // ListAccessKeys lists all access keys for a user.
@@ -331,6 +350,7 @@ func (i *IAMUser) ListAccessKeys(ctx context.Context, username string) ([]Access
 		metadata.Status = string(key.Status)
 		if key.CreateDate != nil {
 			metadata.CreateDate = key.CreateDate.Format("2006-01-02 15:04:05")
+			metadata.CreatedAt = key.CreateDate
 		}
 		keys = append(keys, metadata)
 	}
@@ -338,6 +358,58 @@ func (i *IAMUser) ListAccessKeys(ctx context.Context, username string) ([]Access
 	return keys, nil
 }
 
+// AccessKeyUsage lists all access keys for a user along with when each was last used.
+// Keys whose last use cannot be fetched keep LastUsed unset and are still
+// returned, with an error wrapping ErrPartialList.
+func (i *IAMUser) AccessKeyUsage(ctx context.Context, username string) ([]AccessKeyMetadata, error) {
+	keys, err := i.ListAccessKeys(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	client := i.Client().IAM()
+	if client == nil {
+		return nil, fmt.Errorf("failed to get IAM client")
+	}
+
+	var errs []error
+	for idx := range keys {
+		output, err := client.GetAccessKeyLastUsed(ctx, &iam.GetAccessKeyLastUsedInput{
+			AccessKeyId: &keys[idx].AccessKeyID,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("key %s: %w", keys[idx].AccessKeyID, aws.WrapAWSError(err, "get access key last used")))
+			continue
+		}
+		if output.AccessKeyLastUsed != nil {
+			keys[idx].LastUsed = output.AccessKeyLastUsed.LastUsedDate
+		}
+	}
+
+	if len(errs) > 0 {
+		return keys, fmt.Errorf("%w: %w", ErrPartialList, errors.Join(errs...))
+	}
+	return keys, nil
+}
+
+// StaleAccessKeys returns the user's access keys that are older than maxAge
+// or have not been used within maxAge.
+func (i *IAMUser) StaleAccessKeys(ctx context.Context, username string, maxAge time.Duration) ([]AccessKeyMetadata, error) {
+	keys, err := i.AccessKeyUsage(ctx, username)
+	if err != nil && !errors.Is(err, ErrPartialList) {
+		return nil, err
+	}
+
+	stale := make([]AccessKeyMetadata, 0, len(keys))
+	for _, key := range keys {
+		if key.Stale(maxAge) {
+			stale = append(stale, key)
+		}
+	}
+
+	return stale, err
+}
+
 // AccessKey contains full access key information including the secret.
 type AccessKey struct {
 	AccessKeyID     string
@@ -383,6 +455,10 @@ func (i *IAMUser) CreateAccessKey(ctx context.Context, username string) (*Access
 
 // DeleteAccessKey deletes an access key for a user.
 func (i *IAMUser) DeleteAccessKey(ctx context.Context, username, accessKeyID string) error {
+	if aws.IsDryRun(ctx) {
+		return nil
+	}
+
 	client := i.Client().IAM()
 	if client == nil {
 		return fmt.Errorf("failed to get IAM client")
@@ -453,6 +529,101 @@ func (i *IAMUser) ListAttachedPolicies(ctx context.Context, username string) ([]
 	return policies, nil
 }
 
+// ListInlinePolicies lists the names of a user's inline policies.
+func (i *IAMUser) ListInlinePolicies(ctx context.Context, username string) ([]string, error) {
+	client := i.Client().IAM()
+	if client == nil {
+		return nil, fmt.Errorf("failed to get IAM client")
+	}
+
+	paginator := iam.NewListUserPoliciesPaginator(client, &iam.ListUserPoliciesInput{
+		UserName: &username,
+	})
+
+	var policies []string
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, aws.WrapAWSError(err, "list user policies")
+		}
+		policies = append(policies, output.PolicyNames...)
+	}
+
+	return policies, nil
+}
+
+// PolicyCountLister lists principals with the number of policies and groups
+// granting them permissions. It costs extra API calls per principal.
+type PolicyCountLister interface {
+	ListWithPolicyCounts(ctx context.Context, region string) ([]AWSObject, error)
+}
+
+// IAMUserObject is an IAM user with counts of what grants it permissions.
+// A count of -1 means it could not be fetched.
+type IAMUserObject struct {
+	AWSObject
+	AttachedPolicies int
+	InlinePolicies   int
+	Groups           int
+}
+
+// Policies returns the number of attached and inline policies, or -1 if
+// either is unknown.
+func (u *IAMUserObject) Policies() int {
+	if u.AttachedPolicies < 0 || u.InlinePolicies < 0 {
+		return -1
+	}
+	return u.AttachedPolicies + u.InlinePolicies
+}
+
+// ListWithPolicyCounts lists users and counts their attached policies,
+// inline policies and groups concurrently. Users whose counts fail are
+// still returned, with an error wrapping ErrPartialList.
+func (i *IAMUser) ListWithPolicyCounts(ctx context.Context, region string) ([]AWSObject, error) {
+	users, err := i.List(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mx   sync.Mutex
+		errs []error
+	)
+	objects, _ := fetchConcurrently(ctx, len(users), func(ctx context.Context, n int) (AWSObject, error) {
+		name := users[n].GetName()
+		obj := &IAMUserObject{AWSObject: users[n], AttachedPolicies: -1, InlinePolicies: -1, Groups: -1}
+
+		var failed []error
+		if attached, err := i.ListAttachedPolicies(ctx, name); err == nil {
+			obj.AttachedPolicies = len(attached)
+		} else {
+			failed = append(failed, err)
+		}
+		if inline, err := i.ListInlinePolicies(ctx, name); err == nil {
+			obj.InlinePolicies = len(inline)
+		} else {
+			failed = append(failed, err)
+		}
+		if groups, err := i.ListGroups(ctx, name); err == nil {
+			obj.Groups = len(groups)
+		} else {
+			failed = append(failed, err)
+		}
+
+		if len(failed) > 0 {
+			mx.Lock()
+			errs = append(errs, fmt.Errorf("user %s: %w", name, errors.Join(failed...)))
+			mx.Unlock()
+		}
+		return obj, nil
+	})
+
+	if len(errs) > 0 {
+		return objects, fmt.Errorf("%w: %w", ErrPartialList, errors.Join(errs...))
+	}
+	return objects, nil
+}
+
 // userToAWSObject converts an IAM user to an AWSObject.
 func userToAWSObject(user types.User) AWSObject {
 	tags := make(map[string]string)
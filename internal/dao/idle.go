@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// UsageLister lists resources annotated with what references them, for
+// resources whose idle state cannot be told from the object alone. It costs
+// extra API calls per region.
+type UsageLister interface {
+	ListWithUsage(ctx context.Context, region string) ([]AWSObject, error)
+}
+
+// HasIdleCheck reports whether IsIdle can flag idle resources of a type.
+func HasIdleCheck(rid *ResourceID) bool {
+	if rid == nil {
+		return false
+	}
+	switch rid.String() {
+	case "ec2/volume", "ec2/securitygroup", "vpc/securitygroup":
+		return true
+	}
+	return false
+}
+
+// IsIdle reports whether a resource is allocated but unused, and so likely
+// costing money or cluttering the account for nothing: unattached EBS
+// volumes, unassociated Elastic IPs, detached network interfaces and
+// security groups nothing references. Security groups must come from a
+// UsageLister; the default group is never idle since it cannot be deleted.
+func IsIdle(obj AWSObject) bool {
+	if obj == nil {
+		return false
+	}
+
+	if sg, ok := obj.(*SecurityGroupObject); ok {
+		return sg.References == 0 && !sg.IsDefault()
+	}
+
+	switch raw := obj.GetRaw().(type) {
+	case types.Volume:
+		return raw.State == types.VolumeStateAvailable
+	case types.Address:
+		return raw.AssociationId == nil && raw.InstanceId == nil && raw.NetworkInterfaceId == nil
+	case types.NetworkInterface:
+		return raw.Status == types.NetworkInterfaceStatusAvailable && raw.Attachment == nil
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package dao
+
+import "context"
+
+// Relation points at a resource related to another one, e.g. the security
+// groups of an EC2 instance.
+type Relation struct {
+	RID    ResourceID
+	Path   string // DAO path of the related resource, e.g. "region/sg-id"
+	Detail string // short hint such as a name or device
+}
+
+// Relater lists the resources related to an AWS resource.
+type Relater interface {
+	Related(ctx context.Context, obj AWSObject) ([]Relation, error)
+}
+
+// Related returns the resources related to obj, or nil when the accessor's
+// resource type has no known relationships. Relations that could be resolved
+// are returned alongside an error wrapping ErrPartialList.
+func Related(ctx context.Context, accessor Accessor, obj AWSObject) ([]Relation, error) {
+	r, ok := accessor.(Relater)
+	if !ok {
+		return nil, nil
+	}
+	return r.Related(ctx, obj)
+}
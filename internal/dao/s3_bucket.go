@@ -3,11 +3,14 @@ package dao
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 
 	awsinternal "github.com/a1s/a1s/internal/aws"
 )
@@ -16,6 +19,9 @@ func init() {
 	RegisterAccessor(&S3BucketRID, &S3Bucket{})
 }
 
+// maxLocationLookups bounds the GetBucketLocation calls in flight while listing.
+const maxLocationLookups = 10
+
 // S3Bucket is the DAO for S3 buckets.
 type S3Bucket struct {
 	AWSResource
@@ -37,24 +43,26 @@ func (s *S3Bucket) List(ctx context.Context, region string) ([]AWSObject, error)
 	// Normalize region filter
 	filterByRegion := region != "" && region != "all" && region != "*" && region != awsinternal.RegionAll
 
-	var buckets []AWSObject
-	for _, bucket := range output.Buckets {
-		// Get bucket location for each bucket
+	// Look up bucket locations concurrently, keeping the list ordered by name.
+	// A failed lookup leaves the location blank rather than dropping the bucket.
+	sort.Slice(output.Buckets, func(i, j int) bool {
+		return awsinternal.SafeString(output.Buckets[i].Name) < awsinternal.SafeString(output.Buckets[j].Name)
+	})
+	buckets, _ := fetchWithWorkers(ctx, len(output.Buckets), maxLocationLookups, func(ctx context.Context, i int) (AWSObject, error) {
+		bucket := output.Buckets[i]
 		location := ""
 		if bucket.Name != nil {
-			loc, err := s.GetLocation(ctx, *bucket.Name)
-			if err == nil {
+			if loc, err := s.GetLocation(ctx, *bucket.Name); err == nil {
 				location = loc
 			}
 		}
 
-		// Filter by region if specified
-		if filterByRegion && location != region {
-			continue
+		// Filter by region if specified; unknown locations are kept
+		if filterByRegion && location != "" && location != region {
+			return nil, nil
 		}
-
-		buckets = append(buckets, bucketToAWSObject(bucket, location))
-	}
+		return bucketToAWSObject(bucket, location), nil
+	})
 
 	return buckets, nil
 }
@@ -112,12 +120,26 @@ func (s *S3Bucket) Describe(path string) (string, error) {
 		sb.WriteString(fmt.Sprintf("Created: %s\n", obj.GetCreatedAt().Format("2006-01-02 15:04:05")))
 	}
 
-	// Get versioning status
+	// Get versioning status (never configured reads as disabled)
 	versioning, err := s.GetVersioning(context.Background(), bucketName)
 	if err == nil {
+		if versioning == "" {
+			versioning = "Disabled"
+		}
 		sb.WriteString(fmt.Sprintf("Versioning: %s\n", versioning))
 	}
 
+	// CloudWatch storage metrics are daily, so show when they were measured
+	if metrics, err := s.GetMetrics(context.Background(), bucketName); err == nil {
+		source := "CloudWatch"
+		if metrics.Counted {
+			source = "listed"
+		}
+		asOf := fmt.Sprintf("(%s %s)", source, metrics.Timestamp.Local().Format("2006-01-02 15:04"))
+		sb.WriteString(fmt.Sprintf("Size: %s %s\n", formatSize(metrics.SizeBytes), asOf))
+		sb.WriteString(fmt.Sprintf("Objects: %d %s\n", metrics.Objects, asOf))
+	}
+
 	// Get bucket policy (if any)
 	policy, err := s.GetPolicy(context.Background(), bucketName)
 	if err == nil && policy != "" {
@@ -148,6 +170,10 @@ func (s *S3Bucket) ToJSON(path string) (string, error) {
 
 // Delete deletes an S3 bucket. If force is true, empties the bucket first.
 func (s *S3Bucket) Delete(ctx context.Context, path string, force bool) error {
+	if awsinternal.IsDryRun(ctx) {
+		return nil
+	}
+
 	bucketName := parseBucketPath(path)
 	if bucketName == "" {
 		return fmt.Errorf("invalid bucket path: %s", path)
@@ -224,33 +250,20 @@ func (s *S3Bucket) emptyBucket(ctx context.Context, client *s3.Client, bucketNam
 
 // GetLocation returns the region where the bucket is located.
 func (s *S3Bucket) GetLocation(ctx context.Context, bucket string) (string, error) {
-	client := s.Client().S3()
+	client := s.Client()
 	if client == nil {
 		return "", fmt.Errorf("failed to get S3 client")
 	}
 
-	input := &s3.GetBucketLocationInput{
-		Bucket: &bucket,
-	}
-
-	output, err := client.GetBucketLocation(ctx, input)
-	if err != nil {
-		return "", awsinternal.WrapAWSError(err, "get bucket location")
-	}
-
-	// AWS returns empty string for us-east-1
-	if output.LocationConstraint == "" {
-		return "us-east-1", nil
-	}
-
-	return string(output.LocationConstraint), nil
+	return client.BucketRegion(ctx, bucket)
 }
 
 // GetPolicy returns the bucket policy as a JSON string.
+// Returns an empty string if the bucket has no policy.
 func (s *S3Bucket) GetPolicy(ctx context.Context, bucket string) (string, error) {
-	client := s.Client().S3()
-	if client == nil {
-		return "", fmt.Errorf("failed to get S3 client")
+	client, err := s.regionalClient(ctx, bucket)
+	if err != nil {
+		return "", err
 	}
 
 	input := &s3.GetBucketPolicyInput{
@@ -259,7 +272,10 @@ func (s *S3Bucket) GetPolicy(ctx context.Context, bucket string) (string, error)
 
 	output, err := client.GetBucketPolicy(ctx, input)
 	if err != nil {
-		// Check if error is NoSuchBucketPolicy (not an error, just no policy)
+		// NoSuchBucketPolicy is not an error, just no policy
+		if isAPIError(err, "NoSuchBucketPolicy") {
+			return "", nil
+		}
 		return "", awsinternal.WrapAWSError(err, "get bucket policy")
 	}
 
@@ -271,10 +287,11 @@ func (s *S3Bucket) GetPolicy(ctx context.Context, bucket string) (string, error)
 }
 
 // GetVersioning returns the versioning status of the bucket.
+// Returns an empty string if versioning has never been configured.
 func (s *S3Bucket) GetVersioning(ctx context.Context, bucket string) (string, error) {
-	client := s.Client().S3()
-	if client == nil {
-		return "", fmt.Errorf("failed to get S3 client")
+	client, err := s.regionalClient(ctx, bucket)
+	if err != nil {
+		return "", err
 	}
 
 	input := &s3.GetBucketVersioningInput{
@@ -287,7 +304,7 @@ func (s *S3Bucket) GetVersioning(ctx context.Context, bucket string) (string, er
 	}
 
 	if output.Status == "" {
-		return "Disabled", nil
+		return "", nil
 	}
 
 	return string(output.Status), nil
@@ -295,9 +312,9 @@ func (s *S3Bucket) GetVersioning(ctx context.Context, bucket string) (string, er
 
 // SetVersioning enables or disables versioning for the bucket.
 func (s *S3Bucket) SetVersioning(ctx context.Context, bucket string, enabled bool) error {
-	client := s.Client().S3()
-	if client == nil {
-		return fmt.Errorf("failed to get S3 client")
+	client, err := s.regionalClient(ctx, bucket)
+	if err != nil {
+		return err
 	}
 
 	status := types.BucketVersioningStatusSuspended
@@ -312,7 +329,7 @@ func (s *S3Bucket) SetVersioning(ctx context.Context, bucket string, enabled boo
 		},
 	}
 
-	_, err := client.PutBucketVersioning(ctx, input)
+	_, err = client.PutBucketVersioning(ctx, input)
 	if err != nil {
 		return awsinternal.WrapAWSError(err, "put bucket versioning")
 	}
@@ -320,6 +337,193 @@ func (s *S3Bucket) SetVersioning(ctx context.Context, bucket string, enabled boo
 	return nil
 }
 
+// SetPolicy replaces the bucket policy with the given JSON document.
+// An empty policy deletes the bucket policy.
+func (s *S3Bucket) SetPolicy(ctx context.Context, bucket, policy string) error {
+	client, err := s.regionalClient(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	if policy == "" {
+		if _, err := client.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{Bucket: &bucket}); err != nil {
+			return awsinternal.WrapAWSError(err, "delete bucket policy")
+		}
+		return nil
+	}
+
+	input := &s3.PutBucketPolicyInput{
+		Bucket: &bucket,
+		Policy: &policy,
+	}
+	if _, err := client.PutBucketPolicy(ctx, input); err != nil {
+		return awsinternal.WrapAWSError(err, "put bucket policy")
+	}
+
+	return nil
+}
+
+// GetEditable returns the bucket's versioning status and policy for editing.
+// A bucket without a policy has a null Policy.
+func (s *S3Bucket) GetEditable(ctx context.Context, path string) (map[string]interface{}, error) {
+	bucket := parseBucketPath(path)
+
+	versioning, err := s.GetVersioning(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.GetPolicy(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	props := map[string]interface{}{
+		"Versioning": versioning,
+		"Policy":     nil,
+	}
+	if policy != "" {
+		var doc interface{}
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			return nil, fmt.Errorf("invalid bucket policy: %w", err)
+		}
+		props["Policy"] = doc
+	}
+
+	return props, nil
+}
+
+// ApplyEdit applies edited versioning and policy settings.
+// Versioning accepts "Enabled" or "Suspended"; a null Policy deletes it.
+func (s *S3Bucket) ApplyEdit(ctx context.Context, path string, patch map[string]interface{}) error {
+	bucket := parseBucketPath(path)
+
+	for key := range patch {
+		if key != "Versioning" && key != "Policy" {
+			return fmt.Errorf("unknown property %q: only Versioning and Policy can be edited", key)
+		}
+	}
+
+	if v, ok := patch["Versioning"]; ok {
+		switch v {
+		case string(types.BucketVersioningStatusEnabled):
+			if err := s.SetVersioning(ctx, bucket, true); err != nil {
+				return err
+			}
+		case string(types.BucketVersioningStatusSuspended):
+			if err := s.SetVersioning(ctx, bucket, false); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("Versioning must be \"Enabled\" or \"Suspended\", got %v", v)
+		}
+	}
+
+	if doc, ok := patch["Policy"]; ok {
+		var policy string
+		if doc != nil {
+			b, err := json.Marshal(doc)
+			if err != nil {
+				return fmt.Errorf("invalid policy: %w", err)
+			}
+			policy = string(b)
+		}
+		if err := s.SetPolicy(ctx, bucket, policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTags returns the tags of an S3 bucket.
+// A bucket without a tag set returns an empty map.
+func (s *S3Bucket) GetTags(ctx context.Context, path string) (map[string]string, error) {
+	bucket := parseBucketPath(path)
+	client, err := s.regionalClient(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		if isAPIError(err, "NoSuchTagSet") {
+			return make(map[string]string), nil
+		}
+		return nil, awsinternal.WrapAWSError(err, "get bucket tagging")
+	}
+
+	tags := make(map[string]string, len(output.TagSet))
+	for _, tag := range output.TagSet {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+// SetTags replaces the tag set of an S3 bucket.
+func (s *S3Bucket) SetTags(ctx context.Context, path string, tags map[string]string) error {
+	bucket := parseBucketPath(path)
+	client, err := s.regionalClient(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	// S3 rejects an empty tag set - remove the tagging configuration instead
+	if len(tags) == 0 {
+		_, err := client.DeleteBucketTagging(ctx, &s3.DeleteBucketTaggingInput{
+			Bucket: &bucket,
+		})
+		if err != nil {
+			return awsinternal.WrapAWSError(err, "delete bucket tagging")
+		}
+		return nil
+	}
+
+	tagSet := make([]types.Tag, 0, len(tags))
+	for k, v := range tags {
+		tagSet = append(tagSet, types.Tag{Key: stringPtr(k), Value: stringPtr(v)})
+	}
+
+	_, err = client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+		Bucket:  &bucket,
+		Tagging: &types.Tagging{TagSet: tagSet},
+	})
+	if err != nil {
+		return awsinternal.WrapAWSError(err, "put bucket tagging")
+	}
+
+	return nil
+}
+
+// regionalClient returns an S3 client for the region the bucket lives in.
+func (s *S3Bucket) regionalClient(ctx context.Context, bucket string) (*s3.Client, error) {
+	if bucket == "" {
+		return nil, fmt.Errorf("invalid bucket path: %s", bucket)
+	}
+
+	region, err := s.GetLocation(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s.Client().S3Regional(region)
+	if client == nil {
+		return nil, fmt.Errorf("failed to get regional S3 client for %s", region)
+	}
+
+	return client, nil
+}
+
+// isAPIError reports whether err is an AWS API error with the given code.
+func isAPIError(err error, code string) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == code
+}
+
 // bucketToAWSObject converts an S3 bucket to an AWSObject.
 func bucketToAWSObject(bucket types.Bucket, location string) AWSObject {
 	var arn string
@@ -0,0 +1,236 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsinternal "github.com/a1s/a1s/internal/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// S3 publishes bucket storage metrics to CloudWatch once a day.
+const (
+	bucketMetricsPeriod = 24 * time.Hour
+	bucketMetricsWindow = 3 * 24 * time.Hour
+	bucketMetricsTTL    = time.Hour
+	// maxCountedObjects is the most objects counted by listing a bucket
+	// without CloudWatch metrics, i.e. one ListObjectsV2 page.
+	maxCountedObjects = 1000
+)
+
+// bucketStorageTypes are the BucketSizeBytes storage types summed into a
+// bucket's size.
+var bucketStorageTypes = []string{
+	"StandardStorage",
+	"IntelligentTieringFAStorage",
+	"IntelligentTieringIAStorage",
+	"IntelligentTieringAIAStorage",
+	"StandardIAStorage",
+	"OneZoneIAStorage",
+	"ReducedRedundancyStorage",
+	"GlacierInstantRetrievalStorage",
+	"GlacierStorage",
+	"DeepArchiveStorage",
+}
+
+// bucketMetricsCache holds recent metrics by profile and bucket name, see
+// metricsCacheKey. The daily metrics do not change within the TTL.
+var bucketMetricsCache = awsinternal.NewResourceCache(&awsinternal.CacheConfig{DefaultTTL: bucketMetricsTTL})
+
+// BucketMetrics summarizes what a bucket stores.
+type BucketMetrics struct {
+	SizeBytes int64
+	Objects   int64
+	// Timestamp is when the values were measured. CloudWatch values lag
+	// by up to a day.
+	Timestamp time.Time
+	// Counted is set when the values come from listing the bucket rather
+	// than from CloudWatch.
+	Counted bool
+}
+
+// S3BucketObject is an S3 bucket with its storage metrics, which are nil
+// when they could not be fetched.
+type S3BucketObject struct {
+	AWSObject
+	Metrics *BucketMetrics
+}
+
+// BucketMetricsLister lists and gets buckets with their storage metrics. It
+// costs a CloudWatch call, or a listing, per bucket.
+type BucketMetricsLister interface {
+	ListWithMetrics(ctx context.Context, region string) ([]AWSObject, error)
+	GetWithMetrics(ctx context.Context, path string) (AWSObject, error)
+}
+
+// ListWithMetrics lists buckets and fetches their metrics concurrently.
+// Buckets whose metrics fail are still returned, without metrics.
+func (s *S3Bucket) ListWithMetrics(ctx context.Context, region string) ([]AWSObject, error) {
+	buckets, err := s.List(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, _ := fetchWithWorkers(ctx, len(buckets), maxLocationLookups, func(ctx context.Context, i int) (AWSObject, error) {
+		obj := &S3BucketObject{AWSObject: buckets[i]}
+		if buckets[i].GetRegion() != "" {
+			if metrics, err := s.GetMetrics(ctx, buckets[i].GetName()); err == nil {
+				obj.Metrics = metrics
+			}
+		}
+		return obj, nil
+	})
+
+	return objects, nil
+}
+
+// GetWithMetrics gets a bucket with its storage metrics, which are nil when
+// they could not be fetched.
+func (s *S3Bucket) GetWithMetrics(ctx context.Context, path string) (AWSObject, error) {
+	bucket, err := s.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &S3BucketObject{AWSObject: bucket}
+	if metrics, err := s.GetMetrics(ctx, bucket.GetName()); err == nil {
+		obj.Metrics = metrics
+	}
+	return obj, nil
+}
+
+// GetMetrics returns the size and object count of a bucket from the daily
+// CloudWatch BucketSizeBytes and NumberOfObjects metrics. Buckets without
+// metrics yet, e.g. new ones, are counted by listing when they hold at most
+// maxCountedObjects objects. Only successful fetches are cached.
+func (s *S3Bucket) GetMetrics(ctx context.Context, bucket string) (*BucketMetrics, error) {
+	key := s.metricsCacheKey(bucket)
+	if m, ok := bucketMetricsCache.Get(key); ok {
+		if m, ok := m.(*BucketMetrics); ok {
+			return m, nil
+		}
+	}
+
+	metrics, err := s.fetchMetrics(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketMetricsCache.Set(key, metrics)
+	return metrics, nil
+}
+
+// metricsCacheKey keys a bucket's metrics by the active profile too, since
+// another profile may not see the bucket or may see a different one.
+func (s *S3Bucket) metricsCacheKey(bucket string) string {
+	profile := ""
+	if client := s.Client(); client != nil {
+		profile = client.ActiveProfile()
+	}
+	return profile + "/" + bucket
+}
+
+// fetchMetrics reads a bucket's metrics from CloudWatch, falling back to
+// counting its objects.
+func (s *S3Bucket) fetchMetrics(ctx context.Context, bucket string) (*BucketMetrics, error) {
+	region, err := s.GetLocation(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := s.cloudWatchMetrics(ctx, region, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if metrics == nil {
+		return s.countObjects(ctx, bucket)
+	}
+	return metrics, nil
+}
+
+// cloudWatchMetrics reads the latest daily storage metrics of a bucket, or
+// returns nil when CloudWatch has none.
+func (s *S3Bucket) cloudWatchMetrics(ctx context.Context, region, bucket string) (*BucketMetrics, error) {
+	client := s.Client()
+	if client == nil {
+		return nil, fmt.Errorf("failed to get AWS client")
+	}
+
+	queries := make([]awsinternal.MetricQuery, 0, len(bucketStorageTypes)+1)
+	queries = append(queries, bucketMetricQuery("objects", "NumberOfObjects", bucket, "AllStorageTypes"))
+	for i, storageType := range bucketStorageTypes {
+		queries = append(queries, bucketMetricQuery(fmt.Sprintf("size%d", i), "BucketSizeBytes", bucket, storageType))
+	}
+
+	end := time.Now()
+	series, err := client.GetMetricData(ctx, region, queries, end.Add(-bucketMetricsWindow), end)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics *BucketMetrics
+	for i, ser := range series {
+		n := len(ser.Values)
+		if n == 0 {
+			continue
+		}
+		if metrics == nil {
+			metrics = &BucketMetrics{}
+		}
+		if i == 0 {
+			metrics.Objects = int64(ser.Values[n-1])
+		} else {
+			metrics.SizeBytes += int64(ser.Values[n-1])
+		}
+		if ts := ser.Timestamps[n-1]; ts.After(metrics.Timestamp) {
+			metrics.Timestamp = ts
+		}
+	}
+
+	return metrics, nil
+}
+
+// bucketMetricQuery selects a daily S3 storage metric.
+func bucketMetricQuery(id, name, bucket, storageType string) awsinternal.MetricQuery {
+	return awsinternal.MetricQuery{
+		ID:         id,
+		Namespace:  "AWS/S3",
+		MetricName: name,
+		Dimensions: map[string]string{"BucketName": bucket, "StorageType": storageType},
+		Stat:       "Average",
+		Period:     bucketMetricsPeriod,
+	}
+}
+
+// countObjects sums the objects of a small bucket from one listing page.
+func (s *S3Bucket) countObjects(ctx context.Context, bucket string) (*BucketMetrics, error) {
+	client, err := s.regionalClient(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	maxKeys := int32(maxCountedObjects)
+	output, err := client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+		Bucket:  &bucket,
+		MaxKeys: &maxKeys,
+	})
+	if err != nil {
+		return nil, awsinternal.WrapAWSError(err, "list objects")
+	}
+	if output.IsTruncated != nil && *output.IsTruncated {
+		return nil, fmt.Errorf("no CloudWatch storage metrics for %s yet and it holds over %d objects", bucket, maxCountedObjects)
+	}
+
+	metrics := &BucketMetrics{
+		Objects:   int64(len(output.Contents)),
+		Timestamp: time.Now(),
+		Counted:   true,
+	}
+	for _, obj := range output.Contents {
+		if obj.Size != nil {
+			metrics.SizeBytes += *obj.Size
+		}
+	}
+	return metrics, nil
+}
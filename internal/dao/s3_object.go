@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,24 +27,72 @@ type S3Object struct {
 type ListResult struct {
 	Objects        []AWSObject
 	CommonPrefixes []string
+	// NextToken is the continuation token for the next page, empty on the last page.
+	NextToken string
 }
 
 // List returns objects in a bucket with hierarchical navigation.
 // Path format: "bucket" or "bucket/prefix/"
 // Uses Delimiter="/" for folder-like navigation.
 func (s *S3Object) List(ctx context.Context, path string) ([]AWSObject, error) {
+	var (
+		objects []AWSObject
+		token   string
+	)
+	for {
+		page, err := s.ListPage(ctx, path, token, 0)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, page.Objects...)
+
+		if page.NextToken == "" {
+			return objects, nil
+		}
+		token = page.NextToken
+	}
+}
+
+// ListPage returns a single page of objects in a bucket with hierarchical navigation.
+// Path format: "bucket" or "bucket/prefix/". An empty token starts from the
+// beginning; pageSize <= 0 uses the S3 default (1000). Folders are returned
+// as objects alongside files, as with List.
+func (s *S3Object) ListPage(ctx context.Context, path, token string, pageSize int32) (*ListResult, error) {
+	return s.listPage(ctx, path, token, pageSize, "/")
+}
+
+// ListRecursive returns every object under path, including nested prefixes.
+// Path format: "bucket" or "bucket/prefix/". No folders are returned; zero-byte
+// "folder marker" keys are included as regular objects.
+func (s *S3Object) ListRecursive(ctx context.Context, path string) ([]AWSObject, error) {
+	var (
+		objects []AWSObject
+		token   string
+	)
+	for {
+		page, err := s.listPage(ctx, path, token, 0, "")
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, page.Objects...)
+
+		if page.NextToken == "" {
+			return objects, nil
+		}
+		token = page.NextToken
+	}
+}
+
+// listPage returns one page of objects under path. An empty delimiter lists
+// recursively instead of grouping keys into folders.
+func (s *S3Object) listPage(ctx context.Context, path, token string, pageSize int32, delimiter string) (*ListResult, error) {
 	bucket, prefix := parseListPath(path)
 	if bucket == "" {
 		return nil, fmt.Errorf("invalid path format, expected 'bucket' or 'bucket/prefix/', got: %s", path)
 	}
 
-	client := s.Client().S3()
-	if client == nil {
-		return nil, fmt.Errorf("failed to get S3 client")
-	}
-
 	// Get bucket region
-	region, err := s.getBucketRegion(ctx, client, bucket)
+	region, err := s.getBucketRegion(ctx, bucket)
 	if err != nil {
 		return nil, err
 	}
@@ -55,36 +104,45 @@ func (s *S3Object) List(ctx context.Context, path string) ([]AWSObject, error) {
 	}
 
 	input := &s3.ListObjectsV2Input{
-		Bucket:    &bucket,
-		Delimiter: stringPtr("/"),
+		Bucket: &bucket,
 	}
 
+	if delimiter != "" {
+		input.Delimiter = &delimiter
+	}
 	if prefix != "" {
 		input.Prefix = &prefix
 	}
+	if token != "" {
+		input.ContinuationToken = &token
+	}
+	if pageSize > 0 {
+		input.MaxKeys = &pageSize
+	}
 
-	paginator := s3.NewListObjectsV2Paginator(regionalClient, input)
+	output, err := regionalClient.ListObjectsV2(ctx, input)
+	if err != nil {
+		return nil, aws.WrapAWSError(err, "list objects")
+	}
 
-	var objects []AWSObject
-	for paginator.HasMorePages() {
-		output, err := paginator.NextPage(ctx)
-		if err != nil {
-			return nil, aws.WrapAWSError(err, "list objects")
-		}
+	result := &ListResult{}
+	for _, obj := range output.Contents {
+		result.Objects = append(result.Objects, objectToAWSObject(obj, bucket, region))
+	}
 
-		for _, obj := range output.Contents {
-			objects = append(objects, objectToAWSObject(obj, bucket, region))
+	// Include common prefixes as folder objects
+	for _, prefix := range output.CommonPrefixes {
+		if prefix.Prefix != nil {
+			result.CommonPrefixes = append(result.CommonPrefixes, *prefix.Prefix)
+			result.Objects = append(result.Objects, folderToAWSObject(*prefix.Prefix, bucket, region))
 		}
+	}
 
-		// Include common prefixes as folder objects
-		for _, prefix := range output.CommonPrefixes {
-			if prefix.Prefix != nil {
-				objects = append(objects, folderToAWSObject(*prefix.Prefix, bucket, region))
-			}
-		}
+	if output.IsTruncated != nil && *output.IsTruncated && output.NextContinuationToken != nil {
+		result.NextToken = *output.NextContinuationToken
 	}
 
-	return objects, nil
+	return result, nil
 }
 
 // Get retrieves a single S3 object metadata by path.
@@ -95,9 +153,16 @@ func (s *S3Object) Get(ctx context.Context, path string) (AWSObject, error) {
 		return nil, err
 	}
 
-	client := s.Client().S3()
-	if client == nil {
-		return nil, fmt.Errorf("failed to get S3 client")
+	// Get bucket region
+	region, err := s.getBucketRegion(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use regional client for object operations
+	regionalClient := s.Client().S3Regional(region)
+	if regionalClient == nil {
+		return nil, fmt.Errorf("failed to get regional S3 client for %s", region)
 	}
 
 	input := &s3.HeadObjectInput{
@@ -105,19 +170,60 @@ func (s *S3Object) Get(ctx context.Context, path string) (AWSObject, error) {
 		Key:    &key,
 	}
 
-	output, err := client.HeadObject(ctx, input)
+	output, err := regionalClient.HeadObject(ctx, input)
 	if err != nil {
 		return nil, aws.WrapAWSError(err, "head object")
 	}
 
-	// Get bucket region
-	region, err := s.getBucketRegion(ctx, client, bucket)
+	// Tags need a separate call - don't fail the lookup if they can't be read
+	tags, err := s.getObjectTags(ctx, regionalClient, bucket, key)
 	if err != nil {
-		return nil, err
+		tags = make(map[string]string)
 	}
 
 	// Convert HeadObject output to AWSObject
-	return headObjectToAWSObject(output, bucket, key, region), nil
+	return headObjectToAWSObject(output, bucket, key, region, tags), nil
+}
+
+// GetTags retrieves the tags of an S3 object by path ("bucket/key").
+// Unlike Get, it fails when the tags cannot be read.
+func (s *S3Object) GetTags(ctx context.Context, path string) (map[string]string, error) {
+	bucket, key, err := parseObjectPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	region, err := s.getBucketRegion(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s.Client().S3Regional(region)
+	if client == nil {
+		return nil, fmt.Errorf("failed to get regional S3 client for %s", region)
+	}
+
+	return s.getObjectTags(ctx, client, bucket, key)
+}
+
+// getObjectTags retrieves the tag set of an S3 object.
+func (s *S3Object) getObjectTags(ctx context.Context, client *s3.Client, bucket, key string) (map[string]string, error) {
+	output, err := client.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{
+		Bucket: &bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		return nil, aws.WrapAWSError(err, "get object tagging")
+	}
+
+	tags := make(map[string]string, len(output.TagSet))
+	for _, tag := range output.TagSet {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
 }
 
 // Describe returns a formatted description of the S3 object.
@@ -158,10 +264,16 @@ func (s *S3Object) Describe(path string) (string, error) {
 		sb.WriteString(fmt.Sprintf("Last Modified: %s\n", obj.GetCreatedAt().Format("2006-01-02 15:04:05")))
 	}
 
-	if len(obj.GetTags()) > 0 {
+	if tags := obj.GetTags(); len(tags) > 0 {
+		keys := make([]string, 0, len(tags))
+		for k := range tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
 		sb.WriteString("Tags:\n")
-		for k, v := range obj.GetTags() {
-			sb.WriteString(fmt.Sprintf("  %s: %s\n", k, v))
+		for _, k := range keys {
+			sb.WriteString(fmt.Sprintf("  %s: %s\n", k, tags[k]))
 		}
 	}
 
@@ -185,18 +297,17 @@ func (s *S3Object) ToJSON(path string) (string, error) {
 
 // Delete deletes an S3 object or objects with a prefix.
 func (s *S3Object) Delete(ctx context.Context, path string, force bool) error {
+	if aws.IsDryRun(ctx) {
+		return nil
+	}
+
 	bucket, key, err := parseObjectPath(path)
 	if err != nil {
 		return err
 	}
 
-	client := s.Client().S3()
-	if client == nil {
-		return fmt.Errorf("failed to get S3 client")
-	}
-
 	// Get bucket region for regional access
-	region, err := s.getBucketRegion(ctx, client, bucket)
+	region, err := s.getBucketRegion(ctx, bucket)
 	if err != nil {
 		return err
 	}
@@ -225,6 +336,107 @@ func (s *S3Object) Delete(ctx context.Context, path string, force bool) error {
 	return nil
 }
 
+// ObjectVersion is one version of an S3 object, or a delete marker.
+type ObjectVersion struct {
+	VersionID    string
+	IsLatest     bool
+	DeleteMarker bool
+	Size         int64
+	LastModified *time.Time
+}
+
+// ListVersions returns every version and delete marker of a key, newest first.
+func (s *S3Object) ListVersions(ctx context.Context, bucket, key string) ([]ObjectVersion, error) {
+	region, err := s.getBucketRegion(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	regionalClient := s.Client().S3Regional(region)
+	if regionalClient == nil {
+		return nil, fmt.Errorf("failed to get regional S3 client for %s", region)
+	}
+
+	// Prefix also matches longer keys, so results are filtered to the exact key
+	paginator := s3.NewListObjectVersionsPaginator(regionalClient, &s3.ListObjectVersionsInput{
+		Bucket: &bucket,
+		Prefix: &key,
+	})
+
+	var versions []ObjectVersion
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, aws.WrapAWSError(err, "list object versions")
+		}
+
+		for _, v := range output.Versions {
+			if aws.StringValue(v.Key) != key {
+				continue
+			}
+			version := ObjectVersion{
+				VersionID:    aws.StringValue(v.VersionId),
+				IsLatest:     aws.BoolValue(v.IsLatest),
+				LastModified: v.LastModified,
+			}
+			if v.Size != nil {
+				version.Size = *v.Size
+			}
+			versions = append(versions, version)
+		}
+		for _, m := range output.DeleteMarkers {
+			if aws.StringValue(m.Key) != key {
+				continue
+			}
+			versions = append(versions, ObjectVersion{
+				VersionID:    aws.StringValue(m.VersionId),
+				IsLatest:     aws.BoolValue(m.IsLatest),
+				DeleteMarker: true,
+				LastModified: m.LastModified,
+			})
+		}
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		a, b := versions[i].LastModified, versions[j].LastModified
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.After(*b)
+	})
+
+	return versions, nil
+}
+
+// DeleteVersion permanently deletes one version of an S3 object.
+// Deleting a delete marker restores the previous version.
+func (s *S3Object) DeleteVersion(ctx context.Context, bucket, key, versionID string) error {
+	if aws.IsDryRun(ctx) {
+		return nil
+	}
+
+	region, err := s.getBucketRegion(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	regionalClient := s.Client().S3Regional(region)
+	if regionalClient == nil {
+		return fmt.Errorf("failed to get regional S3 client for %s", region)
+	}
+
+	_, err = regionalClient.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket:    &bucket,
+		Key:       &key,
+		VersionId: &versionID,
+	})
+	if err != nil {
+		return aws.WrapAWSError(err, "delete object version")
+	}
+
+	return nil
+}
+
 // deletePrefix deletes all objects with the specified prefix.
 func (s *S3Object) deletePrefix(ctx context.Context, client *s3.Client, bucket, prefix string, force bool) error {
 	if !force {
@@ -284,13 +496,14 @@ func (s *S3Object) deletePrefix(ctx context.Context, client *s3.Client, bucket,
 
 // Download downloads an S3 object to the provided writer.
 func (s *S3Object) Download(ctx context.Context, bucket, key string, writer io.Writer) error {
-	client := s.Client().S3()
-	if client == nil {
-		return fmt.Errorf("failed to get S3 client")
-	}
+	return s.DownloadVersion(ctx, bucket, key, "", writer)
+}
 
+// DownloadVersion downloads a specific version of an S3 object to the provided
+// writer. An empty versionID downloads the current version.
+func (s *S3Object) DownloadVersion(ctx context.Context, bucket, key, versionID string, writer io.Writer) error {
 	// Get bucket region for regional access
-	region, err := s.getBucketRegion(ctx, client, bucket)
+	region, err := s.getBucketRegion(ctx, bucket)
 	if err != nil {
 		return err
 	}
@@ -304,6 +517,9 @@ func (s *S3Object) Download(ctx context.Context, bucket, key string, writer io.W
 		Bucket: &bucket,
 		Key:    &key,
 	}
+	if versionID != "" {
+		input.VersionId = &versionID
+	}
 
 	output, err := regionalClient.GetObject(ctx, input)
 	if err != nil {
@@ -321,9 +537,15 @@ func (s *S3Object) Download(ctx context.Context, bucket, key string, writer io.W
 
 // Upload uploads data from the reader to an S3 object.
 func (s *S3Object) Upload(ctx context.Context, bucket, key string, reader io.Reader) error {
-	client := s.Client().S3()
-	if client == nil {
-		return fmt.Errorf("failed to get S3 client")
+	// Get bucket region for regional access
+	region, err := s.getBucketRegion(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	regionalClient := s.Client().S3Regional(region)
+	if regionalClient == nil {
+		return fmt.Errorf("failed to get regional S3 client for %s", region)
 	}
 
 	input := &s3.PutObjectInput{
@@ -332,7 +554,7 @@ func (s *S3Object) Upload(ctx context.Context, bucket, key string, reader io.Rea
 		Body:   reader,
 	}
 
-	_, err := client.PutObject(ctx, input)
+	_, err = regionalClient.PutObject(ctx, input)
 	if err != nil {
 		return aws.WrapAWSError(err, "put object")
 	}
@@ -341,10 +563,17 @@ func (s *S3Object) Upload(ctx context.Context, bucket, key string, reader io.Rea
 }
 
 // GetPresignedURL generates a presigned URL for downloading an object.
+// The URL is signed for the bucket's region so it works for buckets
+// outside the current region.
 func (s *S3Object) GetPresignedURL(ctx context.Context, bucket, key string, expiry time.Duration) (string, error) {
-	client := s.Client().S3()
+	region, err := s.getBucketRegion(ctx, bucket)
+	if err != nil {
+		return "", err
+	}
+
+	client := s.Client().S3Regional(region)
 	if client == nil {
-		return "", fmt.Errorf("failed to get S3 client")
+		return "", fmt.Errorf("failed to get regional S3 client for %s", region)
 	}
 
 	presignClient := s3.NewPresignClient(client)
@@ -413,7 +642,8 @@ func folderToAWSObject(prefix, bucket, region string) AWSObject {
 }
 
 // headObjectToAWSObject converts HeadObject output to AWSObject.
-func headObjectToAWSObject(output *s3.HeadObjectOutput, bucket, key, region string) AWSObject {
+// HeadObject doesn't return tags, so they are passed in from GetObjectTagging.
+func headObjectToAWSObject(output *s3.HeadObjectOutput, bucket, key, region string, tags map[string]string) AWSObject {
 	// Extract name from key
 	name := key
 	if idx := strings.LastIndex(key, "/"); idx >= 0 {
@@ -422,9 +652,6 @@ func headObjectToAWSObject(output *s3.HeadObjectOutput, bucket, key, region stri
 
 	arn := fmt.Sprintf("arn:aws:s3:::%s/%s", bucket, key)
 
-	tags := make(map[string]string)
-	// HeadObject doesn't return tags directly, would need separate GetObjectTagging call
-
 	return &BaseAWSObject{
 		ARN:       arn,
 		ID:        key,
@@ -475,23 +702,9 @@ func extractBucketFromPath(path string) string {
 	return bucket
 }
 
-// getBucketRegion retrieves the region of a bucket.
-func (s *S3Object) getBucketRegion(ctx context.Context, client *s3.Client, bucket string) (string, error) {
-	input := &s3.GetBucketLocationInput{
-		Bucket: &bucket,
-	}
-
-	output, err := client.GetBucketLocation(ctx, input)
-	if err != nil {
-		return "", aws.WrapAWSError(err, "get bucket location")
-	}
-
-	// If LocationConstraint is empty, bucket is in us-east-1
-	if output.LocationConstraint == "" {
-		return "us-east-1", nil
-	}
-
-	return string(output.LocationConstraint), nil
+// getBucketRegion retrieves the region of a bucket, cached by the client.
+func (s *S3Object) getBucketRegion(ctx context.Context, bucket string) (string, error) {
+	return s.Client().BucketRegion(ctx, bucket)
 }
 
 // formatSize formats a byte size into a human-readable string.
@@ -13,6 +13,7 @@ import (
 
 func init() {
 	RegisterAccessor(&EC2SecurityGroupRID, &SecurityGroup{})
+	RegisterAccessor(&VPCSecurityGroupRID, &SecurityGroup{})
 }
 
 // SecurityGroup implements DAO for EC2 Security Groups.
@@ -20,7 +21,7 @@ type SecurityGroup struct {
 	AWSResource
 }
 
-// List retrieves all security groups in the specified region.
+// List retrieves all security groups in the specified region using pagination.
 func (sg *SecurityGroup) List(ctx context.Context, region string) ([]AWSObject, error) {
 	factory := sg.getFactory()
 	if factory == nil {
@@ -32,20 +33,36 @@ func (sg *SecurityGroup) List(ctx context.Context, region string) ([]AWSObject,
 		return nil, fmt.Errorf("failed to get EC2 client for region: %s", region)
 	}
 
-	input := &ec2.DescribeSecurityGroupsInput{}
-	result, err := client.DescribeSecurityGroups(ctx, input)
+	groups, err := listSecurityGroups(ctx, client)
 	if err != nil {
-		return nil, aws.WrapAWSError(err, "DescribeSecurityGroups")
+		return nil, err
 	}
 
-	objects := make([]AWSObject, 0, len(result.SecurityGroups))
-	for _, securityGroup := range result.SecurityGroups {
-		objects = append(objects, sgToAWSObject(securityGroup, region))
+	account := sg.accountID()
+	objects := make([]AWSObject, 0, len(groups))
+	for _, securityGroup := range groups {
+		objects = append(objects, sgToAWSObject(securityGroup, region, account))
 	}
 
 	return objects, nil
 }
 
+// listSecurityGroups collects the security groups from every page.
+func listSecurityGroups(ctx context.Context, client ec2.DescribeSecurityGroupsAPIClient) ([]types.SecurityGroup, error) {
+	var groups []types.SecurityGroup
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(client, &ec2.DescribeSecurityGroupsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, aws.WrapAWSError(err, "DescribeSecurityGroups")
+		}
+		groups = append(groups, page.SecurityGroups...)
+	}
+
+	return groups, nil
+}
+
 // Get retrieves a specific security group by path.
 // Path format: "region/sg-id"
 func (sg *SecurityGroup) Get(ctx context.Context, path string) (AWSObject, error) {
@@ -77,7 +94,94 @@ func (sg *SecurityGroup) Get(ctx context.Context, path string) (AWSObject, error
 		return nil, fmt.Errorf("security group not found: %s", sgID)
 	}
 
-	return sgToAWSObject(result.SecurityGroups[0], region), nil
+	return sgToAWSObject(result.SecurityGroups[0], region, sg.accountID()), nil
+}
+
+// SecurityGroupObject is a security group with a count of what references
+// it. A count of -1 means it could not be fetched.
+type SecurityGroupObject struct {
+	AWSObject
+	// References counts network interfaces using the group and rules of
+	// other groups naming it.
+	References int
+}
+
+// IsDefault reports whether this is a VPC's default security group.
+func (s *SecurityGroupObject) IsDefault() bool {
+	group, ok := s.GetRaw().(types.SecurityGroup)
+	return ok && aws.SafeString(group.GroupName) == "default"
+}
+
+// ListWithUsage lists security groups with how many network interfaces
+// and other groups' rules reference each. Every instance, load balancer,
+// database or Lambda in a VPC holds its groups through a network interface.
+func (sg *SecurityGroup) ListWithUsage(ctx context.Context, region string) ([]AWSObject, error) {
+	groups, err := sg.List(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make(map[string]int)
+	for _, obj := range groups {
+		group, ok := obj.GetRaw().(types.SecurityGroup)
+		if !ok {
+			continue
+		}
+		self := aws.SafeString(group.GroupId)
+		for _, perms := range [][]types.IpPermission{group.IpPermissions, group.IpPermissionsEgress} {
+			for _, perm := range perms {
+				for _, pair := range perm.UserIdGroupPairs {
+					if id := aws.SafeString(pair.GroupId); id != "" && id != self {
+						refs[id]++
+					}
+				}
+			}
+		}
+	}
+
+	enis, eniErr := sg.countInterfaceGroups(ctx, region)
+	objects := make([]AWSObject, 0, len(groups))
+	for _, obj := range groups {
+		wrapped := &SecurityGroupObject{AWSObject: obj, References: -1}
+		if eniErr == nil {
+			wrapped.References = refs[obj.GetID()] + enis[obj.GetID()]
+		}
+		objects = append(objects, wrapped)
+	}
+
+	if eniErr != nil {
+		return objects, fmt.Errorf("%w: %w", ErrPartialList, eniErr)
+	}
+	return objects, nil
+}
+
+// countInterfaceGroups counts the network interfaces using each group.
+func (sg *SecurityGroup) countInterfaceGroups(ctx context.Context, region string) (map[string]int, error) {
+	factory := sg.getFactory()
+	if factory == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	client := factory.Client().EC2(region)
+	if client == nil {
+		return nil, fmt.Errorf("failed to get EC2 client for region: %s", region)
+	}
+
+	counts := make(map[string]int)
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(client, &ec2.DescribeNetworkInterfacesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, aws.WrapAWSError(err, "DescribeNetworkInterfaces")
+		}
+		for _, eni := range page.NetworkInterfaces {
+			for _, group := range eni.Groups {
+				counts[aws.SafeString(group.GroupId)]++
+			}
+		}
+	}
+
+	return counts, nil
 }
 
 // Describe returns a formatted description of the security group.
@@ -151,160 +255,321 @@ func (sg *SecurityGroup) Delete(ctx context.Context, path string, force bool) er
 
 	input := &ec2.DeleteSecurityGroupInput{
 		GroupId: &sgID,
+		DryRun:  aws.DryRunFlag(ctx),
 	}
 
 	_, err = client.DeleteSecurityGroup(ctx, input)
-	if err != nil {
+	if err = aws.DryRunResult(err); err != nil {
 		return aws.WrapAWSError(err, "DeleteSecurityGroup")
 	}
 
 	return nil
 }
 
-// AddIngressRule adds an ingress rule to a security group.
-func (sg *SecurityGroup) AddIngressRule(ctx context.Context, sgID, protocol string, fromPort, toPort int32, cidr string) error {
-	factory := sg.getFactory()
-	if factory == nil {
-		return fmt.Errorf("factory not initialized")
-	}
+// SecurityGroupRule is a single ingress or egress rule with one source or destination.
+type SecurityGroupRule struct {
+	Egress      bool
+	Protocol    string // "tcp", "udp", "icmp" or "-1" for all
+	FromPort    int32  // -1 when the rule covers all ports
+	ToPort      int32
+	Peer        string // IPv4/IPv6 CIDR, security group ID or prefix list ID
+	Description string
+}
 
-	region := factory.Region()
-	client := factory.Client().EC2(region)
-	if client == nil {
-		return fmt.Errorf("failed to get EC2 client for region: %s", region)
+// Direction returns "ingress" or "egress".
+func (r SecurityGroupRule) Direction() string {
+	if r.Egress {
+		return "egress"
 	}
+	return "ingress"
+}
 
-	ipPermission := types.IpPermission{
-		IpProtocol: &protocol,
-		FromPort:   &fromPort,
-		ToPort:     &toPort,
-		IpRanges: []types.IpRange{
-			{CidrIp: &cidr},
-		},
+// Ports returns the port range in a readable form.
+func (r SecurityGroupRule) Ports() string {
+	if r.Protocol == "-1" || r.FromPort == -1 {
+		return "All"
 	}
-
-	input := &ec2.AuthorizeSecurityGroupIngressInput{
-		GroupId:       &sgID,
-		IpPermissions: []types.IpPermission{ipPermission},
+	if r.FromPort == r.ToPort {
+		return fmt.Sprintf("%d", r.FromPort)
 	}
+	return fmt.Sprintf("%d-%d", r.FromPort, r.ToPort)
+}
 
-	_, err := client.AuthorizeSecurityGroupIngress(ctx, input)
+// Key returns a string uniquely identifying the rule within its security group.
+func (r SecurityGroupRule) Key() string {
+	return fmt.Sprintf("%s/%s/%s/%s", r.Direction(), r.Protocol, r.Ports(), r.Peer)
+}
+
+// ListRules returns the ingress and egress rules of a security group, one per source.
+// Path format: "region/sg-id"
+func (sg *SecurityGroup) ListRules(ctx context.Context, path string) ([]SecurityGroupRule, error) {
+	obj, err := sg.Get(ctx, path)
 	if err != nil {
-		return aws.WrapAWSError(err, "AuthorizeSecurityGroupIngress")
+		return nil, err
 	}
 
-	return nil
+	raw, ok := obj.GetRaw().(types.SecurityGroup)
+	if !ok {
+		return nil, fmt.Errorf("unexpected security group data for %s", path)
+	}
+
+	rules := flattenRules(raw.IpPermissions, false)
+	return append(rules, flattenRules(raw.IpPermissionsEgress, true)...), nil
+}
+
+// AddIngressRule adds an ingress rule to a security group in the factory's region.
+// source may be an IPv4/IPv6 CIDR, a prefix list or a security group ID,
+// which matches traffic from the group's members.
+func (sg *SecurityGroup) AddIngressRule(ctx context.Context, sgID, protocol string, fromPort, toPort int32, source string) error {
+	rule := SecurityGroupRule{Protocol: protocol, FromPort: fromPort, ToPort: toPort, Peer: source}
+	return sg.changeRule(ctx, "", sgID, rule, true)
+}
+
+// RemoveIngressRule removes an ingress rule from a security group in the factory's region.
+// source may be an IPv4/IPv6 CIDR, a prefix list or a security group ID,
+// which matches traffic from the group's members.
+func (sg *SecurityGroup) RemoveIngressRule(ctx context.Context, sgID, protocol string, fromPort, toPort int32, source string) error {
+	rule := SecurityGroupRule{Protocol: protocol, FromPort: fromPort, ToPort: toPort, Peer: source}
+	return sg.changeRule(ctx, "", sgID, rule, false)
 }
 
-// RemoveIngressRule removes an ingress rule from a security group.
-func (sg *SecurityGroup) RemoveIngressRule(ctx context.Context, sgID, protocol string, fromPort, toPort int32, cidr string) error {
+// AddEgressRule adds an egress rule to a security group in the factory's region.
+// destination may be an IPv4/IPv6 CIDR, a prefix list or a security group ID,
+// which matches traffic to the group's members.
+func (sg *SecurityGroup) AddEgressRule(ctx context.Context, sgID, protocol string, fromPort, toPort int32, destination string) error {
+	rule := SecurityGroupRule{Egress: true, Protocol: protocol, FromPort: fromPort, ToPort: toPort, Peer: destination}
+	return sg.changeRule(ctx, "", sgID, rule, true)
+}
+
+// RemoveEgressRule removes an egress rule from a security group in the factory's region.
+// destination may be an IPv4/IPv6 CIDR, a prefix list or a security group ID,
+// which matches traffic to the group's members.
+func (sg *SecurityGroup) RemoveEgressRule(ctx context.Context, sgID, protocol string, fromPort, toPort int32, destination string) error {
+	rule := SecurityGroupRule{Egress: true, Protocol: protocol, FromPort: fromPort, ToPort: toPort, Peer: destination}
+	return sg.changeRule(ctx, "", sgID, rule, false)
+}
+
+// changeRule authorizes or revokes a rule on a security group. An empty
+// region selects the factory's region.
+func (sg *SecurityGroup) changeRule(ctx context.Context, region, sgID string, rule SecurityGroupRule, add bool) error {
+	if err := checkGroupID(rule.Peer); err != nil {
+		return err
+	}
+
 	factory := sg.getFactory()
 	if factory == nil {
 		return fmt.Errorf("factory not initialized")
 	}
-
-	region := factory.Region()
+	if region == "" {
+		region = factory.Region()
+	}
 	client := factory.Client().EC2(region)
 	if client == nil {
 		return fmt.Errorf("failed to get EC2 client for region: %s", region)
 	}
 
-	ipPermission := types.IpPermission{
-		IpProtocol: &protocol,
-		FromPort:   &fromPort,
-		ToPort:     &toPort,
-		IpRanges: []types.IpRange{
-			{CidrIp: &cidr},
-		},
+	perms := []types.IpPermission{ipPermission(rule.Protocol, rule.FromPort, rule.ToPort, rule.Peer)}
+	dryRun := aws.DryRunFlag(ctx)
+
+	var (
+		op  string
+		err error
+	)
+	switch {
+	case !rule.Egress && add:
+		op = "AuthorizeSecurityGroupIngress"
+		_, err = client.AuthorizeSecurityGroupIngress(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: &sgID, IpPermissions: perms, DryRun: dryRun,
+		})
+	case !rule.Egress:
+		op = "RevokeSecurityGroupIngress"
+		_, err = client.RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId: &sgID, IpPermissions: perms, DryRun: dryRun,
+		})
+	case add:
+		op = "AuthorizeSecurityGroupEgress"
+		_, err = client.AuthorizeSecurityGroupEgress(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId: &sgID, IpPermissions: perms, DryRun: dryRun,
+		})
+	default:
+		op = "RevokeSecurityGroupEgress"
+		_, err = client.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId: &sgID, IpPermissions: perms, DryRun: dryRun,
+		})
+	}
+	if err = aws.DryRunResult(err); err != nil {
+		return aws.WrapAWSError(err, op)
 	}
 
-	input := &ec2.RevokeSecurityGroupIngressInput{
-		GroupId:       &sgID,
-		IpPermissions: []types.IpPermission{ipPermission},
-	}
+	return nil
+}
 
-	_, err := client.RevokeSecurityGroupIngress(ctx, input)
-	if err != nil {
-		return aws.WrapAWSError(err, "RevokeSecurityGroupIngress")
+// checkGroupID rejects a peer that names a security group without an ID,
+// so a group rule is never sent as an IP range.
+func checkGroupID(peer string) error {
+	if strings.HasPrefix(peer, "sg-") && !IsGroupPeer(peer) {
+		return fmt.Errorf("invalid security group ID %q (expected sg-...)", peer)
 	}
-
 	return nil
 }
 
-// AddEgressRule adds an egress rule to a security group.
-func (sg *SecurityGroup) AddEgressRule(ctx context.Context, sgID, protocol string, fromPort, toPort int32, cidr string) error {
-	factory := sg.getFactory()
-	if factory == nil {
-		return fmt.Errorf("factory not initialized")
-	}
+// IsGroupPeer reports whether a rule peer references a security group
+// rather than an address range or prefix list.
+func IsGroupPeer(peer string) bool {
+	return strings.HasPrefix(peer, "sg-") && len(peer) > len("sg-")
+}
 
-	region := factory.Region()
-	client := factory.Client().EC2(region)
-	if client == nil {
-		return fmt.Errorf("failed to get EC2 client for region: %s", region)
+// AddRule adds an ingress or egress rule to a security group.
+// Path format: "region/sg-id"
+func (sg *SecurityGroup) AddRule(ctx context.Context, path string, rule SecurityGroupRule) error {
+	region, sgID, err := parseSGPath(path)
+	if err != nil {
+		return err
 	}
+	return sg.changeRule(ctx, region, sgID, rule, true)
+}
 
-	ipPermission := types.IpPermission{
-		IpProtocol: &protocol,
-		FromPort:   &fromPort,
-		ToPort:     &toPort,
-		IpRanges: []types.IpRange{
-			{CidrIp: &cidr},
-		},
+// RemoveRule removes an ingress or egress rule from a security group.
+// Path format: "region/sg-id"
+func (sg *SecurityGroup) RemoveRule(ctx context.Context, path string, rule SecurityGroupRule) error {
+	region, sgID, err := parseSGPath(path)
+	if err != nil {
+		return err
 	}
+	return sg.changeRule(ctx, region, sgID, rule, false)
+}
 
-	input := &ec2.AuthorizeSecurityGroupEgressInput{
-		GroupId:       &sgID,
-		IpPermissions: []types.IpPermission{ipPermission},
-	}
+// editableRule is the JSON form of a rule in the edit flow.
+type editableRule struct {
+	Protocol string `json:"Protocol"`
+	FromPort int32  `json:"FromPort"`
+	ToPort   int32  `json:"ToPort"`
+	Peer     string `json:"Peer"`
+}
 
-	_, err := client.AuthorizeSecurityGroupEgress(ctx, input)
+// GetEditable returns the security group's ingress and egress rules for editing.
+func (sg *SecurityGroup) GetEditable(ctx context.Context, path string) (map[string]interface{}, error) {
+	rules, err := sg.ListRules(ctx, path)
 	if err != nil {
-		return aws.WrapAWSError(err, "AuthorizeSecurityGroupEgress")
+		return nil, err
 	}
 
-	return nil
+	ingress, egress := []interface{}{}, []interface{}{}
+	for _, r := range rules {
+		rule := map[string]interface{}{
+			"Protocol": r.Protocol,
+			"FromPort": r.FromPort,
+			"ToPort":   r.ToPort,
+			"Peer":     r.Peer,
+		}
+		if r.Egress {
+			egress = append(egress, rule)
+		} else {
+			ingress = append(ingress, rule)
+		}
+	}
+
+	return map[string]interface{}{
+		"Ingress": ingress,
+		"Egress":  egress,
+	}, nil
 }
 
-// RemoveEgressRule removes an egress rule from a security group.
-func (sg *SecurityGroup) RemoveEgressRule(ctx context.Context, sgID, protocol string, fromPort, toPort int32, cidr string) error {
-	factory := sg.getFactory()
-	if factory == nil {
-		return fmt.Errorf("factory not initialized")
+// ApplyEdit reconciles the security group with the edited rule lists.
+// New rules are authorized before removed ones are revoked, so replacing a
+// rule never leaves a window without access.
+func (sg *SecurityGroup) ApplyEdit(ctx context.Context, path string, patch map[string]interface{}) error {
+	current, err := sg.ListRules(ctx, path)
+	if err != nil {
+		return err
 	}
 
-	region := factory.Region()
-	client := factory.Client().EC2(region)
-	if client == nil {
-		return fmt.Errorf("failed to get EC2 client for region: %s", region)
+	var add, remove []SecurityGroupRule
+	for key, value := range patch {
+		var egress bool
+		switch key {
+		case "Ingress":
+		case "Egress":
+			egress = true
+		default:
+			return fmt.Errorf("unknown property %q: only Ingress and Egress can be edited", key)
+		}
+
+		wanted, err := parseEditedRules(value, egress)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+
+		existing := make(map[string]bool)
+		for _, r := range current {
+			if r.Egress == egress {
+				existing[r.Key()] = true
+			}
+		}
+		keep := make(map[string]bool, len(wanted))
+		for _, r := range wanted {
+			keep[r.Key()] = true
+			if !existing[r.Key()] {
+				add = append(add, r)
+			}
+		}
+		for _, r := range current {
+			if r.Egress == egress && !keep[r.Key()] {
+				remove = append(remove, r)
+			}
+		}
 	}
 
-	ipPermission := types.IpPermission{
-		IpProtocol: &protocol,
-		FromPort:   &fromPort,
-		ToPort:     &toPort,
-		IpRanges: []types.IpRange{
-			{CidrIp: &cidr},
-		},
+	for _, r := range add {
+		if err := sg.AddRule(ctx, path, r); err != nil {
+			return err
+		}
 	}
+	for _, r := range remove {
+		if err := sg.RemoveRule(ctx, path, r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-	input := &ec2.RevokeSecurityGroupEgressInput{
-		GroupId:       &sgID,
-		IpPermissions: []types.IpPermission{ipPermission},
+// parseEditedRules converts an edited rule list into security group rules.
+func parseEditedRules(value interface{}, egress bool) ([]SecurityGroupRule, error) {
+	if value == nil {
+		return nil, nil
 	}
 
-	_, err := client.RevokeSecurityGroupEgress(ctx, input)
+	b, err := json.Marshal(value)
 	if err != nil {
-		return aws.WrapAWSError(err, "RevokeSecurityGroupEgress")
+		return nil, err
+	}
+	var edited []editableRule
+	if err := json.Unmarshal(b, &edited); err != nil {
+		return nil, fmt.Errorf("must be an array of {Protocol, FromPort, ToPort, Peer}: %w", err)
 	}
 
-	return nil
+	rules := make([]SecurityGroupRule, 0, len(edited))
+	for i, e := range edited {
+		if e.Protocol == "" || e.Peer == "" {
+			return nil, fmt.Errorf("rule %d: Protocol and Peer are required", i)
+		}
+		rules = append(rules, SecurityGroupRule{
+			Egress:   egress,
+			Protocol: e.Protocol,
+			FromPort: e.FromPort,
+			ToPort:   e.ToPort,
+			Peer:     e.Peer,
+		})
+	}
+	return rules, nil
 }
 
 // Helper functions
 
-// sgToAWSObject converts an EC2 SecurityGroup to an AWSObject.
-func sgToAWSObject(sg types.SecurityGroup, region string) AWSObject {
+// sgToAWSObject converts an EC2 SecurityGroup to an AWSObject. account is
+// used for the ARN when the group does not report its owner.
+func sgToAWSObject(sg types.SecurityGroup, region, account string) AWSObject {
 	tags := make(map[string]string)
 	for _, tag := range sg.Tags {
 		if tag.Key != nil && tag.Value != nil {
@@ -318,7 +583,7 @@ func sgToAWSObject(sg types.SecurityGroup, region string) AWSObject {
 	}
 
 	return &BaseAWSObject{
-		ARN:       buildSecurityGroupARN(region, sg),
+		ARN:       buildSecurityGroupARN(region, ownerOr(sg.OwnerId, account), sg),
 		ID:        aws.SafeString(sg.GroupId),
 		Name:      name,
 		Region:    region,
@@ -328,6 +593,70 @@ func sgToAWSObject(sg types.SecurityGroup, region string) AWSObject {
 	}
 }
 
+// ipPermission builds the permission for a single rule source or destination.
+// Ports are omitted for the all-protocols rule.
+func ipPermission(protocol string, fromPort, toPort int32, peer string) types.IpPermission {
+	perm := types.IpPermission{IpProtocol: &protocol}
+	if protocol != "-1" {
+		perm.FromPort = &fromPort
+		perm.ToPort = &toPort
+	}
+
+	switch {
+	case IsGroupPeer(peer):
+		perm.UserIdGroupPairs = []types.UserIdGroupPair{{GroupId: &peer}}
+	case strings.HasPrefix(peer, "pl-"):
+		perm.PrefixListIds = []types.PrefixListId{{PrefixListId: &peer}}
+	case strings.Contains(peer, ":"):
+		perm.Ipv6Ranges = []types.Ipv6Range{{CidrIpv6: &peer}}
+	default:
+		perm.IpRanges = []types.IpRange{{CidrIp: &peer}}
+	}
+
+	return perm
+}
+
+// flattenRules expands IP permissions into one rule per source or destination.
+func flattenRules(perms []types.IpPermission, egress bool) []SecurityGroupRule {
+	var rules []SecurityGroupRule
+	for _, perm := range perms {
+		base := SecurityGroupRule{
+			Egress:   egress,
+			Protocol: aws.SafeString(perm.IpProtocol),
+			FromPort: -1,
+			ToPort:   -1,
+		}
+		if perm.FromPort != nil {
+			base.FromPort = *perm.FromPort
+		}
+		if perm.ToPort != nil {
+			base.ToPort = *perm.ToPort
+		}
+
+		for _, r := range perm.IpRanges {
+			rule := base
+			rule.Peer, rule.Description = aws.SafeString(r.CidrIp), aws.SafeString(r.Description)
+			rules = append(rules, rule)
+		}
+		for _, r := range perm.Ipv6Ranges {
+			rule := base
+			rule.Peer, rule.Description = aws.SafeString(r.CidrIpv6), aws.SafeString(r.Description)
+			rules = append(rules, rule)
+		}
+		for _, p := range perm.UserIdGroupPairs {
+			rule := base
+			rule.Peer, rule.Description = aws.SafeString(p.GroupId), aws.SafeString(p.Description)
+			rules = append(rules, rule)
+		}
+		for _, p := range perm.PrefixListIds {
+			rule := base
+			rule.Peer, rule.Description = aws.SafeString(p.PrefixListId), aws.SafeString(p.Description)
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
 // parseSGPath parses a security group path in the format "region/sg-id".
 func parseSGPath(path string) (region, sgID string, err error) {
 	parts := strings.Split(path, "/")
@@ -396,11 +725,6 @@ func formatRules(perms []types.IpPermission) string {
 }
 
 // buildSecurityGroupARN constructs an ARN for a security group.
-func buildSecurityGroupARN(region string, sg types.SecurityGroup) string {
-	// ARN format: arn:aws:ec2:region:account-id:security-group/sg-id
-	// We don't have account ID readily available, so we'll use a placeholder
-	// The factory should provide this, but for now we'll return a partial ARN
-	return fmt.Sprintf("arn:aws:ec2:%s:*:security-group/%s",
-		region,
-		aws.SafeString(sg.GroupId))
+func buildSecurityGroupARN(region, account string, sg types.SecurityGroup) string {
+	return ec2ARN(region, account, "security-group/"+aws.SafeString(sg.GroupId))
 }
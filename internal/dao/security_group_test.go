@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// pagedSecurityGroups serves one page per call, chained by NextToken.
+type pagedSecurityGroups struct {
+	pages [][]string
+	calls int
+}
+
+func (p *pagedSecurityGroups) DescribeSecurityGroups(_ context.Context, in *ec2.DescribeSecurityGroupsInput, _ ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	page := p.calls
+	if in.NextToken != nil {
+		if want := tokenFor(page); *in.NextToken != want {
+			return nil, fmt.Errorf("page %d: got token %q, want %q", page, *in.NextToken, want)
+		}
+	}
+	p.calls++
+
+	out := &ec2.DescribeSecurityGroupsOutput{}
+	for _, id := range p.pages[page] {
+		out.SecurityGroups = append(out.SecurityGroups, types.SecurityGroup{GroupId: awssdk.String(id)})
+	}
+	if page+1 < len(p.pages) {
+		out.NextToken = awssdk.String(tokenFor(page + 1))
+	}
+	return out, nil
+}
+
+func tokenFor(page int) string {
+	return string(rune('a' + page))
+}
+
+func TestListSecurityGroupsCollectsAllPages(t *testing.T) {
+	client := &pagedSecurityGroups{pages: [][]string{
+		{"sg-1", "sg-2"},
+		{"sg-3"},
+		{"sg-4", "sg-5"},
+	}}
+
+	groups, err := listSecurityGroups(context.Background(), client)
+	if err != nil {
+		t.Fatalf("listSecurityGroups: %v", err)
+	}
+	if client.calls != 3 {
+		t.Errorf("got %d calls, want 3", client.calls)
+	}
+
+	want := []string{"sg-1", "sg-2", "sg-3", "sg-4", "sg-5"}
+	if len(groups) != len(want) {
+		t.Fatalf("got %d groups, want %d", len(groups), len(want))
+	}
+	for i, g := range groups {
+		if id := awssdk.ToString(g.GroupId); id != want[i] {
+			t.Errorf("group %d: got %s, want %s", i, id, want[i])
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/a1s/a1s/internal/aws"
+)
+
+func init() {
+	RegisterAccessor(&SSMInstanceRID, &SSMInstance{})
+}
+
+// SSMInstance implements the DAO for nodes whose SSM agent is registered,
+// i.e. the instances SSM sessions and Run Command can reach.
+type SSMInstance struct {
+	AWSResource
+}
+
+// List retrieves the SSM-managed instances in the specified region.
+func (s *SSMInstance) List(ctx context.Context, region string) ([]AWSObject, error) {
+	client := s.Client()
+	if client == nil {
+		return nil, aws.ErrNoConnection
+	}
+
+	infos, err := client.DescribeInstanceInformation(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	account := s.accountID()
+	objects := make([]AWSObject, 0, len(infos))
+	for _, info := range infos {
+		objects = append(objects, ssmInstanceToAWSObject(info, region, account))
+	}
+
+	return objects, nil
+}
+
+// Get retrieves a single SSM-managed instance by path (region/instance-id).
+func (s *SSMInstance) Get(ctx context.Context, path string) (AWSObject, error) {
+	region, instanceID, err := parseSSMInstancePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s.Client()
+	if client == nil {
+		return nil, aws.ErrNoConnection
+	}
+
+	infos, err := client.DescribeInstanceInformation(ctx, region, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if info.InstanceID == instanceID {
+			return ssmInstanceToAWSObject(info, region, s.accountID()), nil
+		}
+	}
+
+	return nil, fmt.Errorf("instance %s is not managed by SSM in region %s", instanceID, region)
+}
+
+// Describe returns a formatted description of an SSM-managed instance.
+func (s *SSMInstance) Describe(path string) (string, error) {
+	obj, err := s.Get(context.Background(), path)
+	if err != nil {
+		return "", err
+	}
+
+	info := obj.GetRaw().(aws.SSMInstanceInfo)
+	lastPing := "-"
+	if t := info.LastPing; t != nil {
+		lastPing = t.Format("2006-01-02 15:04:05 MST")
+	}
+	agent := info.AgentVersion
+	if !info.IsLatestVersion {
+		agent += " (update available)"
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Instance ID:   %s\n", obj.GetID()))
+	b.WriteString(fmt.Sprintf("Computer Name: %s\n", info.ComputerName))
+	b.WriteString(fmt.Sprintf("Region:        %s\n", obj.GetRegion()))
+	b.WriteString(fmt.Sprintf("Resource Type: %s\n", info.ResourceType))
+	b.WriteString(fmt.Sprintf("Ping Status:   %s\n", info.PingStatus))
+	b.WriteString(fmt.Sprintf("Last Ping:     %s\n", lastPing))
+	b.WriteString(fmt.Sprintf("Agent Version: %s\n", agent))
+	b.WriteString(fmt.Sprintf("Platform:      %s %s (%s)\n", info.PlatformName, info.PlatformVersion, info.PlatformType))
+	b.WriteString(fmt.Sprintf("IP Address:    %s\n", info.IPAddress))
+	if info.IAMRole != "" {
+		b.WriteString(fmt.Sprintf("IAM Role:      %s\n", info.IAMRole))
+	}
+
+	return b.String(), nil
+}
+
+// ToJSON returns a JSON representation of an SSM-managed instance.
+func (s *SSMInstance) ToJSON(path string) (string, error) {
+	obj, err := s.Get(context.Background(), path)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(obj.GetRaw(), "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal SSM instance to JSON: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// ssmInstanceToAWSObject converts SSM instance information to an AWSObject.
+// EC2 instances get their EC2 ARN; hybrid nodes (mi-*) an SSM one.
+func ssmInstanceToAWSObject(info aws.SSMInstanceInfo, region, account string) AWSObject {
+	arn := ec2ARN(region, account, "instance/"+info.InstanceID)
+	if !strings.HasPrefix(info.InstanceID, "i-") {
+		if account == "" {
+			account = "*"
+		}
+		arn = fmt.Sprintf("arn:aws:ssm:%s:%s:managed-instance/%s", region, account, info.InstanceID)
+	}
+
+	return &BaseAWSObject{
+		ARN:    arn,
+		ID:     info.InstanceID,
+		Name:   info.ComputerName,
+		Region: region,
+		Tags:   map[string]string{},
+		Raw:    info,
+	}
+}
+
+// parseSSMInstancePath parses a path in the format "region/instance-id".
+func parseSSMInstancePath(path string) (region, instanceID string, err error) {
+	region, instanceID, ok := strings.Cut(path, "/")
+	if !ok || region == "" || instanceID == "" || strings.Contains(instanceID, "/") {
+		return "", "", fmt.Errorf("invalid SSM instance path format: expected 'region/instance-id', got '%s'", path)
+	}
+	return region, instanceID, nil
+}
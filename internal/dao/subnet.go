@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/a1s/a1s/internal/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
@@ -32,9 +33,10 @@ func (s *Subnet) List(ctx context.Context, region string) ([]AWSObject, error) {
 		return nil, fmt.Errorf("failed to describe subnets: %w", err)
 	}
 
+	account := s.accountID()
 	objects := make([]AWSObject, 0, len(result.Subnets))
 	for _, subnet := range result.Subnets {
-		obj := subnetToAWSObject(subnet, region)
+		obj := subnetToAWSObject(subnet, region, account)
 		objects = append(objects, obj)
 	}
 
@@ -66,7 +68,7 @@ func (s *Subnet) Get(ctx context.Context, path string) (AWSObject, error) {
 		return nil, fmt.Errorf("subnet %s not found in region %s", subnetID, region)
 	}
 
-	return subnetToAWSObject(result.Subnets[0], region), nil
+	return subnetToAWSObject(result.Subnets[0], region, s.accountID()), nil
 }
 
 // Describe returns a formatted description of a subnet.
@@ -139,18 +141,20 @@ func (s *Subnet) Delete(ctx context.Context, path string, force bool) error {
 
 	input := &ec2.DeleteSubnetInput{
 		SubnetId: &subnetID,
+		DryRun:   aws.DryRunFlag(ctx),
 	}
 
 	_, err = client.DeleteSubnet(ctx, input)
-	if err != nil {
+	if err = aws.DryRunResult(err); err != nil {
 		return fmt.Errorf("failed to delete subnet %s: %w", subnetID, err)
 	}
 
 	return nil
 }
 
-// subnetToAWSObject converts an EC2 Subnet to an AWSObject.
-func subnetToAWSObject(subnet types.Subnet, region string) AWSObject {
+// subnetToAWSObject converts an EC2 Subnet to an AWSObject. account is used
+// for the ARN when the subnet does not report its owner.
+func subnetToAWSObject(subnet types.Subnet, region, account string) AWSObject {
 	tags := make(map[string]string)
 	name := ""
 
@@ -168,7 +172,7 @@ func subnetToAWSObject(subnet types.Subnet, region string) AWSObject {
 		subnetID = *subnet.SubnetId
 	}
 
-	arn := fmt.Sprintf("arn:aws:ec2:%s::subnet/%s", region, subnetID)
+	arn := ec2ARN(region, ownerOr(subnet.OwnerId, account), "subnet/"+subnetID)
 
 	return &BaseAWSObject{
 		ARN:    arn,
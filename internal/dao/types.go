@@ -38,6 +38,7 @@ var (
 	EC2SecurityGroupRID  = ResourceID{Service: "ec2", Resource: "securitygroup"}
 	VPCResourceRID       = ResourceID{Service: "vpc", Resource: "vpc"}
 	SubnetRID            = ResourceID{Service: "vpc", Resource: "subnet"}
+	VPCSecurityGroupRID  = ResourceID{Service: "vpc", Resource: "securitygroup"}
 	S3BucketRID          = ResourceID{Service: "s3", Resource: "bucket"}
 	S3ObjectRID          = ResourceID{Service: "s3", Resource: "object"}
 	IAMUserRID           = ResourceID{Service: "iam", Resource: "user"}
@@ -45,6 +46,7 @@ var (
 	IAMPolicyRID         = ResourceID{Service: "iam", Resource: "policy"}
 	EKSClusterRID        = ResourceID{Service: "eks", Resource: "cluster"}
 	EKSNodeGroupRID      = ResourceID{Service: "eks", Resource: "nodegroup"}
+	SSMInstanceRID       = ResourceID{Service: "ssm", Resource: "instance"}
 )
 
 // AWSObject represents a generic AWS resource with common metadata.
@@ -96,6 +98,39 @@ type Nuker interface {
 	Delete(ctx context.Context, path string, force bool) error
 }
 
+// Taggable provides tag management for AWS resources.
+type Taggable interface {
+	GetTags(ctx context.Context, path string) (map[string]string, error)
+	SetTags(ctx context.Context, path string, tags map[string]string) error
+}
+
+// NameFilter is the filter key for a resource name prefix, e.g. "prod-".
+// Listers push it to the service when it can match names and otherwise
+// apply it before any per-resource calls.
+const NameFilter = "name"
+
+// FilteredLister lists resources with server-side filters.
+// Filter keys use the service's filter names (e.g. "tag:Env", "instance-state-name");
+// comma-separated values match any of them.
+type FilteredLister interface {
+	ListFiltered(ctx context.Context, region string, filters map[string]string) ([]AWSObject, error)
+}
+
+// Editable provides a service-specific edit path for resources Cloud Control
+// cannot fetch or update. GetEditable returns the editable properties;
+// ApplyEdit receives the top-level properties that changed, with removed
+// properties set to nil.
+type Editable interface {
+	GetEditable(ctx context.Context, path string) (map[string]interface{}, error)
+	ApplyEdit(ctx context.Context, path string, patch map[string]interface{}) error
+}
+
+// EditValidator checks edited Cloud Control properties before they are
+// applied, so obvious mistakes are reported without an API round-trip.
+type EditValidator interface {
+	ValidateEdit(props map[string]interface{}) error
+}
+
 // CloudFormationType maps ResourceID strings to CloudFormation type names for Cloud Control API.
 var CloudFormationType = map[string]string{
 	"ec2/instance":      "AWS::EC2::Instance",
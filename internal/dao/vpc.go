@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/a1s/a1s/internal/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
@@ -32,9 +33,10 @@ func (v *VPC) List(ctx context.Context, region string) ([]AWSObject, error) {
 		return nil, fmt.Errorf("failed to describe VPCs: %w", err)
 	}
 
+	account := v.accountID()
 	objects := make([]AWSObject, 0, len(result.Vpcs))
 	for _, vpc := range result.Vpcs {
-		obj := vpcToAWSObject(vpc, region)
+		obj := vpcToAWSObject(vpc, region, account)
 		objects = append(objects, obj)
 	}
 
@@ -66,7 +68,7 @@ func (v *VPC) Get(ctx context.Context, path string) (AWSObject, error) {
 		return nil, fmt.Errorf("VPC %s not found in region %s", vpcID, region)
 	}
 
-	return vpcToAWSObject(result.Vpcs[0], region), nil
+	return vpcToAWSObject(result.Vpcs[0], region, v.accountID()), nil
 }
 
 // Describe returns a formatted description of a VPC.
@@ -141,19 +143,21 @@ func (v *VPC) Delete(ctx context.Context, path string, force bool) error {
 	}
 
 	input := &ec2.DeleteVpcInput{
-		VpcId: &vpcID,
+		VpcId:  &vpcID,
+		DryRun: aws.DryRunFlag(ctx),
 	}
 
 	_, err = client.DeleteVpc(ctx, input)
-	if err != nil {
+	if err = aws.DryRunResult(err); err != nil {
 		return fmt.Errorf("failed to delete VPC %s: %w", vpcID, err)
 	}
 
 	return nil
 }
 
-// vpcToAWSObject converts an EC2 VPC to an AWSObject.
-func vpcToAWSObject(vpc types.Vpc, region string) AWSObject {
+// vpcToAWSObject converts an EC2 VPC to an AWSObject. account is used for
+// the ARN when the VPC does not report its owner.
+func vpcToAWSObject(vpc types.Vpc, region, account string) AWSObject {
 	tags := make(map[string]string)
 	name := ""
 
@@ -171,7 +175,7 @@ func vpcToAWSObject(vpc types.Vpc, region string) AWSObject {
 		vpcID = *vpc.VpcId
 	}
 
-	arn := fmt.Sprintf("arn:aws:ec2:%s::vpc/%s", region, vpcID)
+	arn := ec2ARN(region, ownerOr(vpc.OwnerId, account), "vpc/"+vpcID)
 
 	return &BaseAWSObject{
 		ARN:    arn,
@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
@@ -193,7 +194,8 @@ func (t *TableData) Refresh(ctx context.Context) error {
 	for _, obj := range objects {
 		row := model1.NewRow(len(header))
 		if err := renderer.Render(obj, region, &row); err != nil {
-			// Log error but continue with other rows
+			// Skip the row but keep rendering the others
+			slog.Warn("Render failed", "region", region, "err", err)
 			continue
 		}
 
@@ -14,6 +14,7 @@ type Attrs struct {
 	Time      bool          // Age column
 	Capacity  bool          // Numeric (right-align)
 	Hide      bool          // Always hidden
+	MaxWidth  int           // Display width cap, 0 for none
 	Decorator DecoratorFunc
 }
 
@@ -33,6 +34,9 @@ func (a Attrs) Merge(b Attrs) Attrs {
 	if !a.Capacity {
 		a.Capacity = b.Capacity
 	}
+	if a.MaxWidth == 0 {
+		a.MaxWidth = b.MaxWidth
+	}
 	if a.Decorator == nil {
 		a.Decorator = b.Decorator
 	}
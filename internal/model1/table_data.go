@@ -8,6 +8,7 @@ type TableData struct {
 	rowEvents *RowEvents
 	namespace string
 	errMsg    string
+	more      bool
 	mx        sync.RWMutex
 }
 
@@ -77,6 +78,7 @@ func (t *TableData) Clone() *TableData {
 		rowEvents: t.rowEvents,
 		namespace: t.namespace,
 		errMsg:    t.errMsg,
+		more:      t.more,
 	}
 }
 
@@ -100,3 +102,18 @@ func (t *TableData) HasError() bool {
 	defer t.mx.RUnlock()
 	return t.errMsg != ""
 }
+
+// SetMore marks the rows as incomplete: further pages exist or some regions
+// could not be listed.
+func (t *TableData) SetMore(more bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	t.more = more
+}
+
+// More returns true if there are more rows than listed.
+func (t *TableData) More() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+	return t.more
+}
@@ -15,7 +15,7 @@ func ToAge(t *time.Time) string {
 	return HumanDuration(time.Since(*t))
 }
 
-// HumanDuration converts duration to human readable format (e.g., "5d", "3h", "2m")
+// HumanDuration converts duration to human readable format (e.g., "2mo", "5d", "3h", "2m")
 func HumanDuration(d time.Duration) string {
 	if d < time.Second {
 		return "0s"
@@ -30,6 +30,9 @@ func HumanDuration(d time.Duration) string {
 		years := days / 365
 		return fmt.Sprintf("%dy", years)
 	}
+	if days >= 60 {
+		return fmt.Sprintf("%dmo", days/30)
+	}
 	if days > 0 {
 		return fmt.Sprintf("%dd", days)
 	}
@@ -18,6 +18,10 @@ type ResourceAction struct {
 	Description string                                                                           // Short description
 	Dangerous   bool                                                                             // Requires confirmation
 	Handler     func(ctx context.Context, client aws.Connection, region, identifier string) error
+	// WaitState names the state Wait blocks for, e.g. "running".
+	WaitState string
+	// Wait optionally blocks until the resource settles after Handler succeeds.
+	Wait func(ctx context.Context, client aws.Connection, region, identifier string) error
 }
 
 // ActionRegistry maps resource types to their available actions.
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package ui
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// clipboardCmds lists native clipboard tools to try, in order of preference.
+var clipboardCmds = [][]string{
+	{"pbcopy"},
+	{"wl-copy"},
+	{"xclip", "-selection", "clipboard"},
+	{"xsel", "--clipboard", "--input"},
+	{"clip.exe"},
+}
+
+// CopyToClipboard copies text to the system clipboard.
+// Over SSH, or when no native tool is available, it falls back to an
+// OSC 52 escape sequence so the local terminal receives the text.
+func CopyToClipboard(text string) error {
+	if !isSSH() {
+		for _, args := range clipboardCmds {
+			if runtime.GOOS != "darwin" && args[0] == "pbcopy" {
+				continue
+			}
+			if _, err := exec.LookPath(args[0]); err != nil {
+				continue
+			}
+			cmd := exec.Command(args[0], args[1:]...)
+			cmd.Stdin = strings.NewReader(text)
+			if err := cmd.Run(); err == nil {
+				return nil
+			}
+		}
+	}
+
+	return copyOSC52(text)
+}
+
+// copyOSC52 writes an OSC 52 clipboard sequence to the controlling terminal.
+func copyOSC52(text string) error {
+	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+	if err != nil {
+		tty = os.Stdout
+	} else {
+		defer tty.Close()
+	}
+
+	seq := fmt.Sprintf("\x1b]52;c;%s\a", base64.StdEncoding.EncodeToString([]byte(text)))
+	// tmux only forwards OSC sequences wrapped in a DCS passthrough
+	if os.Getenv("TMUX") != "" {
+		seq = "\x1bPtmux;\x1b" + seq + "\x1b\\"
+	}
+
+	if _, err := tty.WriteString(seq); err != nil {
+		return fmt.Errorf("failed to write to terminal: %w", err)
+	}
+	return nil
+}
+
+// isSSH reports whether the process runs inside an SSH session.
+func isSSH() bool {
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != ""
+}
@@ -5,6 +5,7 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -27,6 +28,9 @@ var defaultCommands = []string{
 	"region",
 }
 
+// MaxHistory is the number of executed commands kept for recall.
+const MaxHistory = 50
+
 // CmdBar is a bordered command/filter input bar at the top of the app.
 // Uses a TextView for ghost-text autocomplete like k9s.
 type CmdBar struct {
@@ -35,6 +39,7 @@ type CmdBar struct {
 	mode              IndicatorMode
 	cmdFn             func(string)
 	filterFn          func(string)
+	filterSubmitFn    func(string)
 	cancelFn          func()
 	activeFn          func(bool)
 	isActive          bool
@@ -44,6 +49,9 @@ type CmdBar struct {
 	suggestionIdx     int
 	currentSuggestion string
 	commands          []string
+	history           []string
+	historyIdx        int
+	fuzzy             bool
 	mx                sync.RWMutex
 }
 
@@ -54,14 +62,15 @@ func NewCmdBar() *CmdBar {
 		mode:          ModeNormal,
 		commands:      defaultCommands,
 		suggestionIdx: -1,
+		historyIdx:    -1,
 		text:          make([]rune, 0),
 	}
 
 	// Style the text view
 	c.SetBorder(true)
-	c.SetBorderColor(tcell.ColorDarkCyan)
+	c.SetBorderColor(CurrentTheme().Color(CurrentTheme().Key))
 	c.SetBackgroundColor(tcell.ColorDefault)
-	c.SetTextColor(tcell.ColorWhite)
+	c.SetTextColor(CurrentTheme().Color(CurrentTheme().Text))
 	c.SetDynamicColors(true)
 	c.SetWrap(false)
 
@@ -121,9 +130,11 @@ func (c *CmdBar) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 
 	case tcell.KeyUp:
-		// Cycle to previous suggestion
+		// Cycle to previous suggestion, or recall an older command
 		c.mx.Lock()
-		if len(c.suggestions) > 0 {
+		if len(c.suggestions) == 0 {
+			c.recall(1)
+		} else {
 			c.suggestionIdx--
 			if c.suggestionIdx < 0 {
 				c.suggestionIdx = len(c.suggestions) - 1
@@ -135,9 +146,11 @@ func (c *CmdBar) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 
 	case tcell.KeyDown:
-		// Cycle to next suggestion
+		// Cycle to next suggestion, or recall a newer command
 		c.mx.Lock()
-		if len(c.suggestions) > 0 {
+		if len(c.suggestions) == 0 {
+			c.recall(-1)
+		} else {
 			c.suggestionIdx++
 			if c.suggestionIdx >= len(c.suggestions) {
 				c.suggestionIdx = 0
@@ -199,10 +212,14 @@ func (c *CmdBar) render() {
 
 	// Build the display string with ghost text
 	var display string
+	theme := CurrentTheme()
 	if suggestion != "" && strings.HasPrefix(suggestion, text) && len(suggestion) > len(text) {
 		// Show typed text in white, remainder as ghost text in gray
 		ghost := suggestion[len(text):]
-		display = fmt.Sprintf("%s%s [::b]%s[gray::]%s[-::]", icon, prefix, text, ghost)
+		display = fmt.Sprintf("%s%s [::b]%s%s", icon, prefix, text, theme.Paint(theme.Dim, ghost))
+	} else if suggestion != "" && suggestion != text {
+		// Fuzzy match: the suggestion doesn't extend the text, so show it whole
+		display = fmt.Sprintf("%s%s [::b]%s %s", icon, prefix, text, theme.Paint(theme.Dim, "→ "+suggestion))
 	} else {
 		display = fmt.Sprintf("%s%s [::b]%s", icon, prefix, text)
 	}
@@ -211,6 +228,8 @@ func (c *CmdBar) render() {
 }
 
 // getSuggestions returns matching commands for the given text.
+// Prefix matches come first; in fuzzy mode they are followed by
+// subsequence matches, best first.
 func (c *CmdBar) getSuggestions(text string) []string {
 	if text == "" {
 		return nil
@@ -218,15 +237,64 @@ func (c *CmdBar) getSuggestions(text string) []string {
 
 	text = strings.ToLower(text)
 	var matches []string
+	var fuzzy []fuzzyMatch
 	for _, cmd := range c.commands {
 		if strings.HasPrefix(cmd, text) {
 			matches = append(matches, cmd)
+			continue
+		}
+		if c.fuzzy {
+			if score, ok := fuzzyScore(text, cmd); ok {
+				fuzzy = append(fuzzy, fuzzyMatch{cmd: cmd, score: score})
+			}
 		}
 	}
 	sort.Strings(matches)
+
+	sort.Slice(fuzzy, func(i, j int) bool {
+		if fuzzy[i].score != fuzzy[j].score {
+			return fuzzy[i].score < fuzzy[j].score
+		}
+		if len(fuzzy[i].cmd) != len(fuzzy[j].cmd) {
+			return len(fuzzy[i].cmd) < len(fuzzy[j].cmd)
+		}
+		return fuzzy[i].cmd < fuzzy[j].cmd
+	})
+	for _, m := range fuzzy {
+		matches = append(matches, m.cmd)
+	}
+
 	return matches
 }
 
+// fuzzyMatch is a command matched as a subsequence of the typed text.
+type fuzzyMatch struct {
+	cmd   string
+	score int
+}
+
+// fuzzyScore reports whether pattern's letters appear in order in s, with a
+// score counting the skipped letters before and between them; lower is better.
+func fuzzyScore(pattern, s string) (int, bool) {
+	score, pos := 0, 0
+	for _, r := range pattern {
+		idx := strings.IndexRune(s[pos:], r)
+		if idx < 0 {
+			return 0, false
+		}
+		score += idx
+		pos += idx + len(string(r))
+	}
+	return score, true
+}
+
+// SetFuzzy enables or disables fuzzy command suggestions.
+func (c *CmdBar) SetFuzzy(fuzzy bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.fuzzy = fuzzy
+}
+
 // updateSuggestions updates the suggestion list based on current text.
 func (c *CmdBar) updateSuggestions() {
 	c.mx.Lock()
@@ -260,6 +328,62 @@ func (c *CmdBar) clearSuggestions() {
 	c.currentSuggestion = ""
 }
 
+// recall steps through the command history; positive steps go back in time.
+// Stepping past the newest entry clears the input. Caller must hold the lock.
+func (c *CmdBar) recall(step int) {
+	if c.mode != ModeCommand || len(c.history) == 0 {
+		return
+	}
+
+	idx := c.historyIdx + step
+	if idx >= len(c.history) {
+		idx = len(c.history) - 1
+	}
+	if idx < 0 {
+		c.historyIdx = -1
+		c.text = c.text[:0]
+		return
+	}
+
+	c.historyIdx = idx
+	c.text = []rune(c.history[len(c.history)-1-idx])
+}
+
+// addHistory records an executed command, dropping a repeat of the last one.
+func (c *CmdBar) addHistory(cmd string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if n := len(c.history); n > 0 && c.history[n-1] == cmd {
+		return
+	}
+	c.history = append(c.history, cmd)
+	if len(c.history) > MaxHistory {
+		c.history = c.history[len(c.history)-MaxHistory:]
+	}
+}
+
+// History returns the executed commands, oldest first.
+func (c *CmdBar) History() []string {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	history := make([]string, len(c.history))
+	copy(history, c.history)
+	return history
+}
+
+// SetHistory replaces the command history, oldest first.
+func (c *CmdBar) SetHistory(cmds []string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if len(cmds) > MaxHistory {
+		cmds = cmds[len(cmds)-MaxHistory:]
+	}
+	c.history = append([]string(nil), cmds...)
+}
+
 // AddCommands adds additional commands to the suggestion list.
 func (c *CmdBar) AddCommands(cmds []string) {
 	c.mx.Lock()
@@ -280,6 +404,20 @@ func (c *CmdBar) AddCommands(cmds []string) {
 	sort.Strings(c.commands)
 }
 
+// RemoveCommands removes commands from the suggestion list.
+func (c *CmdBar) RemoveCommands(cmds []string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	kept := make([]string, 0, len(c.commands))
+	for _, existing := range c.commands {
+		if !slices.Contains(cmds, existing) {
+			kept = append(kept, existing)
+		}
+	}
+	c.commands = kept
+}
+
 // SetCommands sets the full list of available commands.
 func (c *CmdBar) SetCommands(cmds []string) {
 	c.mx.Lock()
@@ -310,6 +448,7 @@ func (c *CmdBar) Activate(mode IndicatorMode) {
 	c.mode = mode
 	c.isActive = true
 	c.text = c.text[:0]
+	c.historyIdx = -1
 	c.mx.Unlock()
 	c.clearSuggestions()
 	c.render()
@@ -340,12 +479,18 @@ func (c *CmdBar) execute() {
 
 	switch c.mode {
 	case ModeCommand:
+		if text != "" {
+			c.addHistory(text)
+		}
 		if c.cmdFn != nil && text != "" {
 			c.cmdFn(":" + text)
 		}
 	case ModeFilter:
 		c.filterText = text
 		// Filter is already applied via ChangedFunc
+		if c.filterSubmitFn != nil {
+			c.filterSubmitFn(text)
+		}
 	}
 
 	c.Deactivate()
@@ -379,6 +524,11 @@ func (c *CmdBar) SetFilterFn(fn func(string)) {
 	c.filterFn = fn
 }
 
+// SetFilterSubmitFn sets the callback for when the filter is confirmed with Enter.
+func (c *CmdBar) SetFilterSubmitFn(fn func(string)) {
+	c.filterSubmitFn = fn
+}
+
 // SetCancelFn sets the callback for when filter is cancelled.
 func (c *CmdBar) SetCancelFn(fn func()) {
 	c.cancelFn = fn
@@ -11,11 +11,18 @@ import (
 // ConfirmFunc is called when user confirms action.
 type ConfirmFunc func()
 
+// TypedConfirmID is the page identifier used by type-to-confirm dialogs.
+const TypedConfirmID = "typed-confirm-dialog"
+
 // Confirm represents a confirmation dialog.
 type Confirm struct {
 	*tview.Modal
 	confirmed bool
 	dangerous bool
+	expected  string
+	message   string
+	typed     *tview.ModalForm
+	field     *tview.InputField
 	onConfirm ConfirmFunc
 	onCancel  func()
 	pages     *Pages
@@ -45,10 +52,25 @@ func (c *Confirm) SetTitle(title string) *Confirm {
 
 // SetMessage sets the confirmation message.
 func (c *Confirm) SetMessage(msg string) *Confirm {
+	c.message = msg
 	c.Modal.SetText(msg)
 	return c
 }
 
+// SetButtons replaces the default Yes/No button labels.
+func (c *Confirm) SetButtons(confirm, cancel string) *Confirm {
+	c.ClearButtons()
+	c.AddButtons([]string{confirm, cancel})
+	return c
+}
+
+// SetConfirmationText requires the user to type expected before confirming.
+// The confirm button stays inactive until the typed text matches exactly.
+func (c *Confirm) SetConfirmationText(expected string) *Confirm {
+	c.expected = expected
+	return c
+}
+
 // SetDangerous styles the dialog for dangerous operations.
 func (c *Confirm) SetDangerous(dangerous bool) *Confirm {
 	c.dangerous = dangerous
@@ -70,9 +92,16 @@ func (c *Confirm) SetOnCancel(fn func()) *Confirm {
 
 // Show displays the dialog.
 func (c *Confirm) Show() {
-	if c.pages != nil {
-		c.pages.AddPage(c.pageID, c, true, true)
+	if c.pages == nil {
+		return
+	}
+
+	if c.expected != "" {
+		c.pageID = TypedConfirmID
+		c.pages.AddPage(c.pageID, c.buildTyped(), true, true)
+		return
 	}
+	c.pages.AddPage(c.pageID, c, true, true)
 }
 
 // Dismiss removes the dialog.
@@ -82,6 +111,69 @@ func (c *Confirm) Dismiss() {
 	}
 }
 
+// buildTyped builds the type-to-confirm variant of the dialog.
+func (c *Confirm) buildTyped() *tview.ModalForm {
+	form := tview.NewForm()
+	c.field = tview.NewInputField()
+	c.field.SetLabel("Type " + c.expected + ": ")
+	c.field.SetFieldWidth(0)
+
+	form.AddFormItem(c.field)
+	form.AddButton("Confirm", func() {
+		if c.matches() {
+			c.handleButton(0, "Confirm")
+		}
+	})
+	form.AddButton("Cancel", func() {
+		c.handleButton(1, "Cancel")
+	})
+	form.SetButtonsAlign(tview.AlignCenter)
+	theme := CurrentTheme()
+	form.SetFieldBackgroundColor(theme.Color(theme.Field))
+	form.SetButtonTextColor(theme.Color(theme.ButtonText))
+
+	// Without colors the label tells whether the typed text matches
+	confirmBtn := form.GetButton(0)
+	updateButton := func(string) {
+		if c.matches() {
+			confirmBtn.SetBackgroundColor(theme.Color(theme.Bad))
+			confirmBtn.SetLabelColor(theme.Color(theme.ButtonText))
+			confirmBtn.SetLabel("Confirm")
+		} else {
+			confirmBtn.SetBackgroundColor(theme.Color(theme.Field))
+			confirmBtn.SetLabelColor(theme.Color(theme.Dim))
+			if NoColor() {
+				confirmBtn.SetLabel("(Confirm)")
+			}
+		}
+	}
+	c.field.SetChangedFunc(updateButton)
+	c.field.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter:
+			if c.matches() {
+				c.handleButton(0, "Confirm")
+			}
+		case tcell.KeyEsc:
+			c.handleButton(1, "Cancel")
+		}
+	})
+	updateButton("")
+
+	c.typed = newModalForm("Confirm", form, func() {
+		c.handleButton(1, "Cancel")
+	})
+	c.typed.SetText(c.message)
+	c.typed.SetTextColor(theme.Color(theme.Bad))
+
+	return c.typed
+}
+
+// matches reports whether the typed text equals the expected confirmation text.
+func (c *Confirm) matches() bool {
+	return c.field != nil && c.field.GetText() == c.expected
+}
+
 // handleButton processes button clicks.
 func (c *Confirm) handleButton(buttonIndex int, buttonLabel string) {
 	c.Dismiss()
@@ -102,14 +194,15 @@ func (c *Confirm) handleButton(buttonIndex int, buttonLabel string) {
 
 // updateStyle applies styling based on danger level.
 func (c *Confirm) updateStyle() {
+	theme := CurrentTheme()
 	if c.dangerous {
-		c.SetTextColor(tcell.ColorRed)
-		c.SetButtonBackgroundColor(tcell.ColorRed)
-		c.SetButtonTextColor(tcell.ColorWhite)
+		c.SetTextColor(theme.Color(theme.Bad))
+		c.SetButtonBackgroundColor(theme.Color(theme.Bad))
+		c.SetButtonTextColor(theme.Color(theme.ButtonText))
 	} else {
-		c.SetTextColor(tcell.ColorWhite)
-		c.SetButtonBackgroundColor(tcell.ColorBlue)
-		c.SetButtonTextColor(tcell.ColorWhite)
+		c.SetTextColor(theme.Color(theme.Text))
+		c.SetButtonBackgroundColor(theme.Color(theme.Button))
+		c.SetButtonTextColor(theme.Color(theme.ButtonText))
 	}
 }
 
@@ -4,6 +4,7 @@ package ui
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/tcell/v2"
@@ -14,7 +15,8 @@ import (
 type Crumbs struct {
 	*tview.TextView
 
-	stack *Stack
+	stack    *Stack
+	selected func(index int)
 }
 
 // NewCrumbs returns a new breadcrumb view.
@@ -27,10 +29,37 @@ func NewCrumbs() *Crumbs {
 	c.SetTextAlign(tview.AlignLeft)
 	c.SetBorderPadding(0, 0, 1, 1)
 	c.SetDynamicColors(true)
+	c.SetRegions(true)
+	c.SetHighlightedFunc(c.clicked)
 
 	return c
 }
 
+// SetSelectedFn sets the callback for a clicked crumb, by index.
+func (c *Crumbs) SetSelectedFn(fn func(index int)) {
+	c.selected = fn
+}
+
+// Update shows the given crumbs, the last one being active.
+func (c *Crumbs) Update(crumbs []string) {
+	c.refresh(crumbs)
+}
+
+// clicked reports a clicked crumb region and clears the highlight so the
+// same crumb can be clicked again.
+func (c *Crumbs) clicked(added, _, _ []string) {
+	if len(added) == 0 {
+		return
+	}
+	c.Highlight()
+
+	index, err := strconv.Atoi(added[0])
+	if err != nil || c.selected == nil {
+		return
+	}
+	c.selected(index)
+}
+
 // StackPushed indicates a new item was added.
 func (c *Crumbs) StackPushed(comp Component) {
 	c.stack.Push(comp)
@@ -49,17 +78,17 @@ func (*Crumbs) StackTop(Component) {}
 // Refresh updates view with new crumbs.
 func (c *Crumbs) refresh(crumbs []string) {
 	c.Clear()
+	theme := CurrentTheme()
 	last := len(crumbs) - 1
 
 	for i, crumb := range crumbs {
+		label := tview.Escape(strings.TrimSuffix(crumb, "/"))
 		if i == last {
-			// Active crumb - bright yellow
-			_, _ = fmt.Fprintf(c, "[yellow:black:b] <%s> [-:-:-] ",
-				strings.ReplaceAll(strings.ToLower(crumb), " ", ""))
+			// Active crumb
+			_, _ = fmt.Fprintf(c, "[\"%d\"][%s::b] <%s> [-:-:-][\"\"] ", i, theme.Header, label)
 		} else {
 			// Inactive crumb - dim
-			_, _ = fmt.Fprintf(c, "[gray::-] <%s> [-:-:-] ",
-				strings.ReplaceAll(strings.ToLower(crumb), " ", ""))
+			_, _ = fmt.Fprintf(c, "[\"%d\"][%s::-] <%s> [-:-:-][\"\"] ", i, theme.Dim, label)
 		}
 	}
 }
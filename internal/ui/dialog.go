@@ -28,7 +28,7 @@ func NewDialog(pages *Pages, pageID string) *Dialog {
 	}
 
 	d.SetBackgroundColor(tcell.ColorDefault)
-	d.SetTextColor(tcell.ColorWhite)
+	d.SetTextColor(CurrentTheme().Color(CurrentTheme().Text))
 
 	return d
 }
@@ -109,20 +109,22 @@ func InfoDialog(pages *Pages, title, message string) *Dialog {
 
 // ErrorDialog creates a styled error dialog.
 func ErrorDialog(pages *Pages, title, message string) *Dialog {
+	theme := CurrentTheme()
 	return NewDialog(pages, "error-dialog").
 		SetTitle(title).
 		SetMessage(message).
 		SetButtons([]string{"OK"}).
-		SetColors(tcell.ColorRed, tcell.ColorRed, tcell.ColorWhite).
+		SetColors(theme.Color(theme.Bad), theme.Color(theme.Bad), theme.Color(theme.ButtonText)).
 		SetButtonHandler(func(_ int, _ string) {})
 }
 
 // WarningDialog creates a styled warning dialog.
 func WarningDialog(pages *Pages, title, message string) *Dialog {
+	theme := CurrentTheme()
 	return NewDialog(pages, "warning-dialog").
 		SetTitle(title).
 		SetMessage(message).
 		SetButtons([]string{"OK"}).
-		SetColors(tcell.ColorYellow, tcell.ColorYellow, tcell.ColorBlack).
+		SetColors(theme.Color(theme.Pending), theme.Color(theme.Pending), theme.Color(theme.Field)).
 		SetButtonHandler(func(_ int, _ string) {})
 }
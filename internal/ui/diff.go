@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package ui
+
+import (
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// DiffDialogID is the page identifier used by diff preview dialogs.
+const DiffDialogID = "diff-dialog"
+
+// DiffDialog previews pending changes and lets the user apply them,
+// go back to the editor or cancel.
+type DiffDialog struct {
+	*tview.Flex
+	text     *tview.TextView
+	form     *tview.Form
+	onApply  func()
+	onEdit   func()
+	onCancel func()
+	pages    *Pages
+}
+
+// NewDiffDialog creates a diff dialog. diff may contain color tags.
+func NewDiffDialog(pages *Pages, title, diff string) *DiffDialog {
+	d := &DiffDialog{
+		text:  tview.NewTextView(),
+		form:  tview.NewForm(),
+		pages: pages,
+	}
+
+	d.text.SetDynamicColors(true)
+	d.text.SetScrollable(true)
+	d.text.SetWrap(false)
+	d.text.SetText(diff)
+
+	d.form.AddButton("Apply", d.apply)
+	d.form.AddButton("Re-edit", d.edit)
+	d.form.AddButton("Cancel", d.cancel)
+	d.form.SetButtonsAlign(tview.AlignCenter)
+	theme := CurrentTheme()
+	d.form.SetButtonBackgroundColor(theme.Color(theme.Button))
+	d.form.SetButtonTextColor(theme.Color(theme.ButtonText))
+	d.form.SetCancelFunc(d.cancel)
+
+	box := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(d.text, 0, 1, false).
+		AddItem(d.form, 3, 0, true)
+	box.SetBorder(true)
+	box.SetBorderPadding(0, 0, 1, 1)
+	box.SetTitle(" " + title + " ")
+	box.SetInputCapture(d.keyboard)
+
+	d.Flex = tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(box, 0, 6, true).
+			AddItem(nil, 0, 1, false), 0, 6, true).
+		AddItem(nil, 0, 1, false)
+
+	return d
+}
+
+// SetOnApply sets the callback for when the user applies the changes.
+func (d *DiffDialog) SetOnApply(fn func()) *DiffDialog {
+	d.onApply = fn
+	return d
+}
+
+// SetOnEdit sets the callback for when the user goes back to the editor.
+func (d *DiffDialog) SetOnEdit(fn func()) *DiffDialog {
+	d.onEdit = fn
+	return d
+}
+
+// SetOnCancel sets the callback for when the user cancels.
+func (d *DiffDialog) SetOnCancel(fn func()) *DiffDialog {
+	d.onCancel = fn
+	return d
+}
+
+// Show displays the dialog.
+func (d *DiffDialog) Show() {
+	if d.pages != nil {
+		d.pages.AddPage(DiffDialogID, d, true, true)
+	}
+}
+
+// Dismiss removes the dialog.
+func (d *DiffDialog) Dismiss() {
+	if d.pages != nil {
+		d.pages.RemovePage(DiffDialogID)
+	}
+}
+
+// keyboard scrolls the diff while the buttons keep focus.
+func (d *DiffDialog) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	switch evt.Key() {
+	case tcell.KeyUp, tcell.KeyDown, tcell.KeyPgUp, tcell.KeyPgDn, tcell.KeyHome, tcell.KeyEnd:
+	case tcell.KeyRune:
+		switch evt.Rune() {
+		case 'j', 'k', 'g', 'G':
+		default:
+			return evt
+		}
+	default:
+		return evt
+	}
+
+	if handler := d.text.InputHandler(); handler != nil {
+		handler(evt, func(tview.Primitive) {})
+	}
+	return nil
+}
+
+// apply dismisses the dialog and applies the changes.
+func (d *DiffDialog) apply() {
+	d.Dismiss()
+	if d.onApply != nil {
+		d.onApply()
+	}
+}
+
+// edit dismisses the dialog and returns to the editor.
+func (d *DiffDialog) edit() {
+	d.Dismiss()
+	if d.onEdit != nil {
+		d.onEdit()
+	}
+}
+
+// cancel dismisses the dialog without applying anything.
+func (d *DiffDialog) cancel() {
+	d.Dismiss()
+	if d.onCancel != nil {
+		d.onCancel()
+	}
+}
@@ -25,6 +25,14 @@ func init() {
 				}
 				return aws.StopInstance(ctx, ec2Client, identifier)
 			},
+			WaitState: "stopped",
+			Wait: func(ctx context.Context, client aws.Connection, region, identifier string) error {
+				ec2Client := client.EC2(region)
+				if ec2Client == nil {
+					return errors.New("failed to get EC2 client")
+				}
+				return aws.WaitInstanceStopped(ctx, ec2Client, identifier, aws.DefaultWaiterTimeout)
+			},
 		},
 		{
 			Key:         tcell.KeyCtrlS,
@@ -38,6 +46,14 @@ func init() {
 				}
 				return aws.StartInstance(ctx, ec2Client, identifier)
 			},
+			WaitState: "running",
+			Wait: func(ctx context.Context, client aws.Connection, region, identifier string) error {
+				ec2Client := client.EC2(region)
+				if ec2Client == nil {
+					return errors.New("failed to get EC2 client")
+				}
+				return aws.WaitInstanceRunning(ctx, ec2Client, identifier, aws.DefaultWaiterTimeout)
+			},
 		},
 		{
 			Key:         tcell.KeyCtrlR,
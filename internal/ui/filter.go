@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/a1s/a1s/internal/model1"
+)
+
+// regexFilterPrefix marks a filter as a regular expression, e.g. "/running|pending".
+const regexFilterPrefix = "/"
+
+// negateFilterPrefix excludes rows matching a filter term, e.g. "!terminated".
+const negateFilterPrefix = "!"
+
+// rowMatcher reports whether a row passes a filter.
+type rowMatcher func(fields model1.Fields) bool
+
+// compileFilter builds the row matcher for a filter; a nil matcher keeps all rows.
+// Filters starting with "/" are Go regular expressions matched against every
+// field; an invalid pattern yields a nil matcher and the compile error.
+// Otherwise the filter is parsed by parseFilter.
+// The returned hint spells out how the filter is applied, for the table title.
+func compileFilter(filter string, header model1.Header) (rowMatcher, string, error) {
+	if pattern, ok := strings.CutPrefix(filter, regexFilterPrefix); ok {
+		if pattern == "" {
+			return nil, "", nil
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid regex: %w", err)
+		}
+		return func(fields model1.Fields) bool {
+			for _, field := range fields {
+				if re.MatchString(field) {
+					return true
+				}
+			}
+			return false
+		}, "regex " + pattern, nil
+	}
+
+	terms, err := parseFilter(filter, header)
+	if len(terms) == 0 {
+		return nil, "", err
+	}
+	return func(fields model1.Fields) bool {
+		return matchRow(terms, fields)
+	}, describeTerms(terms, header), err
+}
+
+// filterTerm is one token of a table filter. col is -1 for tokens matching any column.
+type filterTerm struct {
+	col    int
+	text   string
+	negate bool
+}
+
+// parseFilter splits a filter into space-separated terms that must all match.
+// A "column:value" term matches only that column (e.g. state:running); column
+// names are case-insensitive and may use - or _ for spaces (public-ip).
+// A leading "!" negates the whole term, column included: "!type:t3" keeps rows
+// whose TYPE does not contain t3.
+// Terms naming an unknown column match as plain text and are reported in the error.
+func parseFilter(filter string, header model1.Header) ([]filterTerm, error) {
+	var (
+		terms   []filterTerm
+		unknown []string
+	)
+	for _, token := range strings.Fields(strings.ToLower(filter)) {
+		token, negate := strings.CutPrefix(token, negateFilterPrefix)
+		if token == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(token, ":")
+		if !ok || name == "" || value == "" {
+			terms = append(terms, filterTerm{col: -1, text: token, negate: negate})
+			continue
+		}
+
+		col, found := headerIndex(header, name)
+		if !found {
+			unknown = append(unknown, name)
+			terms = append(terms, filterTerm{col: -1, text: token, negate: negate})
+			continue
+		}
+		terms = append(terms, filterTerm{col: col, text: value, negate: negate})
+	}
+
+	if len(unknown) > 0 {
+		return terms, fmt.Errorf("unknown column %s (columns: %s)",
+			strings.Join(unknown, ", "), strings.ToLower(strings.Join(header.ColumnNames(true), ", ")))
+	}
+	return terms, nil
+}
+
+// describeTerms renders parsed terms with explicit AND/NOT, e.g. "state:running AND NOT type:t3".
+func describeTerms(terms []filterTerm, header model1.Header) string {
+	parts := make([]string, 0, len(terms))
+	for _, t := range terms {
+		part := t.text
+		if t.col >= 0 && t.col < len(header) {
+			part = strings.ToLower(header[t.col].Name) + ":" + t.text
+		}
+		if t.negate {
+			part = "NOT " + part
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " AND ")
+}
+
+// headerIndex finds a column by case-insensitive name.
+func headerIndex(header model1.Header, name string) (int, bool) {
+	name = strings.NewReplacer("-", " ", "_", " ").Replace(name)
+	for i, c := range header {
+		if strings.EqualFold(c.Name, name) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// matchRow reports whether a row satisfies every term.
+func matchRow(terms []filterTerm, fields model1.Fields) bool {
+	for _, t := range terms {
+		if !t.matches(fields) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether the term, after negation, accepts the row's fields.
+func (t filterTerm) matches(fields model1.Fields) bool {
+	return t.contains(fields) != t.negate
+}
+
+// contains reports whether the term's text occurs in its column, or in any field.
+func (t filterTerm) contains(fields model1.Fields) bool {
+	if t.col >= 0 {
+		return t.col < len(fields) && strings.Contains(strings.ToLower(fields[t.col]), t.text)
+	}
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), t.text) {
+			return true
+		}
+	}
+	return false
+}
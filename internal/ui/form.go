@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package ui
+
+import (
+	"github.com/derailed/tview"
+)
+
+// FormDialogID is the page identifier used by form dialogs.
+const FormDialogID = "form-dialog"
+
+// FormFunc is called with the submitted values, keyed by field label.
+type FormFunc func(values map[string]string)
+
+// FormDialog represents a modal dialog with several input fields.
+type FormDialog struct {
+	*tview.ModalForm
+	form     *tview.Form
+	onSubmit FormFunc
+	onCancel func()
+	pages    *Pages
+}
+
+// NewFormDialog creates a new form dialog.
+func NewFormDialog(pages *Pages, title string) *FormDialog {
+	d := &FormDialog{
+		form:  tview.NewForm(),
+		pages: pages,
+	}
+
+	d.form.SetButtonsAlign(tview.AlignCenter)
+	theme := CurrentTheme()
+	d.form.SetFieldBackgroundColor(theme.Color(theme.Field))
+	d.form.SetButtonBackgroundColor(theme.Color(theme.Button))
+	d.form.SetButtonTextColor(theme.Color(theme.ButtonText))
+
+	d.ModalForm = newModalForm(title, d.form, d.cancel)
+
+	return d
+}
+
+// AddInputField adds a text field with an initial value.
+func (d *FormDialog) AddInputField(label, text string) *FormDialog {
+	d.form.AddInputField(label, text, 0, nil, nil)
+	return d
+}
+
+// AddDropDown adds a drop-down with the given options, selecting the first.
+func (d *FormDialog) AddDropDown(label string, options []string) *FormDialog {
+	d.form.AddDropDown(label, options, 0, nil)
+	return d
+}
+
+// AddDropDownSelected adds a drop-down with the given options, selecting
+// the one equal to selected or the first if none is.
+func (d *FormDialog) AddDropDownSelected(label string, options []string, selected string) *FormDialog {
+	idx := 0
+	for i, o := range options {
+		if o == selected {
+			idx = i
+			break
+		}
+	}
+	d.form.AddDropDown(label, options, idx, nil)
+	return d
+}
+
+// SetOnSubmit sets the callback for when user accepts the form.
+func (d *FormDialog) SetOnSubmit(fn FormFunc) *FormDialog {
+	d.onSubmit = fn
+	return d
+}
+
+// SetOnCancel sets the callback for when user cancels.
+func (d *FormDialog) SetOnCancel(fn func()) *FormDialog {
+	d.onCancel = fn
+	return d
+}
+
+// Show displays the dialog.
+func (d *FormDialog) Show() {
+	d.form.AddButton("OK", d.submit)
+	d.form.AddButton("Cancel", d.cancel)
+
+	if d.pages != nil {
+		d.pages.AddPage(FormDialogID, d, true, true)
+	}
+}
+
+// Dismiss removes the dialog.
+func (d *FormDialog) Dismiss() {
+	if d.pages != nil {
+		d.pages.RemovePage(FormDialogID)
+	}
+}
+
+// values collects the current field values keyed by label.
+func (d *FormDialog) values() map[string]string {
+	values := make(map[string]string, d.form.GetFormItemCount())
+	for i := 0; i < d.form.GetFormItemCount(); i++ {
+		switch item := d.form.GetFormItem(i).(type) {
+		case *tview.InputField:
+			values[item.GetLabel()] = item.GetText()
+		case *tview.DropDown:
+			_, option := item.GetCurrentOption()
+			values[item.GetLabel()] = option
+		}
+	}
+	return values
+}
+
+// submit dismisses the dialog and reports the entered values.
+func (d *FormDialog) submit() {
+	values := d.values()
+	d.Dismiss()
+	if d.onSubmit != nil {
+		d.onSubmit(values)
+	}
+}
+
+// cancel dismisses the dialog without accepting the input.
+func (d *FormDialog) cancel() {
+	d.Dismiss()
+	if d.onCancel != nil {
+		d.onCancel()
+	}
+}
+
+// newModalForm wraps form in a titled modal whose Escape calls cancel.
+// ModalForm installs its own cancel handler, so it is overridden afterwards.
+func newModalForm(title string, form *tview.Form, cancel func()) *tview.ModalForm {
+	m := tview.NewModalForm(" "+title+" ", form)
+	form.SetCancelFunc(cancel)
+	return m
+}
@@ -49,7 +49,7 @@ func NewCmdIndicator() *CmdIndicator {
 
 	c.SetDynamicColors(true)
 	c.SetBackgroundColor(tcell.ColorDefault)
-	c.SetTextColor(tcell.ColorWhite)
+	c.SetTextColor(CurrentTheme().Color(CurrentTheme().Text))
 	c.refresh()
 
 	return c
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package ui
+
+import (
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// InputDialogID is the page identifier used by input dialogs.
+const InputDialogID = "input-dialog"
+
+// InputFunc is called with the submitted text when user accepts the input.
+type InputFunc func(text string)
+
+// InputDialog represents a modal dialog with a single text input field.
+type InputDialog struct {
+	*tview.ModalForm
+	form     *tview.Form
+	field    *tview.InputField
+	onSubmit InputFunc
+	onCancel func()
+	pages    *Pages
+	pageID   string
+}
+
+// NewInputDialog creates a new input dialog.
+func NewInputDialog(pages *Pages, title, label string) *InputDialog {
+	d := &InputDialog{
+		form:   tview.NewForm(),
+		field:  tview.NewInputField(),
+		pages:  pages,
+		pageID: InputDialogID,
+	}
+
+	d.field.SetLabel(label)
+	d.field.SetFieldWidth(0)
+	d.field.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter:
+			d.submit()
+		case tcell.KeyEsc:
+			d.cancel()
+		}
+	})
+
+	d.form.AddFormItem(d.field)
+	d.form.AddButton("OK", d.submit)
+	d.form.AddButton("Cancel", d.cancel)
+	d.form.SetButtonsAlign(tview.AlignCenter)
+	theme := CurrentTheme()
+	d.form.SetFieldBackgroundColor(theme.Color(theme.Field))
+	d.form.SetButtonBackgroundColor(theme.Color(theme.Button))
+	d.form.SetButtonTextColor(theme.Color(theme.ButtonText))
+
+	d.ModalForm = newModalForm(title, d.form, d.cancel)
+
+	return d
+}
+
+// SetText sets the initial input text.
+func (d *InputDialog) SetText(text string) *InputDialog {
+	d.field.SetText(text)
+	return d
+}
+
+// SetOnSubmit sets the callback for when user accepts the input.
+func (d *InputDialog) SetOnSubmit(fn InputFunc) *InputDialog {
+	d.onSubmit = fn
+	return d
+}
+
+// SetOnCancel sets the callback for when user cancels.
+func (d *InputDialog) SetOnCancel(fn func()) *InputDialog {
+	d.onCancel = fn
+	return d
+}
+
+// Show displays the dialog.
+func (d *InputDialog) Show() {
+	if d.pages != nil {
+		d.pages.AddPage(d.pageID, d, true, true)
+	}
+}
+
+// Dismiss removes the dialog.
+func (d *InputDialog) Dismiss() {
+	if d.pages != nil {
+		d.pages.RemovePage(d.pageID)
+	}
+}
+
+// submit dismisses the dialog and reports the entered text.
+func (d *InputDialog) submit() {
+	text := d.field.GetText()
+	d.Dismiss()
+	if d.onSubmit != nil {
+		d.onSubmit(text)
+	}
+}
+
+// cancel dismisses the dialog without accepting the input.
+func (d *InputDialog) cancel() {
+	d.Dismiss()
+	if d.onCancel != nil {
+		d.onCancel()
+	}
+}
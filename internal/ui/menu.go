@@ -13,20 +13,23 @@ import (
 )
 
 const (
-	menuIndexFmt = " [yellow::b]<%d>[white::-] %s "
-	menuPlainFmt = " [yellow::b]<%s>[white::-] %s "
+	menuIndexFmt = " [%s::b]<%d>[%s::-] %s "
+	menuPlainFmt = " [%s::b]<%s>[%s::-] %s "
 	maxRows      = 6
 )
 
 // Menu presents menu options.
 type Menu struct {
 	*tview.Table
+
+	rows int
 }
 
 // NewMenu returns a new menu.
 func NewMenu() *Menu {
 	m := &Menu{
 		Table: tview.NewTable(),
+		rows:  maxRows,
 	}
 	m.SetBackgroundColor(tcell.ColorDefault)
 	m.SetBorderPadding(0, 0, 1, 1)
@@ -34,14 +37,21 @@ func NewMenu() *Menu {
 	return m
 }
 
+// SetRows sets how many rows hints flow down before starting a new column.
+func (m *Menu) SetRows(rows int) {
+	if rows > 0 {
+		m.rows = rows
+	}
+}
+
 // HydrateMenu populate menu ui from hints.
 func (m *Menu) HydrateMenu(hh MenuHints) {
 	m.Clear()
 	sort.Sort(hh)
 
-	table := make([]MenuHints, maxRows+1)
-	colCount := (len(hh) / maxRows) + 1
-	for row := range maxRows {
+	table := make([]MenuHints, m.rows+1)
+	colCount := (len(hh) / m.rows) + 1
+	for row := range m.rows {
 		table[row] = make(MenuHints, colCount)
 	}
 	out := m.buildMenuTable(hh, table, colCount)
@@ -72,7 +82,7 @@ func (m *Menu) buildMenuTable(hh MenuHints, table []MenuHints, colCount int) [][
 		}
 		table[row][col] = h
 		row++
-		if row >= maxRows {
+		if row >= m.rows {
 			row, col = 0, col+1
 		}
 	}
@@ -101,10 +111,10 @@ func (m *Menu) formatMenu(h MenuHint) string {
 
 	i, err := strconv.Atoi(h.Mnemonic)
 	if err == nil {
-		return fmt.Sprintf(menuIndexFmt, i, h.Description)
+		return fmt.Sprintf(menuIndexFmt, CurrentTheme().Header, i, CurrentTheme().Text, h.Description)
 	}
 
-	return fmt.Sprintf(menuPlainFmt, h.Mnemonic, h.Description)
+	return fmt.Sprintf(menuPlainFmt, CurrentTheme().Header, h.Mnemonic, CurrentTheme().Text, h.Description)
 }
 
 // StackPushed notifies a component was added.
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package ui
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+)
+
+// ErrNoOpener is returned when no OS browser opener is available.
+var ErrNoOpener = errors.New("no browser opener available")
+
+// OpenURL opens url with the operating system's default browser.
+func OpenURL(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		if _, err := exec.LookPath("xdg-open"); err != nil {
+			return ErrNoOpener
+		}
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return ErrNoOpener
+	}
+	// Reap the opener without blocking the UI
+	go cmd.Wait()
+
+	return nil
+}
@@ -65,6 +65,17 @@ func (p *Pages) CurrentPage() tview.Primitive {
 	return p.pageMap[name]
 }
 
+// Stack returns the page names and primitives, bottom first.
+func (p *Pages) Stack() ([]string, []tview.Primitive) {
+	names := make([]string, len(p.stack))
+	copy(names, p.stack)
+	pages := make([]tview.Primitive, len(p.stack))
+	for i, name := range p.stack {
+		pages[i] = p.pageMap[name]
+	}
+	return names, pages
+}
+
 // StackSize returns the stack depth
 func (p *Pages) StackSize() int {
 	return len(p.stack)
@@ -25,12 +25,21 @@ type ResourceTable struct {
 	header      model1.Header
 	sortColName string
 	filterText  string
+	filterErr   error
+	filterHint  string
 	fullData    *model1.TableData
 	isUpdating  bool
+	spinner     string
 	marks       map[string]struct{}
+	rowColorFn  RowColorFunc
+	wide        bool
 	mx          sync.RWMutex
 }
 
+// RowColorFunc returns a text color overriding the default for a row, keyed by row ID.
+// Returning false keeps the regular per-cell colors.
+type RowColorFunc func(id string) (tcell.Color, bool)
+
 // NewResourceTable creates a new resource table.
 func NewResourceTable(rid *dao.ResourceID) *ResourceTable {
 	r := &ResourceTable{
@@ -44,14 +53,24 @@ func NewResourceTable(rid *dao.ResourceID) *ResourceTable {
 	r.SetBorder(true)
 	r.SetBorderAttributes(tcell.AttrBold)
 	r.SetBorderPadding(0, 0, 1, 1)
-	r.SetBorderColor(tcell.ColorWhite)
+	r.SetBorderColor(CurrentTheme().Color(CurrentTheme().Border))
 	r.SetBackgroundColor(tcell.ColorDefault)
 	r.SetFixed(1, 0)
 	r.SetSelectable(true, false)
+	if NoColor() {
+		r.SetSelectedStyle(SelectedStyle(tcell.ColorDefault, tcell.ColorDefault))
+	}
 
 	return r
 }
 
+// SetRowColorFn sets a function that highlights whole rows.
+func (r *ResourceTable) SetRowColorFn(fn RowColorFunc) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.rowColorFn = fn
+}
+
 // Init initializes the resource table.
 func (r *ResourceTable) Init(ctx context.Context) error {
 	r.Select(1, 0)
@@ -144,9 +163,26 @@ func (r *ResourceTable) bindKeys() {
 	r.actions.Bulk(KeyMap{
 		tcell.KeyCtrlS: NewKeyAction("Sort", r.sortHandler, true),
 		tcell.KeyEnter: NewKeyAction("Select", r.selectHandler, true),
+		KeySpace:       NewKeyAction("Mark", r.markHandler, true),
 	})
 }
 
+// markHandler toggles the mark on the selected row and moves to the next one.
+func (r *ResourceTable) markHandler(evt *tcell.EventKey) *tcell.EventKey {
+	row, col := r.GetSelection()
+	if row == 0 {
+		return nil
+	}
+
+	r.ToggleMark()
+	r.styleRow(row)
+
+	if row < r.GetRowCount()-1 {
+		r.Select(row+1, col)
+	}
+	return nil
+}
+
 // sortHandler cycles through sort columns.
 func (r *ResourceTable) sortHandler(evt *tcell.EventKey) *tcell.EventKey {
 	r.mx.Lock()
@@ -178,12 +214,12 @@ func (r *ResourceTable) selectHandler(evt *tcell.EventKey) *tcell.EventKey {
 
 // showNoData displays a message when there's no data.
 func (r *ResourceTable) showNoData(msg string) {
-	r.showMessage(msg, tcell.ColorGray)
+	r.showMessage(msg, CurrentTheme().Color(CurrentTheme().Dim))
 }
 
 // showError displays an error message in red.
 func (r *ResourceTable) showError(msg string) {
-	r.showMessage(msg, tcell.ColorRed)
+	r.showMessage(msg, CurrentTheme().Color(CurrentTheme().Bad))
 }
 
 // showMessage displays a centered message with the given color.
@@ -239,15 +275,60 @@ func (r *ResourceTable) GetSelectedItem() string {
 		return ""
 	}
 
-	cell := r.GetCell(row, 0)
-	if cell == nil {
+	return r.rowID(row)
+}
+
+// GetMarkedItems returns the IDs of the visible marked rows in table order.
+func (r *ResourceTable) GetMarkedItems() []string {
+	var items []string
+	for row := 1; row < r.GetRowCount(); row++ {
+		if id := r.rowID(row); id != "" && r.IsMarked(id) {
+			items = append(items, id)
+		}
+	}
+	return items
+}
+
+// GetField returns the value of the named column for the row with the given ID.
+// Returns an empty string when the row or column cannot be found.
+func (r *ResourceTable) GetField(item, colName string) string {
+	r.mx.RLock()
+	col, ok := r.header.IndexOf(colName, true)
+	r.mx.RUnlock()
+	if !ok {
 		return ""
 	}
 
-	if ref := cell.GetReference(); ref != nil {
-		if id, ok := ref.(string); ok {
-			return id
+	for row := 1; row < r.GetRowCount(); row++ {
+		if r.rowID(row) != item {
+			continue
 		}
+		if cell := r.GetCell(row, col); cell != nil {
+			return cell.Text
+		}
+		break
+	}
+	return ""
+}
+
+// GetSelectedField returns the selected row's value for the named column.
+// Returns an empty string when the column is not part of the current header.
+func (r *ResourceTable) GetSelectedField(colName string) string {
+	row, _ := r.GetSelection()
+	if row == 0 {
+		return ""
+	}
+
+	r.mx.RLock()
+	col, ok := r.header.IndexOf(colName, true)
+	r.mx.RUnlock()
+	if !ok {
+		return ""
+	}
+
+	cell := r.GetCell(row, col)
+	if cell == nil {
+		return ""
 	}
 	return cell.Text
 }
@@ -271,18 +352,32 @@ func (r *ResourceTable) ClearFilter() {
 	r.SetFilter("")
 }
 
+// FilterError returns the problem found in the current filter, if any.
+func (r *ResourceTable) FilterError() error {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+	return r.filterErr
+}
+
 // applyFilter filters data based on current filter text.
+// See compileFilter for the filter syntax.
 func (r *ResourceTable) applyFilter() {
 	r.mx.RLock()
 	data := r.fullData
-	filter := strings.ToLower(r.filterText)
+	filter := r.filterText
 	r.mx.RUnlock()
 
 	if data == nil {
 		return
 	}
 
-	if filter == "" {
+	match, hint, err := compileFilter(filter, data.Header())
+	r.mx.Lock()
+	r.filterErr = err
+	r.filterHint = hint
+	r.mx.Unlock()
+
+	if match == nil {
 		r.renderData(data)
 		return
 	}
@@ -295,11 +390,8 @@ func (r *ResourceTable) applyFilter() {
 	rowEvents := data.RowEvents()
 	if rowEvents != nil {
 		rowEvents.Range(func(idx int, re model1.RowEvent) bool {
-			for _, field := range re.Row.Fields {
-				if strings.Contains(strings.ToLower(field), filter) {
-					filtered.RowEvents().Add(re)
-					break
-				}
+			if match(re.Row.Fields) {
+				filtered.RowEvents().Add(re)
 			}
 			return true
 		})
@@ -316,15 +408,22 @@ func (r *ResourceTable) renderData(data *model1.TableData) {
 		return
 	}
 
+	// Remember the selection so periodic refreshes don't reset the cursor
+	selected := r.GetSelectedItem()
+
 	r.Clear()
 
 	header := data.Header()
 	r.buildHeader(header)
 
+	selRow := 1
 	rowEvents := data.RowEvents()
 	if rowEvents != nil {
 		rowEvents.Range(func(idx int, re model1.RowEvent) bool {
 			r.buildRow(re.Row, header, idx+1)
+			if selected != "" && re.Row.ID == selected {
+				selRow = idx + 1
+			}
 			return true
 		})
 	}
@@ -332,7 +431,7 @@ func (r *ResourceTable) renderData(data *model1.TableData) {
 	r.updateTitle()
 
 	if r.GetRowCount() > 1 {
-		r.Select(1, 0)
+		r.Select(selRow, 0)
 	}
 }
 
@@ -344,7 +443,7 @@ func (r *ResourceTable) buildHeader(header model1.Header) {
 
 	for col, h := range header {
 		cell := tview.NewTableCell(h.Name)
-		cell.SetTextColor(tcell.ColorYellow)
+		cell.SetTextColor(CurrentTheme().Color(CurrentTheme().Header))
 		cell.SetBackgroundColor(tcell.ColorDefault)
 		cell.SetAlign(h.Align)
 		cell.SetExpansion(1)
@@ -370,9 +469,13 @@ func (r *ResourceTable) buildRow(row model1.Row, header model1.Header, rowIdx in
 		cell.SetBackgroundColor(tcell.ColorDefault)
 		cell.SetAlign(header[col].Align)
 		cell.SetExpansion(1)
+		cell.SetMaxWidth(r.cellWidth(header[col]))
 
 		// Apply color based on column name and value
 		color := r.cellColor(header[col].Name, field)
+		if c, ok := r.rowColor(row.ID); ok {
+			color = c
+		}
 		cell.SetTextColor(color)
 
 		if col == 0 {
@@ -381,10 +484,99 @@ func (r *ResourceTable) buildRow(row model1.Row, header model1.Header, rowIdx in
 
 		r.SetCell(rowIdx, col, cell)
 	}
+
+	if r.IsMarked(row.ID) {
+		r.styleRow(rowIdx)
+	}
+}
+
+// styleRow applies mark styling to a row, or restores its regular colors.
+func (r *ResourceTable) styleRow(rowIdx int) {
+	id := r.rowID(rowIdx)
+	marked := r.IsMarked(id)
+	rowColor, hasRowColor := r.rowColor(id)
+
+	r.mx.RLock()
+	header := r.header
+	r.mx.RUnlock()
+
+	for col := 0; col < r.GetColumnCount(); col++ {
+		cell := r.GetCell(rowIdx, col)
+		if cell == nil {
+			continue
+		}
+		if marked {
+			cell.SetTextColor(CurrentTheme().Color(CurrentTheme().Mark))
+			cell.SetAttributes(tcell.AttrBold)
+			continue
+		}
+		cell.SetAttributes(tcell.AttrNone)
+		if hasRowColor {
+			cell.SetTextColor(rowColor)
+		} else if col < len(header) {
+			cell.SetTextColor(r.cellColor(header[col].Name, cell.Text))
+		}
+	}
+}
+
+// rowColor returns the row highlight color, if any.
+func (r *ResourceTable) rowColor(id string) (tcell.Color, bool) {
+	r.mx.RLock()
+	fn := r.rowColorFn
+	r.mx.RUnlock()
+
+	if fn == nil {
+		return tcell.ColorDefault, false
+	}
+	return fn(id)
+}
+
+// cellWidth returns the display width cap of cells in column h: the
+// column's own cap, e.g. for TAGS, or none in wide mode.
+func (r *ResourceTable) cellWidth(h model1.HeaderColumn) int {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	if r.wide {
+		return 0
+	}
+	return h.MaxWidth
+}
+
+// SetWide switches between honoring per-column width caps and showing every
+// value in full with horizontal scrolling (wide), and re-renders the table.
+func (r *ResourceTable) SetWide(wide bool) {
+	r.mx.Lock()
+	r.wide = wide
+	r.mx.Unlock()
+	r.applyFilter()
+}
+
+// Wide reports whether the table shows full values.
+func (r *ResourceTable) Wide() bool {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+	return r.wide
+}
+
+// rowID returns the resource ID stored on the given table row.
+func (r *ResourceTable) rowID(rowIdx int) string {
+	cell := r.GetCell(rowIdx, 0)
+	if cell == nil {
+		return ""
+	}
+
+	if ref := cell.GetReference(); ref != nil {
+		if id, ok := ref.(string); ok {
+			return id
+		}
+	}
+	return cell.Text
 }
 
 // cellColor returns the appropriate color for a cell based on column and value.
 func (r *ResourceTable) cellColor(colName, value string) tcell.Color {
+	theme := CurrentTheme()
 	colUpper := strings.ToUpper(colName)
 	valLower := strings.ToLower(value)
 
@@ -392,30 +584,30 @@ func (r *ResourceTable) cellColor(colName, value string) tcell.Color {
 	if colUpper == "STATE" || colUpper == "STATUS" {
 		switch valLower {
 		case "running", "active", "available", "attached", "enabled", "in-use", "completed":
-			return tcell.ColorGreen
+			return theme.Color(theme.OK)
 		case "stopped", "terminated", "failed", "error", "deleted", "detached":
-			return tcell.ColorRed
+			return theme.Color(theme.Bad)
 		case "pending", "starting", "stopping", "updating", "creating", "deleting", "modifying":
-			return tcell.ColorYellow
+			return theme.Color(theme.Pending)
 		case "shutting-down":
-			return tcell.ColorOrange
+			return theme.Color(theme.Warn)
 		}
 	}
 
 	// Name column - slightly brighter
 	if colUpper == "NAME" {
 		if value != "" && value != "-" {
-			return tcell.ColorAqua
+			return theme.Color(theme.Name)
 		}
 	}
 
 	// ID column
 	if colUpper == "ID" {
-		return tcell.ColorSteelBlue
+		return theme.Color(theme.ID)
 	}
 
 	// Default
-	return tcell.ColorWhite
+	return theme.Color(theme.Text)
 }
 
 // updateTitle updates the border title.
@@ -434,15 +626,39 @@ func (r *ResourceTable) updateTitle() {
 	}
 
 	count := "0"
-	if r.GetRowCount() > 1 {
-		count = fmt.Sprintf("%d", r.GetRowCount()-1)
+	if r.fullData != nil && !r.fullData.HasError() {
+		shown := 0
+		if r.GetRowCount() > 1 {
+			shown = r.GetRowCount() - 1
+		}
+		count = rowCount(shown, r.fullData, r.filterText != "")
 	}
 
 	resource := r.resourceID.String()
 	title := fmt.Sprintf(" %s(%s)[%s] ", resource, region, count)
+	if r.spinner != "" {
+		title += r.spinner + " "
+	}
+	if r.wide {
+		title += CurrentTheme().Paint(CurrentTheme().Key, "<wide>") + " "
+	}
+	if r.filterHint != "" {
+		title += CurrentTheme().Paint(CurrentTheme().Key, "</"+tview.Escape(r.filterHint)+">") + " "
+	}
+	if r.filterErr != nil {
+		title += CurrentTheme().Paint(CurrentTheme().Bad, "<"+tview.Escape(r.filterErr.Error())+">") + " "
+	}
 	r.SetTitle(title)
 }
 
+// SetSpinner shows a loading spinner frame in the title; empty clears it.
+func (r *ResourceTable) SetSpinner(frame string) {
+	r.mx.Lock()
+	r.spinner = frame
+	r.mx.Unlock()
+	r.updateTitle()
+}
+
 // UpdateUI updates the table from TableData.
 func (r *ResourceTable) UpdateUI(data *model1.TableData) {
 	r.mx.Lock()
@@ -467,6 +683,7 @@ func (r *ResourceTable) UpdateUI(data *model1.TableData) {
 		r.updateTitle()
 		return
 	}
+	r.pruneMarks(data)
 
 	if data == nil || data.Empty() {
 		r.showNoData("No resources found")
@@ -511,8 +728,37 @@ func (r *ResourceTable) TableNoData(data *model1.TableData) {
 // ClearMarks clears all marks.
 func (r *ResourceTable) ClearMarks() {
 	r.mx.Lock()
-	defer r.mx.Unlock()
 	r.marks = make(map[string]struct{})
+	r.mx.Unlock()
+
+	for row := 1; row < r.GetRowCount(); row++ {
+		r.styleRow(row)
+	}
+}
+
+// pruneMarks drops the marks of items no longer listed in data, so a
+// resource that disappears, e.g. a terminated instance, does not come back
+// marked and bulk actions only see what the table shows. Only complete,
+// non-empty listings prune: a partial or failed one says nothing about the
+// resources it misses.
+func (r *ResourceTable) pruneMarks(data *model1.TableData) {
+	if data == nil || data.HasError() || data.More() || data.Empty() || data.RowEvents() == nil {
+		return
+	}
+
+	listed := make(map[string]struct{})
+	data.RowEvents().Range(func(_ int, re model1.RowEvent) bool {
+		listed[re.Row.ID] = struct{}{}
+		return true
+	})
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	for id := range r.marks {
+		if _, ok := listed[id]; !ok {
+			delete(r.marks, id)
+		}
+	}
 }
 
 // ToggleMark toggles mark on current selection.
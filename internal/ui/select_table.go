@@ -131,9 +131,7 @@ func (s *SelectTable) selectionChanged(r, c int) {
 		return
 	}
 	if cell := s.GetCell(r, c); cell != nil {
-		s.SetSelectedStyle(
-			tcell.StyleDefault.Foreground(s.selFgColor).
-				Background(cell.Color).Attributes(tcell.AttrBold))
+		s.SetSelectedStyle(SelectedStyle(s.selFgColor, cell.Color))
 	}
 }
 
@@ -162,7 +160,7 @@ func (s *SelectTable) ToggleMark() {
 	}
 
 	if cell := s.GetCell(s.GetSelectedRowIndex(), 0); cell != nil {
-		s.SetSelectedStyle(tcell.StyleDefault.Foreground(cell.BackgroundColor).Background(cell.Color).Attributes(tcell.AttrBold))
+		s.SetSelectedStyle(SelectedStyle(cell.BackgroundColor, cell.Color))
 	}
 }
 
@@ -219,7 +217,7 @@ func (s *SelectTable) markRange(prev, curr int) {
 		if cell == nil {
 			break
 		}
-		s.SetSelectedStyle(tcell.StyleDefault.Foreground(cell.BackgroundColor).Background(cell.Color).Attributes(tcell.AttrBold))
+		s.SetSelectedStyle(SelectedStyle(cell.BackgroundColor, cell.Color))
 	}
 }
 
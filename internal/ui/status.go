@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// ConnState describes the health of the AWS connection.
+type ConnState int
+
+const (
+	// ConnUnknown means connectivity has not been checked yet.
+	ConnUnknown ConnState = iota
+	// ConnOK means the last connectivity check succeeded.
+	ConnOK
+	// ConnFailed means AWS could not be reached.
+	ConnFailed
+	// ConnExpired means the credentials are missing, invalid or expired.
+	ConnExpired
+	// ConnLoginRequired means the SSO token is missing or expired.
+	ConnLoginRequired
+)
+
+// StatusBar shows the active profile, region, account and connection state.
+type StatusBar struct {
+	*tview.TextView
+
+	// Startup profile/region and where they came from
+	profile, profileOrigin string
+	region, regionOrigin   string
+
+	// Caller identity ARN from the last connectivity check
+	identity string
+
+	// dryRun flags that destructive operations are only rehearsed
+	dryRun bool
+}
+
+// NewStatusBar returns a new status line.
+func NewStatusBar() *StatusBar {
+	s := &StatusBar{TextView: tview.NewTextView()}
+	s.SetBackgroundColor(tcell.ColorDefault)
+	s.SetDynamicColors(true)
+	s.SetBorderPadding(0, 0, 1, 1)
+
+	return s
+}
+
+// SetOrigins records where the startup profile and region came from, e.g.
+// "env" or "flag". The origin is shown while that profile or region is active.
+func (s *StatusBar) SetOrigins(profile, profileOrigin, region, regionOrigin string) {
+	s.profile, s.profileOrigin = profile, profileOrigin
+	s.region, s.regionOrigin = region, regionOrigin
+}
+
+// SetIdentity records the caller identity ARN shown by the next Update.
+func (s *StatusBar) SetIdentity(arn string) {
+	s.identity = arn
+}
+
+// SetDryRun sets whether the next Update shows the dry-run marker.
+func (s *StatusBar) SetDryRun(on bool) {
+	s.dryRun = on
+}
+
+// Update redraws the status line.
+func (s *StatusBar) Update(profile, region, accountID, version string, state ConnState) {
+	if accountID == "" {
+		accountID = "n/a"
+	}
+
+	theme := CurrentTheme()
+	text := fmt.Sprintf("%s %s %s%s  %s %s%s  %s %s",
+		connIndicator(state),
+		theme.Paint(theme.Dim, "Profile:"), theme.Paint(theme.Key, tview.Escape(profile)), originTag(profile == s.profile, s.profileOrigin),
+		theme.Paint(theme.Dim, "Region:"), theme.Paint(theme.Key, tview.Escape(region)), originTag(region == s.region, s.regionOrigin),
+		theme.Paint(theme.Dim, "Account:"), theme.Paint(theme.Key, tview.Escape(accountID)))
+	if id := identityLabel(s.identity); id != "" && state == ConnOK {
+		text += "  " + theme.Paint(theme.Dim, "As:") + " " + theme.Paint(theme.Key, tview.Escape(id))
+	}
+	if s.dryRun {
+		text += "  " + theme.Paint(theme.Warn, "[DRY RUN]")
+	}
+	if version != "" {
+		text += "  " + theme.Paint(theme.Dim, tview.Escape(version))
+	}
+	s.SetText(text)
+}
+
+// identityLabel shortens an identity ARN to its resource part, e.g.
+// "assumed-role/Admin/alice" or "user/alice".
+func identityLabel(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return arn
+	}
+	return parts[5]
+}
+
+// originTag renders an origin suffix while the startup value is active.
+func originTag(active bool, origin string) string {
+	if !active || origin == "" {
+		return ""
+	}
+	return " " + CurrentTheme().Paint(CurrentTheme().Dim, "("+tview.Escape(origin)+")")
+}
+
+// connIndicator renders a colored dot and label for the connection state.
+// Without colors every state gets a distinct symbol or label.
+func connIndicator(state ConnState) string {
+	theme := CurrentTheme()
+	switch state {
+	case ConnOK:
+		if NoColor() {
+			return "● online"
+		}
+		return theme.Paint(theme.OK, "●")
+	case ConnFailed:
+		return theme.Paint(theme.Bad, "● offline")
+	case ConnExpired:
+		return theme.Paint(theme.Warn, "● credentials expired")
+	case ConnLoginRequired:
+		return theme.Paint(theme.Warn, "● SSO login required")
+	default:
+		if NoColor() {
+			return "○"
+		}
+		return theme.Paint(theme.Dim, "●")
+	}
+}
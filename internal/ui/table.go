@@ -6,6 +6,7 @@ package ui
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -57,7 +58,7 @@ func (t *Table) Init(ctx context.Context) error {
 	t.SetBorderPadding(0, 0, 1, 1)
 	t.SetSelectable(true, false)
 	t.SetBackgroundColor(tcell.ColorDefault)
-	t.SetBorderColor(tcell.ColorWhite)
+	t.SetBorderColor(CurrentTheme().Color(CurrentTheme().Border))
 	t.Select(1, 0)
 
 	// Set initial title
@@ -201,7 +202,7 @@ func (t *Table) handleFilterInput(evt *tcell.EventKey) *tcell.EventKey {
 func (t *Table) showNoData(msg string) {
 	t.Clear()
 	cell := tview.NewTableCell(msg)
-	cell.SetTextColor(tcell.ColorGray)
+	cell.SetTextColor(CurrentTheme().Color(CurrentTheme().Dim))
 	cell.SetAlign(tview.AlignCenter)
 	cell.SetSelectable(false)
 	t.SetCell(0, 0, cell)
@@ -404,7 +405,11 @@ func (t *Table) updateTitleWithFilter() {
 
 	count := "0"
 	if data != nil {
-		count = fmt.Sprintf("%d", t.GetRowCount()-1) // Minus header
+		shown := 0
+		if t.GetRowCount() > 1 {
+			shown = t.GetRowCount() - 1 // Minus header
+		}
+		count = rowCount(shown, data, filter != "")
 	}
 
 	resource := t.resourceID.String()
@@ -417,6 +422,19 @@ func (t *Table) updateTitleWithFilter() {
 	t.SetTitle(title)
 }
 
+// rowCount formats the title count of shown rows out of data: "12/340"
+// while filtered, with a trailing "+" when data has more rows than listed.
+func rowCount(shown int, data *model1.TableData, filtered bool) string {
+	total := strconv.Itoa(data.RowCount())
+	if data.More() {
+		total += "+"
+	}
+	if !filtered {
+		return total
+	}
+	return strconv.Itoa(shown) + "/" + total
+}
+
 // UpdateUI updates the table display from TableData.
 func (t *Table) UpdateUI(data *model1.TableData) {
 	t.mx.Lock()
@@ -459,7 +477,7 @@ func (t *Table) buildHeader(header model1.Header) {
 
 	for col, h := range header {
 		cell := tview.NewTableCell(h.Name)
-		cell.SetTextColor(tcell.ColorYellow)
+		cell.SetTextColor(CurrentTheme().Color(CurrentTheme().Header))
 		cell.SetBackgroundColor(tcell.ColorDefault)
 		cell.SetAlign(h.Align)
 		cell.SetExpansion(1)
@@ -483,7 +501,7 @@ func (t *Table) buildRow(row model1.Row, header model1.Header, rowIdx int) {
 		}
 
 		cell := tview.NewTableCell(field)
-		cell.SetTextColor(tcell.ColorWhite)
+		cell.SetTextColor(CurrentTheme().Color(CurrentTheme().Text))
 		cell.SetBackgroundColor(tcell.ColorDefault)
 		cell.SetAlign(header[col].Align)
 		cell.SetExpansion(1)
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/derailed/tcell/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Built-in theme names.
+const (
+	ThemeDefault = "default"
+	ThemeLight   = "light"
+	ThemeNoColor = "no-color"
+)
+
+// Theme is the UI color palette. Colors are tview color names such as
+// "aqua" or hex values such as "#ffaf00"; "default" keeps the terminal's
+// own color.
+type Theme struct {
+	Border  string `yaml:"border"`
+	Header  string `yaml:"header"`  // table and help headers
+	Text    string `yaml:"text"`    // regular cell text
+	Dim     string `yaml:"dim"`     // labels, empty values and footers
+	Key     string `yaml:"key"`     // describe keys, key hints, status values
+	Name    string `yaml:"name"`    // NAME column
+	ID      string `yaml:"id"`      // ID column
+	Number  string `yaml:"number"`  // numbers in describe
+	OK      string `yaml:"ok"`      // running, available, true
+	Bad     string `yaml:"bad"`     // stopped, failed, false, errors
+	Pending string `yaml:"pending"` // pending, updating, warnings
+	Warn    string `yaml:"warn"`    // shutting-down and similar
+	Mark    string `yaml:"mark"`    // marked rows
+	Info    string `yaml:"info"`    // info flash messages
+
+	Button     string `yaml:"button"`     // dialog button background
+	ButtonText string `yaml:"buttonText"` // dialog button label
+	Field      string `yaml:"field"`      // input field background
+}
+
+// DefaultTheme returns the palette for dark terminals.
+func DefaultTheme() *Theme {
+	return &Theme{
+		Border:  "white",
+		Header:  "yellow",
+		Text:    "white",
+		Dim:     "gray",
+		Key:     "aqua",
+		Name:    "aqua",
+		ID:      "steelblue",
+		Number:  "fuchsia",
+		OK:      "green",
+		Bad:     "red",
+		Pending: "yellow",
+		Warn:    "orange",
+		Mark:    "fuchsia",
+		Info:    "green",
+
+		Button:     "blue",
+		ButtonText: "white",
+		Field:      "darkslategray",
+	}
+}
+
+// LightTheme returns a palette readable on light terminal backgrounds.
+func LightTheme() *Theme {
+	return &Theme{
+		Border:  "black",
+		Header:  "navy",
+		Text:    "black",
+		Dim:     "gray",
+		Key:     "teal",
+		Name:    "darkblue",
+		ID:      "darkslategray",
+		Number:  "purple",
+		OK:      "darkgreen",
+		Bad:     "darkred",
+		Pending: "darkgoldenrod",
+		Warn:    "chocolate",
+		Mark:    "purple",
+		Info:    "darkgreen",
+
+		Button:     "navy",
+		ButtonText: "white",
+		Field:      "lightgray",
+	}
+}
+
+// NoColorTheme returns a palette that keeps the terminal's colors.
+func NoColorTheme() *Theme {
+	return &Theme{
+		Border:  "default",
+		Header:  "default",
+		Text:    "default",
+		Dim:     "default",
+		Key:     "default",
+		Name:    "default",
+		ID:      "default",
+		Number:  "default",
+		OK:      "default",
+		Bad:     "default",
+		Pending: "default",
+		Warn:    "default",
+		Mark:    "default",
+		Info:    "default",
+
+		Button:     "default",
+		ButtonText: "default",
+		Field:      "default",
+	}
+}
+
+var (
+	theme   = DefaultTheme()
+	noColor bool
+	themeMx sync.RWMutex
+)
+
+// SetNoColor switches color rendering off or on. While off the no-color
+// theme stays active whatever SetTheme is given, so status must be told
+// apart by text.
+func SetNoColor(off bool) {
+	themeMx.Lock()
+	defer themeMx.Unlock()
+	noColor = off
+	if off {
+		theme = NoColorTheme()
+	}
+}
+
+// NoColor reports whether color rendering is off.
+func NoColor() bool {
+	themeMx.RLock()
+	defer themeMx.RUnlock()
+	return noColor
+}
+
+// CurrentTheme returns the active theme.
+func CurrentTheme() *Theme {
+	themeMx.RLock()
+	defer themeMx.RUnlock()
+	return theme
+}
+
+// SetTheme makes t the active theme. Widgets built afterwards use it.
+func SetTheme(t *Theme) {
+	if t == nil {
+		return
+	}
+	themeMx.Lock()
+	defer themeMx.Unlock()
+	if noColor {
+		return
+	}
+	theme = t
+}
+
+// LoadTheme returns the built-in theme called name, or loads
+// dir/name.yaml on top of the default theme so a skin only needs to list
+// the colors it changes. An empty name selects the default theme.
+func LoadTheme(name, dir string) (*Theme, error) {
+	switch name {
+	case "", ThemeDefault:
+		return DefaultTheme(), nil
+	case ThemeLight:
+		return LightTheme(), nil
+	case ThemeNoColor:
+		return NoColorTheme(), nil
+	}
+
+	path := filepath.Join(dir, name+".yaml")
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read skin %q: %w", name, err)
+	}
+
+	t := DefaultTheme()
+	if err := yaml.Unmarshal(raw, t); err != nil {
+		return nil, fmt.Errorf("failed to parse skin %s: %w", path, err)
+	}
+	return t, nil
+}
+
+// Color converts a theme color to a tcell color.
+func (t *Theme) Color(name string) tcell.Color {
+	if name == "" {
+		return tcell.ColorDefault
+	}
+	return tcell.GetColor(name)
+}
+
+// SelectedStyle returns the style of a selected row drawn fg on bg. Without
+// colors the row is reversed instead so the selection stays visible.
+func SelectedStyle(fg, bg tcell.Color) tcell.Style {
+	if NoColor() {
+		return tcell.StyleDefault.Attributes(tcell.AttrReverse | tcell.AttrBold)
+	}
+	return tcell.StyleDefault.Foreground(fg).Background(bg).Attributes(tcell.AttrBold)
+}
+
+// Paint wraps text in a color tag, leaving it untouched for "default".
+func (t *Theme) Paint(color, text string) string {
+	if color == "" || color == "default" {
+		return text
+	}
+	return "[" + color + "::]" + text + "[-::]"
+}
@@ -140,6 +140,16 @@ type Component interface {
 	Hinter
 }
 
+// Crumbed is implemented by components with their own navigation levels,
+// e.g. S3 prefixes, so each level shows as a breadcrumb.
+type Crumbed interface {
+	// Crumbs returns the component's levels, outermost first.
+	Crumbs() []string
+
+	// PopCrumbs goes up n levels within the component.
+	PopCrumbs(n int)
+}
+
 // StackListener represents a stack listener.
 type StackListener interface {
 	// StackPushed indicates a new item was added.
@@ -4,7 +4,7 @@
 package view
 
 import (
-	"github.com/derailed/tcell/v2"
+	"github.com/a1s/a1s/internal/ui"
 	"github.com/derailed/tview"
 )
 
@@ -25,7 +25,7 @@ func NewAccountInfo() *AccountInfo {
 	}
 
 	a.SetBorder(true)
-	a.SetBorderColor(tcell.ColorDarkCyan)
+	a.SetBorderColor(ui.CurrentTheme().Color(ui.CurrentTheme().Key))
 	a.SetBorderPadding(0, 0, 1, 1)
 
 	return a
@@ -50,6 +50,7 @@ func (a *AccountInfo) SetInfo(profile, region, accountID, version string) {
 // refresh rebuilds the table display.
 func (a *AccountInfo) refresh() {
 	a.Clear()
+	theme := ui.CurrentTheme()
 
 	// Compact two-line format to fit in header
 	// Line 1: profile @ region
@@ -65,7 +66,7 @@ func (a *AccountInfo) refresh() {
 
 	line1 := "[::b]" + profile + "[@::]" + region + "[-:-:-]"
 	cell1 := tview.NewTableCell(line1).
-		SetTextColor(tcell.ColorDarkCyan).
+		SetTextColor(theme.Color(theme.Key)).
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false)
 	a.SetCell(0, 0, cell1)
@@ -74,9 +75,9 @@ func (a *AccountInfo) refresh() {
 	if account == "" {
 		account = "..."
 	}
-	line2 := account + " [gray](v" + a.version + ")[-]"
+	line2 := account + " " + theme.Paint(theme.Dim, "(v"+a.version+")")
 	cell2 := tview.NewTableCell(line2).
-		SetTextColor(tcell.ColorWhite).
+		SetTextColor(theme.Color(theme.Text)).
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false)
 	a.SetCell(1, 0, cell2)
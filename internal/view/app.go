@@ -5,20 +5,30 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
+	"github.com/a1s/a1s/internal/aws"
 	"github.com/a1s/a1s/internal/config"
 	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
 	"github.com/a1s/a1s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 )
 
 const (
-	// FlashDelay sets the flash auto-clear delay.
+	// FlashDelay is the default auto-clear delay for info and warning messages.
 	FlashDelay = 5 * time.Second
+
+	// MinRefreshRate is the shortest allowed auto-refresh interval.
+	MinRefreshRate = time.Second
+
+	// FlashHistorySize is the number of flash messages kept for the log.
+	FlashHistorySize = 200
 )
 
 // FlashLevel represents flash message severity.
@@ -33,12 +43,23 @@ const (
 	FlashErr
 )
 
+// FlashEntry is a flash message kept in the history.
+type FlashEntry struct {
+	Time    time.Time
+	Level   FlashLevel
+	Message string
+}
+
 // Flash handles flash messages in the application.
 type Flash struct {
 	*tview.TextView
-	app    *App
-	cancel context.CancelFunc
-	mx     sync.RWMutex
+	app     *App
+	cancel  context.CancelFunc
+	delay   time.Duration
+	sticky  bool         // an error is showing until dismissed or replaced
+	history []FlashEntry // ring buffer of FlashHistorySize entries
+	next    int          // history slot for the next message
+	mx      sync.RWMutex
 }
 
 // NewFlash creates a new Flash instance.
@@ -46,6 +67,7 @@ func NewFlash(app *App) *Flash {
 	f := &Flash{
 		TextView: tview.NewTextView(),
 		app:      app,
+		delay:    FlashDelay,
 	}
 	f.SetDynamicColors(true)
 	f.SetTextAlign(tview.AlignLeft)
@@ -63,8 +85,9 @@ func (f *Flash) Infof(format string, args ...interface{}) {
 	f.Info(fmt.Sprintf(format, args...))
 }
 
-// Warn displays a warning message.
+// Warn displays a warning message and logs it.
 func (f *Flash) Warn(msg string) {
+	slog.Warn(msg)
 	f.setMessage(FlashWarn, msg)
 }
 
@@ -73,16 +96,62 @@ func (f *Flash) Warnf(format string, args ...interface{}) {
 	f.Warn(fmt.Sprintf(format, args...))
 }
 
-// Err displays an error message.
+// Err displays an error message and logs it.
 func (f *Flash) Err(err error) {
 	if err != nil {
+		slog.Error(err.Error())
 		f.setMessage(FlashErr, err.Error())
 	}
 }
 
-// Errf displays a formatted error message.
+// Errf displays a formatted error message and logs it.
 func (f *Flash) Errf(format string, args ...interface{}) {
-	f.setMessage(FlashErr, fmt.Sprintf(format, args...))
+	msg := fmt.Sprintf(format, args...)
+	slog.Error(msg)
+	f.setMessage(FlashErr, msg)
+}
+
+// SetDelay sets how long info and warning messages stay.
+func (f *Flash) SetDelay(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	f.delay = d
+}
+
+// Sticky returns whether an error is showing until dismissed.
+func (f *Flash) Sticky() bool {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+	return f.sticky
+}
+
+// History returns the recent flash messages, oldest first.
+func (f *Flash) History() []FlashEntry {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	out := make([]FlashEntry, 0, len(f.history))
+	if len(f.history) == FlashHistorySize {
+		out = append(out, f.history[f.next:]...)
+	}
+	return append(out, f.history[:f.next]...)
+}
+
+// record appends a message to the history, overwriting the oldest once full.
+func (f *Flash) record(level FlashLevel, msg string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	entry := FlashEntry{Time: time.Now(), Level: level, Message: msg}
+	if len(f.history) < FlashHistorySize {
+		f.history = append(f.history, entry)
+	} else {
+		f.history[f.next] = entry
+	}
+	f.next = (f.next + 1) % FlashHistorySize
 }
 
 // Clear clears the flash message.
@@ -92,6 +161,7 @@ func (f *Flash) Clear() {
 		f.cancel()
 		f.cancel = nil
 	}
+	f.sticky = false
 	f.mx.Unlock()
 
 	if f.app != nil {
@@ -116,6 +186,7 @@ func (f *Flash) setMessage(level FlashLevel, msg string) {
 		f.Clear()
 		return
 	}
+	f.record(level, msg)
 
 	// Update UI with message
 	updateFn := func() {
@@ -130,32 +201,37 @@ func (f *Flash) setMessage(level FlashLevel, msg string) {
 		updateFn()
 	}
 
-	// Start auto-clear timer
-	ctx, cancel := context.WithCancel(context.Background())
+	// Errors stay until dismissed or replaced; others clear after the delay
 	f.mx.Lock()
-	f.cancel = cancel
-	f.mx.Unlock()
+	defer f.mx.Unlock()
+	f.sticky = level == FlashErr
+	if f.sticky {
+		return
+	}
 
-	go f.autoClear(ctx)
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = cancel
+	go f.autoClear(ctx, f.delay)
 }
 
-func (f *Flash) autoClear(ctx context.Context) {
+func (f *Flash) autoClear(ctx context.Context, delay time.Duration) {
 	select {
 	case <-ctx.Done():
 		return
-	case <-time.After(FlashDelay):
+	case <-time.After(delay):
 		f.Clear()
 	}
 }
 
 func flashColor(level FlashLevel) tcell.Color {
+	theme := ui.CurrentTheme()
 	switch level {
 	case FlashWarn:
-		return tcell.ColorYellow
+		return theme.Color(theme.Pending)
 	case FlashErr:
-		return tcell.ColorRed
+		return theme.Color(theme.Bad)
 	default:
-		return tcell.ColorGreen
+		return theme.Color(theme.Info)
 	}
 }
 
@@ -170,6 +246,22 @@ func flashPrefix(level FlashLevel) string {
 	}
 }
 
+// menuRows is the height of the key hints menu.
+const menuRows = 2
+
+// crumbTarget locates a breadcrumb: the stack depth of its view and, for
+// ui.Crumbed views, the level within it.
+type crumbTarget struct {
+	depth int
+	level int
+}
+
+// stackPage is a view popped off the stack, kept so it can be restored.
+type stackPage struct {
+	name string
+	page tview.Primitive
+}
+
 // PageStack is a type alias for the view stack.
 type PageStack = ui.Pages
 
@@ -179,14 +271,38 @@ type App struct {
 	version     string
 	Main        *tview.Pages
 	Content     *PageStack
+	body        *tview.Flex // Content and the split pane side by side
+	split       *splitPane
+	splitFocus  bool // the split pane, not Content, gets focus back
 	command     *Command
 	factory     dao.Factory
 	cmdBar      *ui.CmdBar
 	menu        *ui.Menu
 	crumbs      *ui.Crumbs
+	crumbless   bool
+	crumbTo     []crumbTarget
+	forward     []stackPage // views popped by crumb navigation, most recent last
+	stackSize   int
+	restoring   bool // crumbForward is re-pushing a view
+	status      *ui.StatusBar
 	flash       *Flash
 	help        *Help
 	running     bool
+	refreshRate time.Duration
+	timeouts    config.Timeouts
+	views       *config.Views
+	dataCache   map[string]*model1.TableData // last listing per view, see Browser.cacheKey
+	paused      bool
+	absTimes    bool   // show timestamps instead of relative ages
+	policyCount bool   // count IAM user policies and groups when listing
+	costHints   bool   // show estimated monthly costs
+	bucketSizes bool   // fetch S3 bucket sizes and object counts when listing
+	idleOnly    bool   // list only idle resources
+	dryRun      bool   // rehearse destructive operations
+	sshUser     string // SSH login when the AMI gives none away
+	sshBastion  string // jump host for instances without a public IP
+	reauthing   bool   // a credentials-expired prompt is showing
+	reauthSkip  string // profile whose prompt was cancelled, until :reconnect or a switch
 	mx          sync.RWMutex
 }
 
@@ -197,12 +313,63 @@ func NewApp(cfg *config.Config, version string) *App {
 		version:     version,
 		Main:        tview.NewPages(),
 		Content:     ui.NewPages(),
+		refreshRate: time.Duration(config.DefaultRefreshRate * float64(time.Second)),
+		timeouts:    config.NewTimeouts(),
+	}
+	if cfg != nil && cfg.A1s != nil && cfg.A1s.RefreshRate > 0 {
+		app.refreshRate = time.Duration(float64(cfg.A1s.RefreshRate) * float64(time.Second))
+	}
+	if cfg != nil && cfg.A1s != nil {
+		app.timeouts = cfg.A1s.Timeouts
+		app.dryRun = cfg.A1s.DryRun
+		app.sshUser = cfg.A1s.SSH.User
+		app.sshBastion = cfg.A1s.SSH.Bastion
+	}
+
+	// Widgets pick up the theme as they are built
+	var themeErr error
+	if cfg != nil && cfg.A1s != nil && cfg.A1s.UI.NoColor {
+		ui.SetNoColor(true)
+	} else if cfg != nil && cfg.A1s != nil {
+		theme, err := ui.LoadTheme(cfg.A1s.UI.Skin, config.AppSkinsDir)
+		if err != nil {
+			themeErr = err
+			theme = ui.DefaultTheme()
+		}
+		ui.SetTheme(theme)
 	}
 
+	views, viewsErr := config.LoadViews(config.AppViewsFile)
+	app.views = views
+
 	app.flash = NewFlash(app)
+	if themeErr != nil {
+		app.flash.Warnf("Using default theme: %v", themeErr)
+	}
+	if viewsErr != nil {
+		app.flash.Warnf("Using default columns: %v", viewsErr)
+	}
 	app.menu = ui.NewMenu()
+	app.menu.SetRows(menuRows)
 	app.crumbs = ui.NewCrumbs()
+	app.crumbs.SetSelectedFn(app.popToCrumb)
+	app.Content.SetChangedFunc(app.stackChanged)
+	if cfg != nil && cfg.A1s != nil {
+		app.crumbless = cfg.A1s.UI.Crumbsless
+		if d, err := time.ParseDuration(cfg.A1s.UI.FlashDelay); err == nil {
+			app.flash.SetDelay(d)
+		}
+		app.EnableMouse(cfg.A1s.UI.EnableMouse)
+	}
+	app.status = ui.NewStatusBar()
+	app.status.SetDryRun(app.dryRun)
 	app.cmdBar = ui.NewCmdBar()
+	if cfg != nil {
+		app.cmdBar.SetHistory(cfg.CommandHistory())
+		if cfg.A1s != nil {
+			app.cmdBar.SetFuzzy(cfg.A1s.UI.FuzzyCommands)
+		}
+	}
 	app.help = NewHelp()
 
 	// Setup keyboard handler
@@ -213,7 +380,7 @@ func NewApp(cfg *config.Config, version string) *App {
 		if active {
 			app.SetFocus(app.cmdBar)
 		} else {
-			app.SetFocus(app.Content)
+			app.SetFocus(app.focusedPages())
 		}
 	})
 
@@ -228,6 +395,8 @@ func NewApp(cfg *config.Config, version string) *App {
 		app.applyFilter(text)
 	})
 
+	app.cmdBar.SetFilterSubmitFn(app.submitFilter)
+
 	app.cmdBar.SetCancelFn(func() {
 		// Clear filter
 		app.applyFilter("")
@@ -236,6 +405,11 @@ func NewApp(cfg *config.Config, version string) *App {
 	return app
 }
 
+// CommandHistory returns the executed commands, oldest first.
+func (a *App) CommandHistory() []string {
+	return a.cmdBar.History()
+}
+
 // Init initializes and builds the application layout.
 func (a *App) Init() error {
 	// Initialize command interpreter
@@ -261,7 +435,7 @@ func (a *App) Run() error {
 
 	// Execute default command to show initial view
 	if err := a.command.Run(""); err != nil {
-		// Log error but don't fail - app can still run
+		// Report the error but don't fail - app can still run
 		a.flash.Errf("Failed to run default command: %v", err)
 	}
 
@@ -307,6 +481,7 @@ func (a *App) SetFactory(f dao.Factory) {
 }
 
 // SwitchProfile switches to a different AWS profile.
+// If the new profile cannot connect, the previous profile is restored.
 func (a *App) SwitchProfile(profile string) error {
 	a.mx.Lock()
 	defer a.mx.Unlock()
@@ -315,18 +490,30 @@ func (a *App) SwitchProfile(profile string) error {
 		return fmt.Errorf("factory not initialized")
 	}
 
+	previous := a.factory.Profile()
 	if err := a.factory.SetProfile(profile); err != nil {
 		return fmt.Errorf("failed to switch profile: %w", err)
 	}
+	a.reauthSkip = ""
 
 	// Verify connectivity with new profile
-	if client := a.factory.Client(); client != nil {
-		if !client.CheckConnectivity() {
-			return fmt.Errorf("failed to connect with profile: %s", profile)
-		}
+	client := a.factory.Client()
+	if client == nil || client.CheckConnectivity() {
+		a.showStatus(client)
+		return nil
 	}
 
-	return nil
+	err := client.ConnectionErr()
+	if previous != "" && previous != profile {
+		_ = a.factory.SetProfile(previous)
+		a.checkStatus(client)
+	} else {
+		a.showStatus(client)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect with profile %s: %w", profile, err)
+	}
+	return fmt.Errorf("failed to connect with profile: %s", profile)
 }
 
 // SwitchRegion switches to a different AWS region.
@@ -338,7 +525,359 @@ func (a *App) SwitchRegion(region string) error {
 		return fmt.Errorf("factory not initialized")
 	}
 
-	return a.factory.SetRegion(region)
+	if err := a.factory.SetRegion(region); err != nil {
+		return err
+	}
+	a.checkStatus(a.factory.Client())
+
+	return nil
+}
+
+// Reconnect reloads AWS profiles and credentials in the background, then
+// refreshes the status line and current view.
+func (a *App) Reconnect() {
+	f := a.GetFactory()
+	if f == nil || f.Client() == nil {
+		a.flash.Err(fmt.Errorf("factory not initialized"))
+		return
+	}
+	client := f.Client()
+	a.reauthSkip = ""
+
+	a.flash.Info("Reconnecting...")
+	go func() {
+		err := client.Reconnect()
+		a.QueueUpdateDraw(func() {
+			a.showStatus(client)
+			if err != nil {
+				a.flash.Errf("Reconnect failed: %v", err)
+				return
+			}
+			a.RefreshCurrentView()
+			a.flash.Infof("Connected as %s", client.ActiveProfile())
+		})
+	}()
+}
+
+// checkStatus re-checks connectivity in the background and refreshes the status line.
+func (a *App) checkStatus(client aws.Connection) {
+	if client == nil {
+		return
+	}
+
+	go func() {
+		client.CheckConnectivity()
+		a.QueueUpdateDraw(func() {
+			a.showStatus(client)
+		})
+	}()
+}
+
+// pollStatus re-checks connectivity in the background like checkStatus,
+// but only updates the status line: views reloading on a timer must not
+// raise the credentials-expired prompt.
+func (a *App) pollStatus(client aws.Connection) {
+	if client == nil {
+		return
+	}
+
+	go func() {
+		client.CheckConnectivity()
+		a.QueueUpdateDraw(func() {
+			a.updateStatus(client)
+		})
+	}()
+}
+
+// showStatus renders the client's status line and prompts for a retry when
+// its credentials have expired. Must run on the UI thread.
+func (a *App) showStatus(client aws.Connection) {
+	if client == nil {
+		return
+	}
+
+	if state := a.updateStatus(client); state == ui.ConnExpired || state == ui.ConnLoginRequired {
+		a.promptReauth(client, state)
+	}
+}
+
+// updateStatus renders the client's profile, region, account and last
+// connectivity result in the status line. Must run on the UI thread.
+func (a *App) updateStatus(client aws.Connection) ui.ConnState {
+	state := ui.ConnUnknown
+	switch err := client.ConnectionErr(); {
+	case client.ConnectionOK():
+		state = ui.ConnOK
+	case errors.Is(err, aws.ErrSSOLoginRequired):
+		state = ui.ConnLoginRequired
+	case errors.Is(err, aws.ErrExpiredCredentials), errors.Is(err, aws.ErrNoCredentials):
+		state = ui.ConnExpired
+	case err != nil:
+		state = ui.ConnFailed
+	}
+	a.status.SetIdentity(client.CallerIdentity().Arn)
+	a.status.Update(client.ActiveProfile(), client.ActiveRegion(), client.AccountID(), a.version, state)
+	return state
+}
+
+// promptReauth explains how to renew expired credentials and offers a retry
+// that drops cached clients and re-checks connectivity, so a1s picks up the
+// new credentials without restarting. Must run on the UI thread.
+func (a *App) promptReauth(client aws.Connection, state ui.ConnState) {
+	profile := client.ActiveProfile()
+	if a.reauthing || a.reauthSkip == profile {
+		return
+	}
+	a.reauthing = true
+
+	msg := fmt.Sprintf("Credentials for profile %q have expired.\nRe-enter MFA or renew the session, then Retry.", profile)
+	if state == ui.ConnLoginRequired || client.ProfileIsSSO(profile) {
+		msg = fmt.Sprintf("SSO session for profile %q has expired.\nRun: aws sso login --profile %s\nthen Retry.", profile, profile)
+	}
+	a.flash.Warn("Credentials expired, renew them and retry")
+
+	confirm := ui.NewConfirm(a.Content)
+	confirm.SetMessage(msg)
+	confirm.SetButtons("Retry", "Cancel")
+	confirm.SetOnConfirm(func() {
+		a.flash.Info("Reconnecting...")
+		go func() {
+			client.Reset()
+			ok := client.CheckConnectivity()
+			a.QueueUpdateDraw(func() {
+				a.reauthing = false
+				a.showStatus(client)
+				if ok {
+					a.flash.Infof("Reconnected as %s", client.ActiveProfile())
+					a.RefreshCurrentView()
+				}
+			})
+		}()
+	})
+	confirm.SetOnCancel(func() {
+		a.reauthing = false
+		a.reauthSkip = profile
+	})
+	confirm.Show()
+}
+
+// RefreshRate returns the interval between automatic view reloads.
+func (a *App) RefreshRate() time.Duration {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.refreshRate
+}
+
+// SetRefreshRate changes the interval between automatic view reloads.
+func (a *App) SetRefreshRate(d time.Duration) error {
+	if d < MinRefreshRate {
+		return fmt.Errorf("refresh interval must be at least %s", MinRefreshRate)
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.refreshRate = d
+	return nil
+}
+
+// Timeout returns the configured timeout for a kind of AWS operation
+// (config.TimeoutList, TimeoutGet, TimeoutAction or TimeoutTransfer).
+func (a *App) Timeout(kind string) time.Duration {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.timeouts.Duration(kind)
+}
+
+// SetTimeout overrides the timeout for a kind of AWS operation.
+func (a *App) SetTimeout(kind string, d time.Duration) error {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	return a.timeouts.Set(kind, d)
+}
+
+// cachedData returns a copy of the listing cached under key, or nil.
+func (a *App) cachedData(key string) *model1.TableData {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	data, ok := a.dataCache[key]
+	if !ok {
+		return nil
+	}
+	return data.Clone()
+}
+
+// cacheData caches a copy of a listing under key.
+func (a *App) cacheData(key string, data *model1.TableData) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	if a.dataCache == nil {
+		a.dataCache = make(map[string]*model1.TableData)
+	}
+	a.dataCache[key] = data.Clone()
+}
+
+// ViewColumns returns the columns configured for a resource, or nil to use
+// its default columns.
+func (a *App) ViewColumns(resource string) []string {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.views.Columns(resource)
+}
+
+// timeoutFor returns the app's timeout for kind, or the default without an app.
+func timeoutFor(app *App, kind string) time.Duration {
+	if app == nil {
+		return config.NewTimeouts().Duration(kind)
+	}
+	return app.Timeout(kind)
+}
+
+// dryRunContext marks ctx as a dry run when dryRun is set.
+func dryRunContext(ctx context.Context, dryRun bool) context.Context {
+	if dryRun {
+		return aws.WithDryRun(ctx)
+	}
+	return ctx
+}
+
+// ToggleAutoRefresh pauses or resumes automatic reloads and returns
+// whether auto-refresh is now paused.
+func (a *App) ToggleAutoRefresh() bool {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.paused = !a.paused
+	return a.paused
+}
+
+// AutoRefreshPaused returns whether automatic reloads are paused.
+func (a *App) AutoRefreshPaused() bool {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.paused
+}
+
+// ToggleAbsoluteTimes switches table time columns between relative ages
+// and absolute timestamps, returning whether timestamps are now shown.
+func (a *App) ToggleAbsoluteTimes() bool {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.absTimes = !a.absTimes
+	return a.absTimes
+}
+
+// AbsoluteTimes returns whether table time columns show absolute timestamps.
+func (a *App) AbsoluteTimes() bool {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.absTimes
+}
+
+// TogglePolicyCounts switches counting IAM user policies and groups on or
+// off, returning whether counts are now shown.
+func (a *App) TogglePolicyCounts() bool {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.policyCount = !a.policyCount
+	return a.policyCount
+}
+
+// ToggleCostHints switches the estimated monthly cost column on or off,
+// returning whether it is now shown.
+func (a *App) ToggleCostHints() bool {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.costHints = !a.costHints
+	return a.costHints
+}
+
+// ToggleBucketSizes switches the S3 bucket SIZE and OBJECTS columns on or
+// off, returning whether they are now shown.
+func (a *App) ToggleBucketSizes() bool {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.bucketSizes = !a.bucketSizes
+	return a.bucketSizes
+}
+
+// BucketSizes returns whether bucket listings include storage metrics.
+func (a *App) BucketSizes() bool {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.bucketSizes
+}
+
+// CostHints returns whether tables show estimated monthly costs.
+func (a *App) CostHints() bool {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.costHints
+}
+
+// ToggleIdleOnly switches listing only idle resources on or off, returning
+// whether only idle resources are now listed.
+func (a *App) ToggleIdleOnly() bool {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.idleOnly = !a.idleOnly
+	return a.idleOnly
+}
+
+// SetDryRun switches dry-run mode on or off. In dry-run mode destructive
+// operations are rehearsed: EC2 checks them with DryRun and other services
+// are not called. Must run on the UI thread.
+func (a *App) SetDryRun(on bool) {
+	a.mx.Lock()
+	a.dryRun = on
+	a.mx.Unlock()
+
+	a.status.SetDryRun(on)
+	if f := a.GetFactory(); f != nil && f.Client() != nil {
+		a.showStatus(f.Client())
+	}
+}
+
+// DryRun returns whether destructive operations are only rehearsed.
+func (a *App) DryRun() bool {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.dryRun
+}
+
+// SSHUser returns the configured SSH login, empty when unset.
+func (a *App) SSHUser() string {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.sshUser
+}
+
+// SSHBastion returns the jump host for private instances, empty when unset.
+func (a *App) SSHBastion() string {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.sshBastion
+}
+
+// SetSSHBastion sets the jump host for private instances; empty clears it.
+func (a *App) SetSSHBastion(host string) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.sshBastion = host
+}
+
+// IdleOnly returns whether listings are restricted to idle resources.
+func (a *App) IdleOnly() bool {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.idleOnly
+}
+
+// PolicyCounts returns whether IAM user listings include policy counts.
+func (a *App) PolicyCounts() bool {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return a.policyCount
 }
 
 // QueueUpdateDraw queues a function to be executed on the UI thread.
@@ -352,35 +891,66 @@ func (a *App) ClearStatus(showLogo bool) {
 	// TODO: Implement logo display logic
 }
 
-// SetAccountInfo sets the account information display.
-// Currently a no-op since we removed the separate account info display.
+// SetOrigins records where the startup profile and region came from so the
+// status line can show it, e.g. "(env)" for AWS_PROFILE.
+func (a *App) SetOrigins(profile, profileOrigin, region, regionOrigin string) {
+	a.status.SetOrigins(profile, profileOrigin, region, regionOrigin)
+}
+
+// SetAccountInfo sets the account information shown in the status line.
 func (a *App) SetAccountInfo(profile, region, accountID, version string) {
-	// Account info could be shown in the flash bar or elsewhere
-	a.flash.Infof("Profile: %s | Region: %s", profile, region)
+	if f := a.GetFactory(); f != nil && f.Client() != nil {
+		a.showStatus(f.Client())
+		return
+	}
+
+	state := ui.ConnUnknown
+	if accountID != "" {
+		state = ui.ConnOK
+	}
+	a.status.Update(profile, region, accountID, version, state)
 }
 
 // buildLayout creates the main UI layout.
 func (a *App) buildLayout() *tview.Flex {
-	// Bottom bar: flash messages and menu hints
-	bottomBar := tview.NewFlex().
-		SetDirection(tview.FlexRow).
+	// Bottom bar: breadcrumbs, flash messages and menu hints
+	bottomBar := tview.NewFlex().SetDirection(tview.FlexRow)
+	barHeight := 1 + menuRows
+	if !a.crumbless {
+		bottomBar.AddItem(a.crumbs, 1, 0, false)
+		barHeight++
+	}
+	bottomBar.
 		AddItem(a.flash, 1, 0, false).
-		AddItem(a.menu, 1, 0, false)
+		AddItem(a.menu, menuRows, 0, false)
+
+	// Content shares the middle with the split pane while one is open
+	a.body = tview.NewFlex().AddItem(a.Content, 0, 1, true)
 
 	// Main layout: command bar at top, content in middle, status at bottom
 	main := tview.NewFlex().
 		SetDirection(tview.FlexRow).
 		AddItem(a.cmdBar, 3, 0, false).
-		AddItem(a.Content, 0, 1, true).
-		AddItem(bottomBar, 2, 0, false)
+		AddItem(a.status, 1, 0, false).
+		AddItem(a.body, 0, 1, true).
+		AddItem(bottomBar, barHeight, 0, false)
 
 	return main
 }
 
+// isDialogPage reports whether the page handles its own keys.
+func isDialogPage(name string) bool {
+	switch name {
+	case "help", ui.InputDialogID, ui.TypedConfirmID, ui.FormDialogID, ui.DiffDialogID, secretDialogID:
+		return true
+	}
+	return false
+}
+
 // keyboard handles global keyboard events.
 func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
-	// If help is showing, let it handle keys
-	if name, _ := a.Content.GetFrontPage(); name == "help" {
+	// If help or an input dialog is showing, let it handle keys
+	if name, _ := a.Content.GetFrontPage(); isDialogPage(name) {
 		return evt
 	}
 
@@ -402,6 +972,15 @@ func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 		case '?':
 			a.showHelp()
 			return nil
+		case 'F':
+			a.showFlashLog()
+			return nil
+		case '[':
+			a.crumbBack()
+			return nil
+		case ']':
+			a.crumbForward()
+			return nil
 		case 'q':
 			a.Stop()
 			return nil
@@ -416,6 +995,19 @@ func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	case tcell.KeyCtrlR:
 		a.refresh()
 		return nil
+	case tcell.KeyCtrlW:
+		if a.split != nil {
+			a.swapPane()
+			return nil
+		}
+		return evt
+	case tcell.KeyCtrlX:
+		// Dismiss a sticky error
+		if a.flash.Sticky() {
+			a.flash.Clear()
+			return nil
+		}
+		return evt
 	case tcell.KeyEsc:
 		// Clear filter or go back
 		if a.cmdBar.GetFilterText() != "" {
@@ -449,6 +1041,21 @@ func (a *App) applyFilter(filter string) {
 	}
 }
 
+// submitFilter applies the filter confirmed with Enter and warns when it is
+// invalid. While typing, only the table title reports the problem.
+func (a *App) submitFilter(filter string) {
+	a.applyFilter(filter)
+
+	if a.Content == nil {
+		return
+	}
+	if checked, ok := a.Content.CurrentPage().(interface{ FilterError() error }); ok {
+		if err := checked.FilterError(); err != nil {
+			a.flash.Warn(err.Error())
+		}
+	}
+}
+
 // showHelp displays the help screen in the content area.
 func (a *App) showHelp() {
 	// Set close callback to remove the help page
@@ -457,13 +1064,38 @@ func (a *App) showHelp() {
 		a.SetFocus(a.Content)
 	})
 
+	a.updateHelp()
+
 	// Add help to content area (not full screen, keeps command bar visible)
 	a.Content.AddPage("help", a.help, true, true)
 	a.SetFocus(a.help)
 }
 
-// refresh refreshes the current view.
+// showFlashLog pushes the log of recent flash messages.
+func (a *App) showFlashLog() {
+	if _, ok := a.Content.CurrentPage().(*FlashLog); ok {
+		return
+	}
+
+	view := NewFlashLog(a.flash)
+	if err := view.Init(context.Background()); err != nil {
+		a.flash.Err(err)
+		return
+	}
+	a.Content.Push("messages", view)
+	a.SetFocus(view)
+	view.Start()
+}
+
+// refresh refreshes the current view of the focused pane.
 func (a *App) refresh() {
+	if pages := a.focusedPages(); pages != a.Content {
+		if startable, ok := pages.CurrentPage().(interface{ Start() }); ok {
+			a.flash.Info("Refreshing...")
+			startable.Start()
+		}
+		return
+	}
 	a.RefreshCurrentView()
 }
 
@@ -485,9 +1117,154 @@ func (a *App) RefreshCurrentView() {
 	}
 }
 
+// stackChanged keeps the breadcrumbs in sync with the view stack. A view
+// pushed by other means than crumbForward drops the forward history.
+func (a *App) stackChanged() {
+	// Views and dialogs shown on Content take focus from the split pane
+	a.splitFocus = false
+	if size := a.Content.StackSize(); size != a.stackSize {
+		if size > a.stackSize && !a.restoring {
+			a.forward = nil
+		}
+		a.stackSize = size
+	}
+	a.viewChanged()
+}
+
+// viewChanged refreshes the breadcrumbs, menu hints and help screen from
+// the view on top of the stack, e.g. after it added or removed actions.
+func (a *App) viewChanged() {
+	a.refreshCrumbs()
+	a.updateHelp()
+	a.updateMenu(a.Content.CurrentPage())
+}
+
+// SetFocus focuses p and shows its key hints in the menu.
+func (a *App) SetFocus(p tview.Primitive) *tview.Application {
+	app := a.Application.SetFocus(p)
+	a.updateMenu(p)
+	return app
+}
+
+// updateMenu shows the key hints of p, or of the top view when p is the
+// view stack itself. Other primitives such as dialogs keep the current hints.
+func (a *App) updateMenu(p tview.Primitive) {
+	if a.menu == nil || a.Content == nil {
+		return
+	}
+	if p == a.Content {
+		p = a.Content.CurrentPage()
+	}
+	if pages := a.SplitPages(); pages != nil && p == pages {
+		p = pages.CurrentPage()
+	}
+	if hinter, ok := p.(ui.Hinter); ok {
+		a.menu.HydrateMenu(hinter.Hints())
+	}
+}
+
+// updateHelp points the help screen at the view on top of the stack.
+func (a *App) updateHelp() {
+	if a.help == nil {
+		return
+	}
+	page := a.Content.CurrentPage()
+	name, hints := "", ui.MenuHints(nil)
+	if named, ok := page.(ui.Primitive); ok {
+		name = named.Name()
+	}
+	if hinter, ok := page.(ui.Hinter); ok {
+		hints = hinter.Hints()
+	}
+	a.help.SetHints(name, hints)
+}
+
+// refreshCrumbs rebuilds the breadcrumbs from the view stack, expanding
+// views with their own levels such as S3 prefixes.
+func (a *App) refreshCrumbs() {
+	_, pages := a.Content.Stack()
+
+	var crumbs []string
+	a.crumbTo = a.crumbTo[:0]
+	for depth, page := range pages {
+		if crumbed, ok := page.(ui.Crumbed); ok {
+			for level, crumb := range crumbed.Crumbs() {
+				crumbs = append(crumbs, crumb)
+				a.crumbTo = append(a.crumbTo, crumbTarget{depth: depth, level: level})
+			}
+			continue
+		}
+		name := ""
+		if named, ok := page.(ui.Primitive); ok {
+			name = named.Name()
+		}
+		crumbs = append(crumbs, name)
+		a.crumbTo = append(a.crumbTo, crumbTarget{depth: depth})
+	}
+	a.crumbs.Update(crumbs)
+}
+
+// popToCrumb pops views, then levels within the remaining view, until the
+// crumb at index is active.
+func (a *App) popToCrumb(index int) {
+	defer a.SetFocus(a.Content)
+	if index < 0 || index >= len(a.crumbTo) {
+		return
+	}
+
+	target := a.crumbTo[index]
+	for a.Content.StackSize() > target.depth+1 {
+		a.forward = append(a.forward, stackPage{name: a.Content.Current(), page: a.Content.CurrentPage()})
+		a.Content.Pop()
+	}
+
+	crumbed, ok := a.Content.CurrentPage().(ui.Crumbed)
+	if !ok {
+		return
+	}
+	if n := len(crumbed.Crumbs()) - 1 - target.level; n > 0 {
+		// Forward history can't restore levels within a view
+		a.forward = nil
+		crumbed.PopCrumbs(n)
+		a.refreshCrumbs()
+	}
+}
+
+// crumbBack goes back to the previous breadcrumb.
+func (a *App) crumbBack() {
+	if len(a.crumbTo) > 1 {
+		a.popToCrumb(len(a.crumbTo) - 2)
+	}
+}
+
+// crumbForward restores the view most recently left by crumb navigation.
+func (a *App) crumbForward() {
+	if len(a.forward) == 0 {
+		return
+	}
+	last := a.forward[len(a.forward)-1]
+	a.forward = a.forward[:len(a.forward)-1]
+
+	a.restoring = true
+	a.Content.Push(last.name, last.page)
+	a.restoring = false
+
+	a.SetFocus(last.page)
+	if startable, ok := last.page.(interface{ Start() }); ok {
+		startable.Start()
+	}
+}
+
 // handleEscape handles the Escape key (go back/cancel).
 func (a *App) handleEscape() {
 	// If we have multiple pages, pop the top one
+	if pages := a.focusedPages(); pages != a.Content {
+		if pages.StackSize() > 1 {
+			pages.Pop()
+			a.SetFocus(pages)
+		}
+		return
+	}
 	if a.Content.StackSize() > 1 {
 		a.Content.Pop()
 	}
@@ -5,19 +5,40 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
 	"github.com/a1s/a1s/internal/dao"
 	"github.com/a1s/a1s/internal/model1"
+	"github.com/a1s/a1s/internal/render"
 	"github.com/a1s/a1s/internal/ui"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 	"github.com/derailed/tcell/v2"
 )
 
+// maxRegionWorkers bounds the number of regions listed concurrently.
+const maxRegionWorkers = 8
+
+// spinnerInterval is the delay between loading spinner frames.
+const spinnerInterval = 100 * time.Millisecond
+
+// tagsColumnWidth caps the display width of the TAGS column. Cells keep the
+// full text, so filters still match tags past the cut.
+const tagsColumnWidth = 40
+
+// spinnerFrames are the loading spinner animation frames.
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
 // ContextKey represents context key.
 type ContextKey string
 
@@ -37,12 +58,27 @@ type Browser struct {
 	factory  dao.Factory
 	accessor dao.Accessor
 	region   string
+	filters  map[string]string
 	cancelFn context.CancelFunc
+	showTags bool
+	last     *listing // the listing shown, for re-rendering without a reload
+	gen      uint64   // bumped per load so late results of older loads are dropped
 	pushFn   func(name string, c ui.Component)
 	popFn    func()
 	mx       sync.RWMutex
 }
 
+// listing is a rendered table along with the objects it was rendered from.
+type listing struct {
+	data    *model1.TableData
+	objects []dao.AWSObject // nil when data holds an error
+	region  string
+	// base and rows keep every column of the resource's header by row ID,
+	// so actions can read columns the configured view leaves out.
+	base model1.Header
+	rows map[string]model1.Fields
+}
+
 // NewBrowser returns a new AWS resource browser.
 func NewBrowser(rid *dao.ResourceID) *Browser {
 	return &Browser{
@@ -92,6 +128,7 @@ func (b *Browser) Init(ctx context.Context) error {
 	}
 
 	b.bindKeys(b.Actions())
+	b.SetRowColorFn(b.idleRowColor)
 	return nil
 }
 
@@ -102,12 +139,24 @@ func (b *Browser) Start() {
 	model := b.GetModel()
 	if model != nil {
 		model.AddListener(b)
-		if err := model.Watch(b.prepareContext()); err != nil {
-			// Log error - App.Flash() will be added when App is available
-		}
+		ctx := b.prepareContext()
+		go func() {
+			if err := model.Watch(ctx); err != nil {
+				b.mx.RLock()
+				app := b.app
+				b.mx.RUnlock()
+				if app != nil {
+					app.QueueUpdateDraw(func() { b.TableLoadFailed(err) })
+				}
+			}
+		}()
 	} else if b.factory != nil {
-		// Load real AWS data using the factory
-		b.loadRealData()
+		// Show the last listing of this view at once, then load real AWS
+		// data off the UI thread and keep it fresh
+		if data := b.cached(); data != nil {
+			b.UpdateUI(data)
+		}
+		go b.loadRealData(b.prepareContext(), b.nextGen())
 	} else {
 		// Show demo data if no factory is connected
 		b.loadDemoData()
@@ -115,22 +164,178 @@ func (b *Browser) Start() {
 	b.Table.Start()
 }
 
-// loadRealData fetches real AWS resources using the DAO.
-func (b *Browser) loadRealData() {
+// loadRealData fetches real AWS resources using the DAO, animating a spinner
+// in the table title until the data arrives. It must not run on the UI thread.
+// Results are dropped if ctx was cancelled by a newer Start or by Stop, or
+// if gen is no longer the current load generation.
+func (b *Browser) loadRealData(ctx context.Context, gen uint64) {
 	rid := b.GetResourceID()
 	if rid == nil {
 		return
 	}
 
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	update := func(fn func()) {
+		if app != nil {
+			app.QueueUpdateDraw(fn)
+		} else {
+			fn()
+		}
+	}
+
+	done := make(chan struct{})
+	if app != nil {
+		go b.spin(ctx, app, done)
+	}
+
+	l, ok := b.fetchData(ctx, rid)
+	close(done)
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	update(func() {
+		if ctx.Err() != nil || !b.isCurrentGen(gen) {
+			return
+		}
+		b.SetSpinner("")
+		if !ok {
+			// Fall back to demo data on error
+			b.show(nil)
+			b.loadDemoData()
+			return
+		}
+		b.show(l)
+	})
+
+	if ok {
+		b.watch(ctx, gen)
+	}
+}
+
+// show displays a listing and caches it for re-entering the view.
+// A nil listing only forgets the previous one.
+func (b *Browser) show(l *listing) {
+	b.mx.Lock()
+	b.last = l
+	b.mx.Unlock()
+
+	if l != nil {
+		b.UpdateUI(l.data)
+		b.remember(l.data)
+	}
+}
+
+// cacheKey identifies the listing shown by this browser across view
+// instances: the profile, region, resource, list filters and whatever
+// shapes the table, i.e. the toggled and configured columns.
+func (b *Browser) cacheKey() string {
+	rid := b.GetResourceID()
+
+	b.mx.RLock()
+	factory := b.factory
+	region := b.region
+	filters := make([]string, 0, len(b.filters))
+	for k, v := range b.filters {
+		filters = append(filters, k+"="+v)
+	}
+	b.mx.RUnlock()
+
+	if rid == nil || factory == nil {
+		return ""
+	}
+	if region == "" {
+		region = factory.Region()
+	}
+	sort.Strings(filters)
+
+	toggles := fmt.Sprintf("tags=%t,cost=%t,policies=%t,sizes=%t,idle=%t",
+		b.tagsShown(), b.costHints(), b.policyCounts(), b.bucketSizes(), b.idleOnly())
+
+	return strings.Join([]string{
+		factory.Profile(), region, rid.String(),
+		strings.Join(filters, ","), strings.Join(b.viewColumns(rid), ","), toggles,
+	}, "|")
+}
+
+// cached returns the last successful listing of this view, or nil.
+func (b *Browser) cached() *model1.TableData {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	key := b.cacheKey()
+	if app == nil || key == "" {
+		return nil
+	}
+	return app.cachedData(key)
+}
+
+// remember caches a successful listing so re-entering the view shows it
+// while the next load runs.
+func (b *Browser) remember(data *model1.TableData) {
+	if data == nil || data.HasError() {
+		return
+	}
+
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if key := b.cacheKey(); app != nil && key != "" {
+		app.cacheData(key, data)
+	}
+}
+
+// spin animates the loading spinner in the table title until done is closed.
+func (b *Browser) spin(ctx context.Context, app *App, done <-chan struct{}) {
+	ticker := time.NewTicker(spinnerInterval)
+	defer ticker.Stop()
+
+	for i := 0; ; i++ {
+		frame := spinnerFrames[i%len(spinnerFrames)]
+		app.QueueUpdateDraw(func() {
+			select {
+			case <-done:
+			default:
+				b.SetSpinner(frame)
+			}
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// timeout returns the configured timeout for a kind of AWS operation.
+func (b *Browser) timeout(kind string) time.Duration {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	return timeoutFor(app, kind)
+}
+
+// fetchData lists the browser's resources and renders them to TableData.
+// Listing errors are reported in the returned data; ok is false only when
+// no accessor exists for the resource.
+func (b *Browser) fetchData(ctx context.Context, rid *dao.ResourceID) (*listing, bool) {
 	// Get or create accessor
 	b.mx.Lock()
 	if b.accessor == nil {
 		acc, err := dao.AccessorFor(b.factory, rid)
 		if err != nil {
 			b.mx.Unlock()
-			// Fall back to demo data on error
-			b.loadDemoData()
-			return
+			return nil, false
 		}
 		b.accessor = acc
 	}
@@ -147,50 +352,369 @@ func (b *Browser) loadRealData() {
 		region = aws.DefaultRegion
 	}
 
+	// Regional services have no "all" endpoint, so fan out per region
+	if region == aws.RegionAll && !aws.IsGlobalService(rid.Service) {
+		return b.loadAllRegions(ctx, accessor, factory, rid), true
+	}
+
 	// Fetch data from AWS
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, b.timeout(config.TimeoutList))
 	defer cancel()
 
-	objects, err := accessor.List(ctx, region)
+	objects, err := b.list(ctx, accessor, region)
+	partial := err != nil && errors.Is(err, dao.ErrPartialList) && len(objects) > 0
+	if partial {
+		// Some resources could not be fetched - show what we have
+		b.warnPartial(err, rid)
+		err = nil
+	}
 	if err != nil {
+		slog.Error("List failed", "resource", rid.String(), "region", region, "err", err)
+
 		// Show table with error message based on error type
 		data := model1.NewTableData()
 		data.SetNamespace(region)
-		data.SetHeader(b.headerForResource(rid))
+		data.SetHeader(b.displayHeader(rid))
 
 		// Determine appropriate error message
 		errMsg := b.friendlyError(err, rid)
 		data.SetError(errMsg)
 
-		b.UpdateUI(data)
-		return
+		// Expired credentials show in the status line
+		b.mx.RLock()
+		app := b.app
+		b.mx.RUnlock()
+		if app != nil && factory != nil && aws.IsExpiredCredentials(err) {
+			app.pollStatus(factory.Client())
+		}
+
+		return &listing{data: data, region: region}, true
 	}
 
 	// Convert to TableData using renderer
-	data := b.renderObjects(objects, region, rid)
-	b.UpdateUI(data)
+	l := b.renderListing(objects, region, rid)
+	l.data.SetMore(partial)
+	return l, true
+}
+
+// loadAllRegions lists resources across all enabled regions and merges the results.
+// Regions that fail are skipped and reported as a warning instead of failing the listing.
+func (b *Browser) loadAllRegions(ctx context.Context, accessor dao.Accessor, factory dao.Factory, rid *dao.ResourceID) *listing {
+	ctx, cancel := context.WithTimeout(ctx, b.timeout(config.TimeoutList))
+	defer cancel()
+
+	regions := enabledRegions(ctx, factory)
+	results := make([][]dao.AWSObject, len(regions))
+	errs := make([]error, len(regions))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxRegionWorkers)
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i], errs[i] = b.list(ctx, accessor, region)
+		}(i, region)
+	}
+	wg.Wait()
+
+	var (
+		objects  []dao.AWSObject
+		failed   []string
+		firstErr error
+		partial  bool
+	)
+	for i, region := range regions {
+		if errs[i] != nil && errors.Is(errs[i], dao.ErrPartialList) && len(results[i]) > 0 {
+			b.warnPartial(errs[i], rid)
+			errs[i] = nil
+			partial = true
+		}
+		if errs[i] != nil {
+			failed = append(failed, region)
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		objects = append(objects, results[i]...)
+	}
+
+	if len(regions) > 0 && len(failed) == len(regions) {
+		data := model1.NewTableData()
+		data.SetNamespace(aws.RegionAll)
+		data.SetHeader(b.displayHeader(rid))
+		data.SetError(b.friendlyError(firstErr, rid))
+		return &listing{data: data, region: aws.RegionAll}
+	}
+
+	if len(failed) > 0 {
+		b.mx.RLock()
+		app := b.app
+		b.mx.RUnlock()
+		if app != nil {
+			app.Flash().Warnf("Unable to list %s in %d region(s): %s", rid.String(), len(failed), strings.Join(failed, ", "))
+		}
+	}
+
+	l := b.renderListing(objects, aws.RegionAll, rid)
+	l.data.SetMore(partial || len(failed) > 0)
+	return l
+}
+
+// watch periodically reloads the browser's data until ctx is cancelled or a
+// newer load supersedes gen. Reloads are skipped while auto-refresh is
+// paused or the browser is hidden.
+func (b *Browser) watch(ctx context.Context, gen uint64) {
+	rid := b.GetResourceID()
+	if rid == nil {
+		return
+	}
+
+	for {
+		b.mx.RLock()
+		app := b.app
+		b.mx.RUnlock()
+		if app == nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(app.RefreshRate()):
+		}
+
+		if app.AutoRefreshPaused() || !b.HasFocus() {
+			continue
+		}
+
+		l, ok := b.fetchData(ctx, rid)
+		if !ok || ctx.Err() != nil {
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			if ctx.Err() == nil && b.isCurrentGen(gen) {
+				b.show(l)
+			}
+		})
+	}
+}
+
+// toggleAutoRefresh pauses or resumes periodic reloads.
+func (b *Browser) toggleAutoRefresh(*tcell.EventKey) *tcell.EventKey {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return nil
+	}
+
+	if app.ToggleAutoRefresh() {
+		app.Flash().Info("Auto-refresh paused")
+	} else {
+		app.Flash().Infof("Auto-refresh resumed (every %s)", app.RefreshRate())
+	}
+	return nil
+}
+
+// warnPartial flashes a warning for a listing that only partially succeeded.
+func (b *Browser) warnPartial(err error, rid *dao.ResourceID) {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app != nil {
+		app.Flash().Warnf("Some %s could not be loaded: %v", rid.String(), err)
+	}
+}
+
+// enabledRegions returns the regions enabled for the active profile.
+// Falls back to the built-in region list if discovery fails.
+func enabledRegions(ctx context.Context, factory dao.Factory) []string {
+	rm := aws.NewRegionManager()
+	if client := factory.Client(); client != nil {
+		home := factory.Region()
+		if home == "" || home == aws.RegionAll {
+			home = aws.DefaultRegion
+		}
+		if ec2Client := client.EC2(home); ec2Client != nil {
+			_ = rm.DiscoverRegions(ctx, ec2Client)
+		}
+	}
+	return rm.ListRegions()
 }
 
 // renderObjects converts AWS objects to TableData.
+// When listing all regions, a REGION column is prepended so rows stay distinguishable.
 func (b *Browser) renderObjects(objects []dao.AWSObject, region string, rid *dao.ResourceID) *model1.TableData {
+	return b.renderListing(objects, region, rid).data
+}
+
+// renderListing renders objects as renderObjects does, keeping each object's
+// full row for lookups of columns that are not displayed.
+func (b *Browser) renderListing(objects []dao.AWSObject, region string, rid *dao.ResourceID) *listing {
 	data := model1.NewTableData()
 	data.SetNamespace(region)
+	l := &listing{data: data, objects: objects, region: region}
 
 	if len(objects) == 0 {
-		return data
+		return l
 	}
 
-	// Build header based on resource type
-	header := b.headerForResource(rid)
-	data.SetHeader(header)
+	// Build header based on resource type and the configured columns
+	base := b.headerForResource(rid)
+	l.base, l.rows = base, make(map[string]model1.Fields, len(objects))
+	cols := visibleColumns(base, b.viewColumns(rid))
+	header := projectHeader(base, cols)
+	costCol, tagsCol := -1, -1
+	if dao.HasCostEstimate(rid) && b.costHints() {
+		costCol = len(header)
+		header = append(header, model1.HeaderColumn{Name: "COST/MO"})
+	}
+	if b.tagsShown() {
+		tagsCol = len(header)
+		header = append(header, model1.HeaderColumn{Name: "TAGS", Attrs: model1.Attrs{MaxWidth: tagsColumnWidth}})
+	}
+	_, hasRegion := header.IndexOf("REGION", true)
+	multiRegion := region == aws.RegionAll && !hasRegion
+	if multiRegion {
+		data.SetHeader(append(model1.Header{{Name: "REGION"}}, header...))
+	} else {
+		data.SetHeader(header)
+	}
 
 	// Build rows
 	for _, obj := range objects {
-		row := b.rowForObject(obj, rid, header)
+		full := b.rowForObject(obj, rid, base)
+		l.rows[full.ID] = full.Fields
+		row := model1.NewRow(len(header))
+		row.ID = full.ID
+		for i, col := range cols {
+			row.Fields[i] = full.Fields[col]
+		}
+		if costCol >= 0 {
+			row.Fields[costCol] = costField(obj)
+		}
+		if tagsCol >= 0 {
+			row.Fields[tagsCol] = tagsField(obj.GetTags())
+		}
+		if multiRegion {
+			row.Fields = append(model1.Fields{obj.GetRegion()}, row.Fields...)
+		}
 		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
 	}
 
-	return data
+	return l
+}
+
+// GetField returns the named column of the row with the given ID. Columns
+// left out of the configured view or hidden by default are read from the
+// row's full set of fields, so actions can still check e.g. STATE.
+func (b *Browser) GetField(item, colName string) string {
+	if v := b.Table.GetField(item, colName); v != "" {
+		return v
+	}
+	return b.baseField(item, colName)
+}
+
+// GetSelectedField returns the named column of the selected row, falling
+// back to the row's full set of fields like GetField.
+func (b *Browser) GetSelectedField(colName string) string {
+	if v := b.Table.GetSelectedField(colName); v != "" {
+		return v
+	}
+	return b.baseField(b.GetSelectedItem(), colName)
+}
+
+// baseField returns the named column of the row with the given ID from the
+// shown listing's full rows.
+func (b *Browser) baseField(item, colName string) string {
+	b.mx.RLock()
+	last := b.last
+	b.mx.RUnlock()
+
+	if item == "" || last == nil {
+		return ""
+	}
+	col, ok := last.base.IndexOf(colName, true)
+	if !ok {
+		return ""
+	}
+	fields, ok := last.rows[item]
+	if !ok || col >= len(fields) {
+		return ""
+	}
+	return fields[col]
+}
+
+// viewColumns returns the columns configured for rid, or nil for its defaults.
+func (b *Browser) viewColumns(rid *dao.ResourceID) []string {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return nil
+	}
+	return app.ViewColumns(rid.String())
+}
+
+// visibleColumns returns the indexes of the header columns to display, in
+// display order: the configured columns found in header, ignoring unknown
+// names, or else every column not hidden by default.
+func visibleColumns(header model1.Header, columns []string) []int {
+	cols := make([]int, 0, len(header))
+	seen := make(map[int]bool, len(columns))
+	for _, name := range columns {
+		if idx, ok := header.IndexOf(name, true); ok && !seen[idx] {
+			seen[idx] = true
+			cols = append(cols, idx)
+		}
+	}
+	if len(cols) > 0 {
+		return cols
+	}
+
+	for i, h := range header {
+		if !h.Hide {
+			cols = append(cols, i)
+		}
+	}
+	return cols
+}
+
+// projectHeader returns the header columns at cols, without the Hide flag
+// so opted-in columns display.
+func projectHeader(header model1.Header, cols []int) model1.Header {
+	out := make(model1.Header, 0, len(cols)+3)
+	for _, col := range cols {
+		h := header[col]
+		h.Hide = false
+		out = append(out, h)
+	}
+	return out
+}
+
+// displayHeader returns the header of rid as displayed, e.g. for error and
+// empty tables.
+func (b *Browser) displayHeader(rid *dao.ResourceID) model1.Header {
+	base := b.headerForResource(rid)
+	return projectHeader(base, visibleColumns(base, b.viewColumns(rid)))
+}
+
+// selectedRegion returns the region of the selected row when the table
+// carries a REGION column, e.g. for multi-region listings.
+func (b *Browser) selectedRegion() string {
+	region := b.GetSelectedField("REGION")
+	if region == "-" || region == aws.RegionAll {
+		return ""
+	}
+	return region
 }
 
 // headerForResource returns the header for a resource type.
@@ -205,27 +729,51 @@ func (b *Browser) headerForResource(rid *dao.ResourceID) model1.Header {
 			{Name: "AZ"},
 			{Name: "PUBLIC IP"},
 			{Name: "PRIVATE IP"},
+			{Name: "VPC", Attrs: model1.Attrs{Hide: true}},
+			{Name: "SUBNET", Attrs: model1.Attrs{Hide: true}},
 		}
-	case "s3/bucket":
+	case "ec2/volume":
 		return model1.Header{
+			{Name: "ID"},
+			{Name: "NAME"},
+			{Name: "SIZE"},
+			{Name: "TYPE"},
+			{Name: "STATE"},
+			{Name: "AZ"},
+			{Name: "ENCRYPTED", Attrs: model1.Attrs{Hide: true}},
+			{Name: "IOPS", Attrs: model1.Attrs{Hide: true}},
+			{Name: "ATTACHED-TO", Attrs: model1.Attrs{Hide: true}},
+		}
+	case "s3/bucket":
+		header := model1.Header{
 			{Name: "NAME"},
 			{Name: "REGION"},
 			{Name: "CREATED"},
 		}
+		if b.bucketSizes() {
+			header = append(header, model1.HeaderColumn{Name: "SIZE"}, model1.HeaderColumn{Name: "OBJECTS"})
+		}
+		return header
 	case "vpc/securitygroup":
 		return model1.Header{
 			{Name: "ID"},
 			{Name: "NAME"},
 			{Name: "VPC"},
+			{Name: "INBOUND"},
+			{Name: "OUTBOUND"},
 			{Name: "DESCRIPTION"},
 		}
 	case "iam/user":
-		return model1.Header{
+		header := model1.Header{
 			{Name: "NAME"},
 			{Name: "USER ID"},
 			{Name: "CREATED"},
 			{Name: "LAST USED"},
 		}
+		if b.policyCounts() {
+			header = append(header, model1.HeaderColumn{Name: "POLICIES"}, model1.HeaderColumn{Name: "GROUPS"})
+		}
+		return header
 	case "iam/role":
 		return model1.Header{
 			{Name: "NAME"},
@@ -233,6 +781,25 @@ func (b *Browser) headerForResource(rid *dao.ResourceID) model1.Header {
 			{Name: "CREATED"},
 			{Name: "DESCRIPTION"},
 		}
+	case "eks/nodegroup":
+		return model1.Header{
+			{Name: "NAME"},
+			{Name: "CLUSTER"},
+			{Name: "STATUS"},
+			{Name: "DESIRED"},
+			{Name: "MIN"},
+			{Name: "MAX"},
+		}
+	case "ssm/instance":
+		return model1.Header{
+			{Name: "ID"},
+			{Name: "NAME"},
+			{Name: "PING"},
+			{Name: "LAST PING"},
+			{Name: "AGENT"},
+			{Name: "PLATFORM"},
+			{Name: "IP"},
+		}
 	default:
 		return model1.Header{
 			{Name: "ID"},
@@ -263,55 +830,347 @@ func (b *Browser) rowForObject(obj dao.AWSObject, rid *dao.ResourceID, header mo
 		row.Fields[4] = extractField(raw, "Placement.AvailabilityZone")
 		row.Fields[5] = extractField(raw, "PublicIpAddress")
 		row.Fields[6] = extractField(raw, "PrivateIpAddress")
+		row.Fields[7] = extractField(raw, "VpcId")
+		row.Fields[8] = extractField(raw, "SubnetId")
+
+	case "ec2/volume":
+		row.Fields[0] = obj.GetID()
+		row.Fields[1] = obj.GetName()
+		row.Fields[2] = extractField(raw, "Size")
+		if row.Fields[2] != "-" {
+			row.Fields[2] += "GiB"
+		}
+		row.Fields[3] = extractField(raw, "VolumeType")
+		row.Fields[4] = extractField(raw, "State")
+		row.Fields[5] = extractField(raw, "AvailabilityZone")
+		row.Fields[6] = extractField(raw, "Encrypted")
+		row.Fields[7] = extractField(raw, "Iops")
+		row.Fields[8] = "-"
+		if vol, ok := raw.(ec2types.Volume); ok && len(vol.Attachments) > 0 {
+			row.Fields[8] = aws.SafeString(vol.Attachments[0].InstanceId)
+		}
 
 	case "s3/bucket":
+		// Header: NAME, REGION, CREATED[, SIZE, OBJECTS]
 		row.Fields[0] = obj.GetName()
 		row.Fields[1] = obj.GetRegion()
-		if t := obj.GetCreatedAt(); t != nil {
-			row.Fields[2] = t.Format("2006-01-02")
-		} else {
-			row.Fields[2] = "-"
+		row.Fields[2] = b.formatTime(obj.GetCreatedAt())
+		if len(row.Fields) > 4 {
+			row.Fields[3], row.Fields[4] = "-", "-"
+			if bucket, ok := obj.(*dao.S3BucketObject); ok && bucket.Metrics != nil {
+				row.Fields[3] = formatBytes(bucket.Metrics.SizeBytes)
+				row.Fields[4] = strconv.FormatInt(bucket.Metrics.Objects, 10)
+			}
 		}
 
 	case "vpc/securitygroup":
 		row.Fields[0] = obj.GetID()
 		row.Fields[1] = obj.GetName()
 		row.Fields[2] = extractField(raw, "VpcId")
-		row.Fields[3] = extractField(raw, "Description")
+		row.Fields[3], row.Fields[4] = "-", "-"
+		if sg, ok := raw.(ec2types.SecurityGroup); ok {
+			row.Fields[3] = strconv.Itoa(len(sg.IpPermissions))
+			row.Fields[4] = strconv.Itoa(len(sg.IpPermissionsEgress))
+		}
+		row.Fields[5] = extractField(raw, "Description")
 
 	case "iam/user":
-		// Header: NAME, USER ID, CREATED, LAST USED
+		// Header: NAME, USER ID, CREATED, LAST USED[, POLICIES, GROUPS]
 		row.ID = obj.GetName() // Use name as row ID for IAM
 		row.Fields[0] = obj.GetName()
 		row.Fields[1] = obj.GetID()
-		if t := obj.GetCreatedAt(); t != nil {
-			row.Fields[2] = t.Format("2006-01-02")
-		} else {
-			row.Fields[2] = "-"
+		row.Fields[2] = b.formatTime(obj.GetCreatedAt())
+		row.Fields[3] = b.formatTime(extractTime(raw, "PasswordLastUsed"))
+		if len(row.Fields) > 5 {
+			row.Fields[4], row.Fields[5] = "-", "-"
+			if u, ok := obj.(*dao.IAMUserObject); ok {
+				row.Fields[4] = countField(u.Policies())
+				row.Fields[5] = countField(u.Groups)
+			}
 		}
-		row.Fields[3] = extractField(raw, "PasswordLastUsed")
 
 	case "iam/role":
 		// Header: NAME, ROLE ID, CREATED, DESCRIPTION
 		row.ID = obj.GetName() // Use name as row ID for IAM
 		row.Fields[0] = obj.GetName()
 		row.Fields[1] = obj.GetID()
-		if t := obj.GetCreatedAt(); t != nil {
-			row.Fields[2] = t.Format("2006-01-02")
-		} else {
-			row.Fields[2] = "-"
-		}
+		row.Fields[2] = b.formatTime(obj.GetCreatedAt())
 		row.Fields[3] = extractField(raw, "Description")
 
-	default:
+	case "eks/nodegroup":
+		// Row ID carries the cluster so paths resolve to region/cluster/nodegroup
+		cluster := extractField(raw, "ClusterName")
+		row.ID = cluster + "/" + obj.GetName()
+		row.Fields[0] = obj.GetName()
+		row.Fields[1] = cluster
+		row.Fields[2] = extractField(raw, "Status")
+		row.Fields[3] = extractField(raw, "ScalingConfig.DesiredSize")
+		row.Fields[4] = extractField(raw, "ScalingConfig.MinSize")
+		row.Fields[5] = extractField(raw, "ScalingConfig.MaxSize")
+
+	case "ssm/instance":
 		row.Fields[0] = obj.GetID()
 		row.Fields[1] = obj.GetName()
-		row.Fields[2] = obj.GetRegion()
-	}
-
+		row.Fields[2], row.Fields[3], row.Fields[4], row.Fields[5], row.Fields[6] = "-", "-", "-", "-", "-"
+		if info, ok := raw.(aws.SSMInstanceInfo); ok {
+			row.Fields[2] = info.PingStatus
+			row.Fields[3] = b.formatTime(info.LastPing)
+			row.Fields[4] = info.AgentVersion
+			if !info.IsLatestVersion {
+				row.Fields[4] += "*"
+			}
+			row.Fields[5] = strings.TrimSpace(info.PlatformName + " " + info.PlatformVersion)
+			row.Fields[6] = info.IPAddress
+		}
+
+	default:
+		row.Fields[0] = obj.GetID()
+		row.Fields[1] = obj.GetName()
+		row.Fields[2] = obj.GetRegion()
+	}
+
 	return row
 }
 
+// countField renders a count, or "?" when it is unknown.
+func countField(n int) string {
+	if n < 0 {
+		return "?"
+	}
+	return strconv.Itoa(n)
+}
+
+// costField renders an estimated monthly cost, or "?" when the resource
+// cannot be priced. The "~" marks the value as approximate.
+func costField(obj dao.AWSObject) string {
+	cost, ok := dao.EstimatedMonthlyCost(obj)
+	if !ok {
+		return "?"
+	}
+	return fmt.Sprintf("~$%.2f", cost)
+}
+
+// tagsField renders tags as key=value pairs sorted by key, e.g.
+// "Env=prod,Name=web", or "-" without tags.
+func tagsField(tags map[string]string) string {
+	if len(tags) == 0 {
+		return "-"
+	}
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+tags[k])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// formatTime renders a table timestamp as a relative age, or as an absolute
+// time when the user toggled absolute times.
+func (b *Browser) formatTime(t *time.Time) string {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	return formatTime(app, t)
+}
+
+// formatTime renders t as a relative age, or as an absolute time when app
+// shows absolute times. Views outside Browser use it directly.
+func formatTime(app *App, t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	if app != nil && app.AbsoluteTimes() {
+		return t.Local().Format("2006-01-02 15:04")
+	}
+	return render.ToAge(t)
+}
+
+// toggleTimesCmd switches time columns between relative ages and absolute
+// timestamps, re-rendering the listed objects instead of listing them again.
+func (b *Browser) toggleTimesCmd(*tcell.EventKey) *tcell.EventKey {
+	if !b.toggleTimes() {
+		return nil
+	}
+
+	b.mx.RLock()
+	last := b.last
+	b.mx.RUnlock()
+
+	rid := b.GetResourceID()
+	if last == nil || last.objects == nil || rid == nil {
+		b.Start()
+		return nil
+	}
+	l := b.renderListing(last.objects, last.region, rid)
+	l.data.SetMore(last.data.More())
+	b.show(l)
+	return nil
+}
+
+// toggleCostsCmd shows or hides the estimated monthly cost column.
+func (b *Browser) toggleCostsCmd(*tcell.EventKey) *tcell.EventKey {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return nil
+	}
+
+	if app.ToggleCostHints() {
+		app.Flash().Info("Showing approximate monthly costs (us-east-1 on-demand list prices)")
+	} else {
+		app.Flash().Info("Hiding estimated costs")
+	}
+	b.Start()
+	return nil
+}
+
+// toggleTagsCmd shows or hides the TAGS column of this view.
+func (b *Browser) toggleTagsCmd(*tcell.EventKey) *tcell.EventKey {
+	b.mx.Lock()
+	b.showTags = !b.showTags
+	show := b.showTags
+	app := b.app
+	b.mx.Unlock()
+
+	if app != nil {
+		if show {
+			app.Flash().Info("Showing tags")
+		} else {
+			app.Flash().Info("Hiding tags")
+		}
+	}
+	b.Start()
+	return nil
+}
+
+// toggleWideCmd switches the table between capped columns, e.g. TAGS, and full cell values.
+func (b *Browser) toggleWideCmd(*tcell.EventKey) *tcell.EventKey {
+	wide := !b.Wide()
+	b.SetWide(wide)
+
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app != nil {
+		if wide {
+			app.Flash().Info("Showing full values, use left/right to scroll")
+		} else {
+			app.Flash().Info("Clipping capped columns")
+		}
+	}
+	return nil
+}
+
+// toggleIdleCmd switches between listing all resources and only idle ones:
+// unattached volumes and unreferenced security groups.
+func (b *Browser) toggleIdleCmd(*tcell.EventKey) *tcell.EventKey {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return nil
+	}
+
+	if app.ToggleIdleOnly() {
+		app.Flash().Info("Showing only idle resources")
+	} else {
+		app.Flash().Info("Showing all resources")
+	}
+	b.Start()
+	return nil
+}
+
+// toggleSizesCmd shows or hides the bucket SIZE and OBJECTS columns. They
+// cost a CloudWatch call per bucket, so they are off by default.
+func (b *Browser) toggleSizesCmd(*tcell.EventKey) *tcell.EventKey {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return nil
+	}
+
+	if app.ToggleBucketSizes() {
+		app.Flash().Info("Fetching bucket sizes from CloudWatch...")
+	} else {
+		app.Flash().Info("Hiding bucket sizes")
+	}
+	b.Start()
+	return nil
+}
+
+// toggleTimes flips the app's time display and flashes the new mode.
+// Returns false without an app.
+func (b *Browser) toggleTimes() bool {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	return toggleTimes(app)
+}
+
+// toggleTimes flips app's time display and flashes the new mode.
+// Returns false without an app.
+func toggleTimes(app *App) bool {
+	if app == nil {
+		return false
+	}
+
+	if app.ToggleAbsoluteTimes() {
+		app.Flash().Info("Showing absolute times")
+	} else {
+		app.Flash().Info("Showing relative ages")
+	}
+	return true
+}
+
+// extractTime extracts a time field from a struct using reflection.
+// Returns nil if the field is missing or unset.
+func extractTime(obj interface{}, path string) *time.Time {
+	val := reflect.ValueOf(obj)
+	for _, part := range strings.Split(path, ".") {
+		for val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				return nil
+			}
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.Struct {
+			return nil
+		}
+		val = val.FieldByName(part)
+		if !val.IsValid() {
+			return nil
+		}
+	}
+
+	if val.Kind() == reflect.Ptr && val.IsNil() {
+		return nil
+	}
+	if !val.CanInterface() {
+		return nil
+	}
+	switch t := val.Interface().(type) {
+	case *time.Time:
+		return t
+	case time.Time:
+		return &t
+	}
+	return nil
+}
+
 // extractField extracts a field from a struct using reflection.
 func extractField(obj interface{}, path string) string {
 	if obj == nil {
@@ -416,15 +1275,16 @@ func (b *Browser) loadDemoData() {
 			{Name: "REGION"},
 			{Name: "CREATED"},
 			{Name: "SIZE"},
+			{Name: "OBJECTS"},
 		})
 		rows := model1.NewRowEvents(2)
 		rows.Add(model1.NewRowEvent(model1.EventAdd, model1.Row{
 			ID:     "my-app-bucket",
-			Fields: model1.Fields{"my-app-bucket", "us-east-1", "2024-01-15", "1.2 GB"},
+			Fields: model1.Fields{"my-app-bucket", "us-east-1", "2024-01-15", "1.2 GB", "3412"},
 		}))
 		rows.Add(model1.NewRowEvent(model1.EventAdd, model1.Row{
 			ID:     "backup-storage",
-			Fields: model1.Fields{"backup-storage", "us-west-2", "2023-06-20", "45 GB"},
+			Fields: model1.Fields{"backup-storage", "us-west-2", "2023-06-20", "45 GB", "120567"},
 		}))
 		for i := 0; i < rows.Len(); i++ {
 			if re, ok := rows.At(i); ok {
@@ -439,15 +1299,16 @@ func (b *Browser) loadDemoData() {
 			{Name: "VPC"},
 			{Name: "INBOUND"},
 			{Name: "OUTBOUND"},
+			{Name: "DESCRIPTION"},
 		})
 		rows := model1.NewRowEvents(2)
 		rows.Add(model1.NewRowEvent(model1.EventAdd, model1.Row{
 			ID:     "sg-0123456789abcdef0",
-			Fields: model1.Fields{"sg-0123456789abcdef0", "web-sg", "vpc-abc123", "3", "1"},
+			Fields: model1.Fields{"sg-0123456789abcdef0", "web-sg", "vpc-abc123", "3", "1", "Web tier"},
 		}))
 		rows.Add(model1.NewRowEvent(model1.EventAdd, model1.Row{
 			ID:     "sg-0123456789abcdef1",
-			Fields: model1.Fields{"sg-0123456789abcdef1", "default", "vpc-abc123", "1", "1"},
+			Fields: model1.Fields{"sg-0123456789abcdef1", "default", "vpc-abc123", "1", "1", "default VPC security group"},
 		}))
 		for i := 0; i < rows.Len(); i++ {
 			if re, ok := rows.At(i); ok {
@@ -483,7 +1344,9 @@ func (b *Browser) Stop() {
 		b.cancelFn()
 		b.cancelFn = nil
 	}
+	b.gen++
 	b.mx.Unlock()
+	b.SetSpinner("")
 
 	model := b.GetModel()
 	if model != nil {
@@ -520,6 +1383,116 @@ func (b *Browser) GetRegion() string {
 	return b.region
 }
 
+// SetListFilters sets server-side list filters (see dao.FilteredLister).
+func (b *Browser) SetListFilters(filters map[string]string) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.filters = filters
+}
+
+// list lists resources in a region, applying server-side filters when set
+// and dropping resources in use when only idle ones are wanted.
+func (b *Browser) list(ctx context.Context, accessor dao.Accessor, region string) ([]dao.AWSObject, error) {
+	objects, err := b.listAll(ctx, accessor, region)
+	if !b.idleOnly() {
+		return objects, err
+	}
+
+	idle := make([]dao.AWSObject, 0, len(objects))
+	for _, obj := range objects {
+		if dao.IsIdle(obj) {
+			idle = append(idle, obj)
+		}
+	}
+	return idle, err
+}
+
+// listAll lists resources in a region, applying server-side filters when set.
+func (b *Browser) listAll(ctx context.Context, accessor dao.Accessor, region string) ([]dao.AWSObject, error) {
+	b.mx.RLock()
+	filters := b.filters
+	b.mx.RUnlock()
+
+	if len(filters) > 0 {
+		if fl, ok := accessor.(dao.FilteredLister); ok {
+			return fl.ListFiltered(ctx, region, filters)
+		}
+	}
+	if b.policyCounts() {
+		if pl, ok := accessor.(dao.PolicyCountLister); ok {
+			return pl.ListWithPolicyCounts(ctx, region)
+		}
+	}
+	if b.bucketSizes() {
+		if ml, ok := accessor.(dao.BucketMetricsLister); ok {
+			return ml.ListWithMetrics(ctx, region)
+		}
+	}
+	if b.idleOnly() {
+		if ul, ok := accessor.(dao.UsageLister); ok {
+			return ul.ListWithUsage(ctx, region)
+		}
+	}
+	return accessor.List(ctx, region)
+}
+
+// idleOnly reports whether listings should keep only idle resources.
+func (b *Browser) idleOnly() bool {
+	if !dao.HasIdleCheck(b.GetResourceID()) {
+		return false
+	}
+
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	return app != nil && app.IdleOnly()
+}
+
+// idleRowColor paints rows red while only idle resources are listed.
+func (b *Browser) idleRowColor(string) (tcell.Color, bool) {
+	if !b.idleOnly() {
+		return tcell.ColorDefault, false
+	}
+	theme := ui.CurrentTheme()
+	return theme.Color(theme.Bad), true
+}
+
+// costHints reports whether listings should include estimated costs.
+func (b *Browser) costHints() bool {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	return app != nil && app.CostHints()
+}
+
+// tagsShown reports whether listings should include a TAGS column.
+func (b *Browser) tagsShown() bool {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	return b.showTags
+}
+
+// bucketSizes reports whether listings should include bucket storage metrics.
+func (b *Browser) bucketSizes() bool {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	return app != nil && app.BucketSizes()
+}
+
+// policyCounts reports whether listings should include IAM policy counts.
+func (b *Browser) policyCounts() bool {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	return app != nil && app.PolicyCounts()
+}
+
 // Name returns the component name for breadcrumbs.
 func (b *Browser) Name() string {
 	rid := b.GetResourceID()
@@ -539,9 +1512,26 @@ func (b *Browser) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyR:        ui.NewKeyAction("Change Region", b.changeRegion, true),
 		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", b.refresh, true),
+		ui.KeyShiftR:   ui.NewKeyAction("Toggle Auto-Refresh", b.toggleAutoRefresh, true),
 		ui.KeyD:        ui.NewKeyAction("Describe", b.describe, true),
 		ui.KeyE:        ui.NewKeyAction("Edit", b.edit, true),
+		ui.KeyShiftT:   ui.NewKeyAction("Edit Tags", b.editTags, true),
+		ui.KeyY:        ui.NewKeyAction("Copy ID", b.copyID, true),
+		ui.KeyShiftY:   ui.NewKeyAction("Copy ARN", b.copyARN, true),
+		ui.KeyShiftO:   ui.NewKeyAction("Open Console", b.openConsole, true),
+		ui.KeyShiftA:   ui.NewKeyAction("Toggle Age", b.toggleTimesCmd, true),
+		tcell.KeyCtrlT: ui.NewKeyAction("Toggle Tags", b.toggleTagsCmd, true),
+		ui.KeyW:        ui.NewKeyAction("Toggle Wide", b.toggleWideCmd, true),
 	})
+	if dao.HasCostEstimate(b.GetResourceID()) {
+		aa.Add(ui.KeyShiftC, ui.NewKeyAction("Toggle Cost", b.toggleCostsCmd, true))
+	}
+	if dao.HasIdleCheck(b.GetResourceID()) {
+		aa.Add(ui.KeyShiftI, ui.NewKeyAction("Toggle Idle", b.toggleIdleCmd, true))
+	}
+	if rid := b.GetResourceID(); rid != nil && rid.String() == "s3/bucket" {
+		aa.Add(ui.KeyShiftZ, ui.NewKeyAction("Toggle Size", b.toggleSizesCmd, true))
+	}
 
 	// Add action registry bindings for this resource type
 	b.bindResourceActions(aa)
@@ -575,7 +1565,6 @@ func (b *Browser) executeAction(action *ui.ResourceAction) *tcell.EventKey {
 	b.mx.RLock()
 	app := b.app
 	factory := b.factory
-	region := b.region
 	b.mx.RUnlock()
 
 	if app == nil || factory == nil {
@@ -588,21 +1577,14 @@ func (b *Browser) executeAction(action *ui.ResourceAction) *tcell.EventKey {
 		return nil
 	}
 
-	// Get region from model if available
-	if model := b.GetModel(); model != nil {
-		if ns := model.GetNamespace(); ns != "" && ns != "*" && ns != "all" {
-			region = ns
-		}
-	}
-	if region == "" {
-		region = factory.Region()
-	}
-	if region == "" {
-		region = aws.DefaultRegion
-	}
+	_, region := b.selectedPath()
 
 	// If dangerous, show confirmation dialog
 	if action.Dangerous {
+		if marked := b.GetMarkedItems(); len(marked) > 0 {
+			b.confirmBulkAction(action, marked, region, client)
+			return nil
+		}
 		b.confirmAction(action, resourceID, region, client)
 		return nil
 	}
@@ -626,14 +1608,15 @@ func (b *Browser) confirmAction(action *ui.ResourceAction, resourceID, region st
 	confirm := ui.NewConfirm(app.Content)
 	confirm.SetMessage(fmt.Sprintf("%s %s?", action.Name, resourceID))
 	confirm.SetDangerous(true)
+	confirm.SetConfirmationText(resourceID)
 	confirm.SetOnConfirm(func() {
 		b.doExecuteAction(action, resourceID, region, client)
 	})
 	confirm.Show()
 }
 
-// doExecuteAction performs the actual action execution.
-func (b *Browser) doExecuteAction(action *ui.ResourceAction, resourceID, region string, client aws.Connection) {
+// confirmBulkAction shows a confirmation dialog listing every marked resource.
+func (b *Browser) confirmBulkAction(action *ui.ResourceAction, resourceIDs []string, region string, client aws.Connection) {
 	b.mx.RLock()
 	app := b.app
 	b.mx.RUnlock()
@@ -642,35 +1625,218 @@ func (b *Browser) doExecuteAction(action *ui.ResourceAction, resourceID, region
 		return
 	}
 
-	app.Flash().Infof("%s %s...", action.Name, resourceID)
-
-	// Execute in goroutine to not block UI
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
-		defer cancel()
+	// Resolve each item's region up front, rows may come from different regions
+	regions := make([]string, len(resourceIDs))
+	for i, id := range resourceIDs {
+		regions[i] = region
+		if r := b.GetField(id, "REGION"); r != "" {
+			regions[i] = r
+		}
+	}
 
-		err := action.Handler(ctx, client, region, resourceID)
+	msg := fmt.Sprintf("%s %d resources?\n\n%s", action.Name, len(resourceIDs), strings.Join(resourceIDs, "\n"))
 
-		// Update UI on main thread
-		app.QueueUpdateDraw(func() {
-			if err != nil {
-				app.Flash().Errf("%s failed: %v", action.Name, err)
-			} else {
-				app.Flash().Infof("%s %s successful", action.Name, resourceID)
-				// Refresh the view
-				b.refresh(nil)
-			}
-		})
-	}()
+	confirm := ui.NewConfirm(app.Content)
+	confirm.SetMessage(msg)
+	confirm.SetDangerous(true)
+	confirm.SetConfirmationText(strconv.Itoa(len(resourceIDs)))
+	confirm.SetOnConfirm(func() {
+		b.doExecuteBulkAction(action, resourceIDs, regions, client)
+	})
+	confirm.Show()
 }
 
-// prepareContext creates a context with cancellation for data fetching.
-func (b *Browser) prepareContext() context.Context {
-	ctx := b.defaultContext()
+// confirmDelete asks the user to type name before deleting path through the
+// view's DAO. done runs on the UI thread once the delete succeeds.
+func (b *Browser) confirmDelete(kind, name, path string, done func()) {
+	b.mx.RLock()
+	app := b.app
+	factory := b.factory
+	b.mx.RUnlock()
 
-	b.mx.Lock()
-	if b.cancelFn != nil {
-		b.cancelFn()
+	if app == nil || factory == nil {
+		return
+	}
+
+	accessor, err := dao.AccessorFor(factory, b.GetResourceID())
+	if err != nil {
+		app.Flash().Errf("Failed to get accessor: %v", err)
+		return
+	}
+	nuker, ok := accessor.(dao.Nuker)
+	if !ok {
+		app.Flash().Errf("%s does not support delete", kind)
+		return
+	}
+
+	confirm := ui.NewConfirm(app.Content)
+	confirm.SetMessage(fmt.Sprintf("Delete %s '%s'?\n\nThis action cannot be undone!", kind, name))
+	confirm.SetDangerous(true)
+	confirm.SetConfirmationText(name)
+	confirm.SetOnConfirm(func() {
+		dryRun := app.DryRun()
+		app.Flash().Infof("%sDeleting %s %s...", dryRunPrefix(dryRun), kind, name)
+
+		go func() {
+			ctx, cancel := context.WithTimeout(dryRunContext(context.Background(), dryRun), timeoutFor(app, config.TimeoutAction))
+			defer cancel()
+
+			err := nuker.Delete(ctx, path, false)
+			app.QueueUpdateDraw(func() {
+				switch {
+				case err != nil:
+					app.Flash().Errf("Delete %s failed: %v", name, err)
+				case dryRun:
+					app.Flash().Infof("Dry run: delete %s would succeed", name)
+				default:
+					app.Flash().Infof("Deleted %s %s", kind, name)
+					if done != nil {
+						done()
+					}
+				}
+			})
+		}()
+	})
+	confirm.Show()
+}
+
+// doExecuteBulkAction runs an action against each resource in turn,
+// flashing per-item results followed by a summary.
+func (b *Browser) doExecuteBulkAction(action *ui.ResourceAction, resourceIDs, regions []string, client aws.Connection) {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return
+	}
+
+	dryRun := app.DryRun()
+	app.Flash().Infof("%s%s %d resources...", dryRunPrefix(dryRun), action.Name, len(resourceIDs))
+
+	// Execute in goroutine to not block UI
+	go func() {
+		var failed int
+		for i, id := range resourceIDs {
+			ctx, cancel := context.WithTimeout(dryRunContext(context.Background(), dryRun), timeoutFor(app, config.TimeoutAction))
+			err := action.Handler(ctx, client, regions[i], id)
+			cancel()
+
+			if err != nil {
+				failed++
+			}
+			resourceID, region := id, regions[i]
+			app.QueueUpdateDraw(func() {
+				switch {
+				case err != nil:
+					app.Flash().Errf("%s %s failed: %v", action.Name, resourceID, err)
+				case dryRun:
+					app.Flash().Infof("Dry run: %s %s would succeed", action.Name, resourceID)
+				default:
+					app.Flash().Infof("%s %s successful", action.Name, resourceID)
+					b.waitForState(action, resourceID, region, client)
+				}
+			})
+		}
+
+		app.QueueUpdateDraw(func() {
+			if failed > 0 {
+				app.Flash().Errf("%s%s: %d succeeded, %d failed", dryRunPrefix(dryRun), action.Name, len(resourceIDs)-failed, failed)
+			} else {
+				app.Flash().Infof("%s%s: %d succeeded", dryRunPrefix(dryRun), action.Name, len(resourceIDs))
+			}
+			b.ClearMarks()
+			b.refresh(nil)
+		})
+	}()
+}
+
+// doExecuteAction performs the actual action execution.
+func (b *Browser) doExecuteAction(action *ui.ResourceAction, resourceID, region string, client aws.Connection) {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return
+	}
+
+	dryRun := app.DryRun()
+	app.Flash().Infof("%s%s %s...", dryRunPrefix(dryRun), action.Name, resourceID)
+
+	// Execute in goroutine to not block UI
+	go func() {
+		ctx, cancel := context.WithTimeout(dryRunContext(context.Background(), dryRun), timeoutFor(app, config.TimeoutAction))
+		defer cancel()
+
+		err := action.Handler(ctx, client, region, resourceID)
+
+		// Update UI on main thread
+		app.QueueUpdateDraw(func() {
+			switch {
+			case err != nil:
+				app.Flash().Errf("%s failed: %v", action.Name, err)
+			case dryRun:
+				app.Flash().Infof("Dry run: %s %s would succeed", action.Name, resourceID)
+			default:
+				app.Flash().Infof("%s %s successful", action.Name, resourceID)
+				// Refresh the view
+				b.refresh(nil)
+				b.waitForState(action, resourceID, region, client)
+			}
+		})
+	}()
+}
+
+// dryRunPrefix labels progress messages of rehearsed operations.
+func dryRunPrefix(dryRun bool) string {
+	if dryRun {
+		return "Dry run: "
+	}
+	return ""
+}
+
+// waitForState waits in the background for a resource to settle after an
+// action, then flashes the outcome and refreshes. The immediate refresh has
+// already shown the transitional state, e.g. pending or stopping.
+func (b *Browser) waitForState(action *ui.ResourceAction, resourceID, region string, client aws.Connection) {
+	if action.Wait == nil {
+		return
+	}
+
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), aws.DefaultWaiterTimeout)
+		defer cancel()
+
+		err := action.Wait(ctx, client, region, resourceID)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Warnf("%s did not reach %s: %v", resourceID, action.WaitState, err)
+				return
+			}
+			app.Flash().Infof("%s is %s", resourceID, action.WaitState)
+			if b.HasFocus() {
+				b.refresh(nil)
+			}
+		})
+	}()
+}
+
+// prepareContext creates a context with cancellation for data fetching.
+func (b *Browser) prepareContext() context.Context {
+	ctx := b.defaultContext()
+
+	b.mx.Lock()
+	if b.cancelFn != nil {
+		b.cancelFn()
 	}
 	ctx, b.cancelFn = context.WithCancel(ctx)
 	b.mx.Unlock()
@@ -678,6 +1844,21 @@ func (b *Browser) prepareContext() context.Context {
 	return ctx
 }
 
+// nextGen starts a new load generation, superseding all earlier loads.
+func (b *Browser) nextGen() uint64 {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.gen++
+	return b.gen
+}
+
+// isCurrentGen reports whether gen is still the latest load generation.
+func (b *Browser) isCurrentGen(gen uint64) bool {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+	return b.gen == gen
+}
+
 // defaultContext builds the default context with resource ID and region.
 func (b *Browser) defaultContext() context.Context {
 	ctx := context.Background()
@@ -712,7 +1893,6 @@ func (b *Browser) describe(*tcell.EventKey) *tcell.EventKey {
 	pushFn := b.pushFn
 	popFn := b.popFn
 	factory := b.factory
-	region := b.region
 	app := b.app
 	b.mx.RUnlock()
 
@@ -725,21 +1905,7 @@ func (b *Browser) describe(*tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	// Get region for path - regional resources need region/id format
-	if region == "" && factory != nil {
-		region = factory.Region()
-	}
-	if region == "" {
-		region = aws.DefaultRegion
-	}
-
-	// Build path based on resource type
-	// Regional resources (EC2, VPC, EKS) use region/id format
-	// Global resources (IAM, S3) just use the id/name
-	path := resourceID
-	if rid.Service == "ec2" || rid.Service == "vpc" || rid.Service == "eks" {
-		path = region + "/" + resourceID
-	}
+	path, _ := b.selectedPath()
 
 	// Create describe view
 	descView := NewDescribe(rid)
@@ -751,6 +1917,7 @@ func (b *Browser) describe(*tcell.EventKey) *tcell.EventKey {
 			popFn()
 		}
 	})
+	descView.SetPushFn(pushFn)
 
 	ctx := context.Background()
 	if err := descView.Init(ctx); err != nil {
@@ -779,7 +1946,6 @@ func (b *Browser) edit(*tcell.EventKey) *tcell.EventKey {
 	b.mx.RLock()
 	app := b.app
 	factory := b.factory
-	region := b.region
 	b.mx.RUnlock()
 
 	// Check if we have app access for flash messages
@@ -787,15 +1953,14 @@ func (b *Browser) edit(*tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	// Check if resource type is supported by Cloud Control
-	_, ok := dao.GetCloudFormationType(rid)
-	if !ok {
-		app.Flash().Errf("Edit not supported for %s", rid.String())
+	if factory == nil {
+		app.Flash().Err(fmt.Errorf("factory not initialized"))
 		return nil
 	}
 
-	if factory == nil {
-		app.Flash().Err(fmt.Errorf("factory not initialized"))
+	// Check if resource type is supported by Cloud Control or its DAO
+	if !editSupported(factory, rid) {
+		app.Flash().Errf("Edit not supported for %s", rid.String())
 		return nil
 	}
 
@@ -805,55 +1970,289 @@ func (b *Browser) edit(*tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	// Get region - prefer the model's namespace (actual region of displayed data)
-	if model := b.GetModel(); model != nil {
-		if ns := model.GetNamespace(); ns != "" && ns != "*" && ns != "all" {
-			region = ns
+	path, region := b.selectedPath()
+
+	// Show info flash
+	app.Flash().Infof("Opening editor for %s...", resourceID)
+
+	// Call EditResource from editor module
+	ctx := context.Background()
+	EditResource(ctx, app, factory, rid, path, region, func(err error) {
+		if err != nil {
+			if errors.Is(err, ErrEditorCancelled) {
+				app.Flash().Info("Edit cancelled")
+			} else if errors.Is(err, ErrNoChanges) {
+				app.Flash().Info("No changes detected")
+			} else {
+				app.Flash().Errf("Edit failed: %v", err)
+			}
+			return
 		}
+
+		// Success
+		app.Flash().Infof("Successfully updated %s", resourceID)
+
+		// Refresh the view to show updated data
+		b.refresh(nil)
+	})
+
+	return nil
+}
+
+// editTags opens the selected resource's tags in the external editor.
+func (b *Browser) editTags(*tcell.EventKey) *tcell.EventKey {
+	resourceID := b.GetSelectedItem()
+	if resourceID == "" {
+		return nil
 	}
-	if region == "" {
-		region = factory.Region()
+
+	rid := b.GetResourceID()
+	if rid == nil {
+		return nil
 	}
-	if region == "" {
-		region = aws.DefaultRegion
+
+	b.mx.RLock()
+	app := b.app
+	factory := b.factory
+	accessor := b.accessor
+	b.mx.RUnlock()
+
+	if app == nil {
+		return nil
 	}
 
-	// Build path (same logic as describe)
-	path := resourceID
-	if rid.Service == "ec2" || rid.Service == "vpc" || rid.Service == "eks" {
-		path = region + "/" + resourceID
+	if accessor == nil {
+		if factory == nil {
+			app.Flash().Err(fmt.Errorf("factory not initialized"))
+			return nil
+		}
+		acc, err := dao.AccessorFor(factory, rid)
+		if err != nil {
+			app.Flash().Errf("Tagging not supported for %s", rid.String())
+			return nil
+		}
+		accessor = acc
 	}
 
-	// Show info flash
-	app.Flash().Infof("Opening editor for %s...", resourceID)
+	taggable, ok := accessor.(dao.Taggable)
+	if !ok {
+		app.Flash().Errf("Tagging not supported for %s", rid.String())
+		return nil
+	}
 
-	// Call EditResource from editor module
-	ctx := context.Background()
-	err := EditResource(ctx, app.Application, client, rid, path, region)
+	path, _ := b.selectedPath()
 
-	if err != nil {
-		if err == ErrEditorCancelled {
-			app.Flash().Info("Edit cancelled")
-		} else if err == ErrNoChanges {
-			app.Flash().Info("No changes detected")
-		} else {
-			app.Flash().Errf("Edit failed: %v", err)
+	EditTags(context.Background(), app, taggable, rid, path, func(err error) {
+		if err != nil {
+			if errors.Is(err, ErrEditorCancelled) {
+				app.Flash().Info("Edit cancelled")
+			} else if errors.Is(err, ErrNoChanges) {
+				app.Flash().Info("No changes detected")
+			} else {
+				app.Flash().Errf("Tag update failed: %v", err)
+			}
+			return
 		}
+
+		app.Flash().Infof("Updated tags on %s", resourceID)
+		b.refresh(nil)
+	})
+
+	return nil
+}
+
+// copyID copies the selected resource ID to the clipboard.
+func (b *Browser) copyID(*tcell.EventKey) *tcell.EventKey {
+	if resourceID := b.GetSelectedItem(); resourceID != "" {
+		b.copyToClipboard(resourceID)
+	}
+	return nil
+}
+
+// copyARN fetches the selected resource and copies its ARN to the clipboard.
+func (b *Browser) copyARN(*tcell.EventKey) *tcell.EventKey {
+	resourceID := b.GetSelectedItem()
+	if resourceID == "" {
 		return nil
 	}
 
-	// Success
-	app.Flash().Infof("Successfully updated %s", resourceID)
+	b.mx.RLock()
+	app := b.app
+	accessor := b.accessor
+	factory := b.factory
+	b.mx.RUnlock()
+
+	if app == nil {
+		return nil
+	}
 
-	// Refresh the view to show updated data
-	b.refresh(nil)
+	if accessor == nil {
+		if factory == nil {
+			app.Flash().Err(fmt.Errorf("factory not initialized"))
+			return nil
+		}
+		acc, err := dao.AccessorFor(factory, b.GetResourceID())
+		if err != nil {
+			app.Flash().Errf("Failed to get accessor: %v", err)
+			return nil
+		}
+		accessor = acc
+	}
+
+	path, _ := b.selectedPath()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutGet))
+		defer cancel()
+
+		obj, err := accessor.Get(ctx, path)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Errf("Failed to fetch %s: %v", resourceID, err)
+				return
+			}
+			if obj.GetARN() == "" {
+				app.Flash().Warnf("No ARN available for %s", resourceID)
+				return
+			}
+			b.copyToClipboard(obj.GetARN())
+		})
+	}()
+
+	return nil
+}
+
+// openConsole opens the selected resource in the AWS Management Console.
+func (b *Browser) openConsole(*tcell.EventKey) *tcell.EventKey {
+	resourceID := b.GetSelectedItem()
+	rid := b.GetResourceID()
+	if resourceID == "" || rid == nil {
+		return nil
+	}
+
+	b.mx.RLock()
+	app := b.app
+	factory := b.factory
+	b.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return nil
+	}
+
+	var accountID string
+	if client := factory.Client(); client != nil {
+		accountID = client.AccountID()
+	}
+
+	// IAM policy rows are keyed by policy ID, the console links by name
+	id := resourceID
+	if rid.String() == "iam/policy" {
+		if name := b.GetSelectedField("NAME"); name != "" {
+			id = name
+		}
+	}
+
+	_, region := b.selectedPath()
+	url, err := aws.ConsoleURL(rid.Service, rid.Resource, region, id, accountID)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
 
+	b.openURL(url)
 	return nil
 }
 
+// openURL opens url in the OS browser, flashing the URL when that is not possible.
+func (b *Browser) openURL(url string) {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return
+	}
+
+	if err := ui.OpenURL(url); err != nil {
+		app.Flash().Warnf("Open in browser: %s", url)
+		return
+	}
+	app.Flash().Infof("Opened %s", truncate(url, 60))
+}
+
+// copyToClipboard copies text to the clipboard and flashes the result.
+func (b *Browser) copyToClipboard(text string) {
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app == nil {
+		return
+	}
+
+	if err := ui.CopyToClipboard(text); err != nil {
+		app.Flash().Errf("Copy failed: %v", err)
+		return
+	}
+	app.Flash().Infof("Copied %s", truncate(text, 60))
+}
+
+// truncate shortens s to at most n runes, marking the cut with an ellipsis.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
+
+// selectedPath returns the DAO path and region of the selected resource.
+// Regional resources (EC2, VPC, EKS, SSM) use region/id format, global ones just the id.
+func (b *Browser) selectedPath() (path, region string) {
+	resourceID := b.GetSelectedItem()
+	rid := b.GetResourceID()
+	if resourceID == "" || rid == nil {
+		return "", ""
+	}
+
+	b.mx.RLock()
+	factory := b.factory
+	region = b.region
+	b.mx.RUnlock()
+
+	// Prefer the model's namespace (actual region of displayed data)
+	if model := b.GetModel(); model != nil {
+		if ns := model.GetNamespace(); ns != "" && ns != "*" && ns != "all" {
+			region = ns
+		}
+	}
+	if r := b.selectedRegion(); r != "" {
+		region = r
+	}
+	if (region == "" || region == aws.RegionAll) && factory != nil {
+		region = factory.Region()
+	}
+	if region == "" || region == aws.RegionAll {
+		region = aws.DefaultRegion
+	}
+
+	path = resourceID
+	if rid.Service == "ec2" || rid.Service == "vpc" || rid.Service == "eks" || rid.Service == "ssm" {
+		path = region + "/" + resourceID
+	}
+
+	return path, region
+}
+
 // changeRegion prompts for region change.
 func (b *Browser) changeRegion(*tcell.EventKey) *tcell.EventKey {
-	// TODO: Implement region picker dialog
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app != nil && app.command != nil {
+		app.command.regionPicker()
+	}
 	return nil
 }
 
@@ -884,12 +2283,57 @@ func (b *Browser) TableDataChanged(mdata *model1.TableData) {
 }
 
 // TableLoadFailed notifies view something went wrong.
+// The table shows the friendly message in place of a stuck loading state,
+// while the flash carries the full error.
 func (b *Browser) TableLoadFailed(err error) {
-	// TODO: Show error via App.Flash() when available
+	if err == nil {
+		return
+	}
+
+	b.mx.RLock()
+	app := b.app
+	b.mx.RUnlock()
+
+	if app != nil {
+		app.Flash().Err(err)
+	}
+
+	rid := b.GetResourceID()
+	if rid == nil {
+		return
+	}
+	data := model1.NewTableData()
+	data.SetNamespace(b.GetRegion())
+	data.SetHeader(b.displayHeader(rid))
+	data.SetError(b.friendlyError(err, rid))
+	b.UpdateUI(data)
 }
 
 // friendlyError converts AWS errors to user-friendly messages.
+// AWS API errors are classified by error code; other errors such as DNS
+// failures fall back to matching the error text.
 func (b *Browser) friendlyError(err error, rid *dao.ResourceID) string {
+	if errors.Is(err, aws.ErrSSOLoginRequired) || aws.IsSSOTokenError(err) {
+		return "SSO login required or token expired (run aws sso login)"
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch aws.ClassifyErrorCode(apiErr.ErrorCode()) {
+		case aws.ErrKindAccessDenied:
+			return fmt.Sprintf("Access denied for %s (%s)", rid.String(), apiErr.ErrorCode())
+		case aws.ErrKindExpiredToken:
+			return "AWS credentials invalid or expired"
+		case aws.ErrKindThrottling:
+			return fmt.Sprintf("AWS is throttling %s requests, try again shortly", rid.String())
+		case aws.ErrKindNotFound:
+			return fmt.Sprintf("%s not found (%s)", rid.String(), apiErr.ErrorCode())
+		}
+	}
+	if errors.Is(err, aws.ErrExpiredCredentials) || errors.Is(err, aws.ErrNoCredentials) {
+		return "AWS credentials invalid or expired"
+	}
+
 	errStr := err.Error()
 
 	// Check for common permission/access errors
@@ -900,6 +2344,13 @@ func (b *Browser) friendlyError(err error, rid *dao.ResourceID) string {
 		return fmt.Sprintf("Access denied for %s", rid.String())
 	}
 
+	// Check for missing credentials
+	if errors.Is(err, aws.ErrNoProfiles) ||
+		strings.Contains(errStr, "failed to retrieve credentials") ||
+		strings.Contains(errStr, "get credentials") {
+		return "No AWS credentials found (run aws configure, then :reconnect)"
+	}
+
 	// Check for credential errors
 	if strings.Contains(errStr, "NoCredentialProviders") ||
 		strings.Contains(errStr, "ExpiredToken") ||
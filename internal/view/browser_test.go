@@ -0,0 +1,28 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
+)
+
+func TestBrowserGetFieldFallsBackToBaseRow(t *testing.T) {
+	b := NewBrowser(&dao.EC2VolumeRID)
+	b.show(&listing{
+		data:   model1.NewTableData(),
+		base:   model1.Header{{Name: "ID"}, {Name: "STATE"}},
+		rows:   map[string]model1.Fields{"vol-1": {"vol-1", "in-use"}},
+		region: "us-east-1",
+	})
+
+	if got := b.GetField("vol-1", "STATE"); got != "in-use" {
+		t.Errorf("GetField(STATE) = %q, want %q", got, "in-use")
+	}
+	if got := b.GetField("vol-2", "STATE"); got != "" {
+		t.Errorf("GetField for unknown row = %q, want empty", got)
+	}
+	if got := b.GetField("vol-1", "SIZE"); got != "" {
+		t.Errorf("GetField for unknown column = %q, want empty", got)
+	}
+}
@@ -6,8 +6,13 @@ package view
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
 	"github.com/a1s/a1s/internal/dao"
 	"github.com/a1s/a1s/internal/ui"
 )
@@ -22,24 +27,88 @@ var defaultAliases = map[string]string{
 	"iam":  "iam/user",
 	"role": "iam/role",
 	"eks":  "eks/cluster",
+	"ng":   "eks/nodegroup",
 	"vol":  "ec2/volume",
+	"ssm":  "ssm/instance",
+
+	"subnet":         "vpc/subnet",
+	"policy":         "iam/policy",
+	"instances":      "ec2/instance",
+	"volumes":        "ec2/volume",
+	"buckets":        "s3/bucket",
+	"vpcs":           "vpc/vpc",
+	"subnets":        "vpc/subnet",
+	"securitygroups": "vpc/securitygroup",
+	"users":          "iam/user",
+	"roles":          "iam/role",
+	"policies":       "iam/policy",
+	"clusters":       "eks/cluster",
+	"nodegroups":     "eks/nodegroup",
+	"managed":        "ssm/instance",
 }
 
 // awsCommands defines valid AWS service commands.
 var awsCommands = map[string]bool{
-	"ec2":     true,
-	"s3":      true,
-	"vpc":     true,
-	"iam":     true,
-	"eks":     true,
-	"profile": true,
-	"region":  true,
+	"ec2":         true,
+	"s3":          true,
+	"vpc":         true,
+	"iam":         true,
+	"eks":         true,
+	"ssm":         true,
+	"profile":     true,
+	"region":      true,
+	"refresh":     true,
+	"alias":       true,
+	"timeout":     true,
+	"reconnect":   true,
+	"whoami":      true,
+	"dry-run":     true,
+	"ssh-bastion": true,
+	"goto":        true,
+	"overview":    true,
+	"split":       true,
 }
 
+// idPrefixes maps resource ID prefixes to the resources they identify.
+var idPrefixes = []struct {
+	prefix, rid string
+}{
+	{"i-", "ec2/instance"},
+	{"vol-", "ec2/volume"},
+	{"sg-", "vpc/securitygroup"},
+	{"vpc-", "vpc/vpc"},
+	{"subnet-", "vpc/subnet"},
+	{"mi-", "ssm/instance"},
+}
+
+// arnResources maps an ARN's service and resource type, e.g.
+// "ec2:instance" for arn:aws:ec2:...:instance/i-0abc, to its resource.
+var arnResources = map[string]string{
+	"ec2:instance":         "ec2/instance",
+	"ec2:volume":           "ec2/volume",
+	"ec2:security-group":   "vpc/securitygroup",
+	"ec2:vpc":              "vpc/vpc",
+	"ec2:subnet":           "vpc/subnet",
+	"iam:user":             "iam/user",
+	"iam:role":             "iam/role",
+	"iam:policy":           "iam/policy",
+	"eks:cluster":          "eks/cluster",
+	"eks:nodegroup":        "eks/nodegroup",
+	"ssm:managed-instance": "ssm/instance",
+}
+
+// nameResources are tried in order for a bare name, which may be a bucket,
+// a role or a user.
+var nameResources = []string{"s3/bucket", "iam/role", "iam/user"}
+
+// maxAliasDepth bounds alias-to-alias resolution to guard against cycles.
+const maxAliasDepth = 5
+
 // Command handles user command interpretation and execution.
 type Command struct {
-	app     *App
-	aliases map[string]string
+	app         *App
+	aliases     map[string]string
+	userAliases *config.Aliases
 }
 
 // NewCommand creates a new command interpreter.
@@ -55,9 +124,29 @@ func (c *Command) Init() error {
 	for k, v := range defaultAliases {
 		c.aliases[k] = v
 	}
+
+	// User aliases override the defaults
+	c.userAliases = &config.Aliases{Alias: make(map[string]string)}
+	if err := c.userAliases.LoadFrom(config.AppAliasesFile); err != nil {
+		c.app.Flash().Warnf("Failed to load aliases: %v", err)
+	}
+	for k, v := range c.userAliases.All() {
+		c.aliases[k] = v
+	}
+
+	c.app.cmdBar.AddCommands(append(c.aliasNames(), "alias", "refresh", "timeout", "reconnect", "whoami", "dry-run", "ssh-bastion", "goto", "overview", "split"))
 	return nil
 }
 
+// aliasNames returns all alias names.
+func (c *Command) aliasNames() []string {
+	names := make([]string, 0, len(c.aliases))
+	for name := range c.aliases {
+		names = append(names, name)
+	}
+	return names
+}
+
 // Run parses and executes a command.
 func (c *Command) Run(cmd string) error {
 	if cmd == "" {
@@ -86,20 +175,73 @@ func (c *Command) Run(cmd string) error {
 
 	case "region":
 		if len(args) == 0 {
-			// TODO: Show region picker view
-			return fmt.Errorf("region command requires a region name")
+			c.regionPicker()
+			return nil
 		}
 		return c.regionCmd(args[0])
 
+	case "alias":
+		return c.aliasCmd(args)
+
+	case "refresh":
+		if len(args) == 0 {
+			c.app.Flash().Infof("Refresh interval: %s", c.app.RefreshRate())
+			return nil
+		}
+		return c.refreshCmd(args[0])
+
+	case "timeout":
+		return c.timeoutCmd(args)
+
+	case "reconnect":
+		c.app.Reconnect()
+		return nil
+
+	case "whoami":
+		return c.whoamiView()
+
+	case "dry-run":
+		return c.dryRunCmd(args)
+
+	case "ssh-bastion":
+		return c.sshBastionCmd(args)
+
+	case "goto":
+		return c.gotoCmd(args)
+
+	case "overview":
+		return c.overviewView()
+
+	case "split":
+		return c.splitCmd(args)
+
 	default:
-		// Assume it's a resource command
-		return c.resourceCmd(cmdName)
+		// Assume it's a resource command, remaining args are list filters
+		filters, err := parseFilters(args)
+		if err != nil {
+			return err
+		}
+		return c.resourceCmd(cmdName, filters)
 	}
 }
 
-// defaultCmd executes the default command (EC2 instances).
+// defaultCmd executes the default command, the resource overview.
 func (c *Command) defaultCmd() error {
-	return c.resourceCmd("ec2/instance")
+	return c.overviewView()
+}
+
+// overviewView shows resource counts for the active region.
+func (c *Command) overviewView() error {
+	view := NewOverview(c.app)
+	if err := view.Init(context.Background()); err != nil {
+		return fmt.Errorf("failed to initialize overview: %w", err)
+	}
+
+	c.app.Content.Push("overview", view)
+	c.app.SetFocus(view)
+	view.Start()
+
+	return nil
 }
 
 // profileView shows the profile switcher view.
@@ -120,6 +262,20 @@ func (c *Command) profileView() error {
 	return nil
 }
 
+// whoamiView shows the active role chain and verified caller identity.
+func (c *Command) whoamiView() error {
+	view := NewWhoAmI(c.app)
+	if err := view.Init(context.Background()); err != nil {
+		return fmt.Errorf("failed to initialize whoami view: %w", err)
+	}
+
+	c.app.Content.Push("whoami", view)
+	c.app.SetFocus(view)
+	view.Start()
+
+	return nil
+}
+
 // profileCmd switches the AWS profile.
 func (c *Command) profileCmd(profile string) error {
 	if err := c.app.SwitchProfile(profile); err != nil {
@@ -134,13 +290,21 @@ func (c *Command) profileCmd(profile string) error {
 	return nil
 }
 
-// regionCmd switches the AWS region.
+// regionCmd switches the AWS region; "all" lists regional resources
+// across every enabled region.
 func (c *Command) regionCmd(region string) error {
+	if err := aws.CheckRegion(region); err != nil {
+		return err
+	}
 	if err := c.app.SwitchRegion(region); err != nil {
 		return err
 	}
 
-	c.app.Flash().Infof("Switched to region: %s", region)
+	if region == aws.RegionAll {
+		c.app.Flash().Info("Showing all regions")
+	} else {
+		c.app.Flash().Infof("Switched to region: %s", region)
+	}
 
 	// Refresh current view to load resources from new region
 	c.app.RefreshCurrentView()
@@ -148,8 +312,363 @@ func (c *Command) regionCmd(region string) error {
 	return nil
 }
 
-// resourceCmd navigates to a resource view.
-func (c *Command) resourceCmd(rid string) error {
+// regionPicker lets the user choose a region from a drop-down.
+func (c *Command) regionPicker() {
+	current := ""
+	if f := c.app.GetFactory(); f != nil {
+		current = f.Region()
+	}
+
+	const label = "Region"
+	dialog := ui.NewFormDialog(c.app.Content, "Switch Region")
+	dialog.AddDropDownSelected(label, append([]string{aws.RegionAll}, aws.KnownRegions()...), current)
+	dialog.SetOnSubmit(func(values map[string]string) {
+		if region := values[label]; region != current {
+			if err := c.regionCmd(region); err != nil {
+				c.app.Flash().Err(err)
+			}
+		}
+	})
+	dialog.Show()
+}
+
+// refreshCmd changes the auto-refresh interval (e.g. "10s", "1m", or plain seconds).
+func (c *Command) refreshCmd(interval string) error {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		secs, convErr := strconv.ParseFloat(interval, 64)
+		if convErr != nil {
+			return fmt.Errorf("invalid refresh interval %q", interval)
+		}
+		d = time.Duration(secs * float64(time.Second))
+	}
+
+	if err := c.app.SetRefreshRate(d); err != nil {
+		return err
+	}
+
+	c.app.Flash().Infof("Refresh interval set to %s", d)
+	return nil
+}
+
+// timeoutCmd shows the operation timeouts, or overrides one for this session
+// (e.g. "timeout list 60s").
+func (c *Command) timeoutCmd(args []string) error {
+	kinds := config.TimeoutKinds()
+	if len(args) == 0 {
+		parts := make([]string, 0, len(kinds))
+		for _, kind := range kinds {
+			parts = append(parts, fmt.Sprintf("%s=%s", kind, c.app.Timeout(kind)))
+		}
+		c.app.Flash().Infof("Timeouts: %s", strings.Join(parts, " "))
+		return nil
+	}
+	if len(args) != 2 {
+		return fmt.Errorf("usage: timeout <%s> <duration>", strings.Join(kinds, "|"))
+	}
+
+	d, err := time.ParseDuration(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid timeout %q", args[1])
+	}
+	if err := c.app.SetTimeout(args[0], d); err != nil {
+		return err
+	}
+
+	c.app.Flash().Infof("%s timeout set to %s", args[0], d)
+	return nil
+}
+
+// dryRunCmd shows dry-run mode, or turns it "on" or "off".
+func (c *Command) dryRunCmd(args []string) error {
+	if len(args) == 0 {
+		if c.app.DryRun() {
+			c.app.Flash().Info("Dry run: on")
+		} else {
+			c.app.Flash().Info("Dry run: off")
+		}
+		return nil
+	}
+
+	switch args[0] {
+	case "on":
+		c.app.SetDryRun(true)
+		c.app.Flash().Warn("Dry run on: destructive operations are only rehearsed")
+	case "off":
+		c.app.SetDryRun(false)
+		c.app.Flash().Info("Dry run off")
+	default:
+		return fmt.Errorf("usage: dry-run [on|off]")
+	}
+	return nil
+}
+
+// sshBastionCmd shows the SSH bastion, sets it to a [user@]host[:port], or
+// turns it "off".
+func (c *Command) sshBastionCmd(args []string) error {
+	if len(args) == 0 {
+		if host := c.app.SSHBastion(); host != "" {
+			c.app.Flash().Infof("SSH bastion: %s", host)
+		} else {
+			c.app.Flash().Info("SSH bastion: off")
+		}
+		return nil
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("usage: ssh-bastion [host|off]")
+	}
+
+	if args[0] == "off" {
+		c.app.SetSSHBastion("")
+		c.app.Flash().Info("SSH bastion off")
+		return nil
+	}
+	c.app.SetSSHBastion(args[0])
+	c.app.Flash().Infof("Private instances are reached through %s", args[0])
+	return nil
+}
+
+// gotoTarget is a resource a goto argument may refer to.
+type gotoTarget struct {
+	rid  string // e.g. "ec2/instance"
+	path string // accessor path, e.g. "us-east-1/i-0abc"
+}
+
+// gotoCmd opens the describe view of a resource given its ID, ARN or name,
+// e.g. "goto i-0abc", without listing its resource type first.
+func (c *Command) gotoCmd(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: goto <id|arn|name>")
+	}
+
+	factory := c.app.GetFactory()
+	if factory == nil || factory.Client() == nil {
+		return aws.ErrNoConnection
+	}
+	region := factory.Region()
+	if region == "" || region == aws.RegionAll {
+		region = aws.DefaultRegion
+	}
+
+	targets, err := gotoTargets(args[0], region)
+	if err != nil {
+		return err
+	}
+
+	c.app.Flash().Infof("Looking up %s...", args[0])
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(c.app, config.TimeoutGet))
+		defer cancel()
+
+		target, err := findGotoTarget(ctx, factory, targets)
+		c.app.QueueUpdateDraw(func() {
+			if err != nil {
+				c.app.Flash().Errf("Cannot go to %s: %v", args[0], err)
+				return
+			}
+			c.describeView(factory, target)
+		})
+	}()
+	return nil
+}
+
+// gotoTargets infers the resources id may refer to: an ARN or a prefixed
+// ID names exactly one, a bare name one of nameResources. Regional IDs
+// are looked up in region unless the ARN names another.
+func gotoTargets(id, region string) ([]gotoTarget, error) {
+	if strings.HasPrefix(id, "arn:") {
+		target, err := arnTarget(id, region)
+		if err != nil {
+			return nil, err
+		}
+		return []gotoTarget{target}, nil
+	}
+
+	for _, p := range idPrefixes {
+		if hex, ok := strings.CutPrefix(id, p.prefix); ok && isHexID(hex) {
+			return []gotoTarget{{rid: p.rid, path: region + "/" + id}}, nil
+		}
+	}
+
+	if strings.ContainsAny(id, "/:") {
+		return nil, fmt.Errorf("unrecognized resource ID %q, expected an ID such as i-0abc, an ARN or a name", id)
+	}
+	targets := make([]gotoTarget, len(nameResources))
+	for i, rid := range nameResources {
+		targets[i] = gotoTarget{rid: rid, path: id}
+	}
+	return targets, nil
+}
+
+// isHexID reports whether s is the hex part of an AWS resource ID, 8 or 17
+// lowercase hex digits, so names such as "i-logs" are not taken for IDs.
+func isHexID(s string) bool {
+	if len(s) != 8 && len(s) != 17 {
+		return false
+	}
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// arnTarget maps an ARN, arn:partition:service:region:account:resource,
+// to the resource it names.
+func arnTarget(arn, region string) (gotoTarget, error) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[5] == "" {
+		return gotoTarget{}, fmt.Errorf("malformed ARN %q", arn)
+	}
+	service, resource := parts[2], parts[5]
+	if parts[3] != "" {
+		region = parts[3]
+	}
+
+	// S3 ARNs carry no region or account, just the bucket and object key
+	if service == "s3" {
+		if strings.Contains(resource, "/") {
+			return gotoTarget{}, fmt.Errorf("S3 object ARNs are not supported, use the bucket ARN")
+		}
+		return gotoTarget{rid: "s3/bucket", path: resource}, nil
+	}
+
+	kind, name, _ := strings.Cut(resource, "/")
+	rid, ok := arnResources[service+":"+kind]
+	if !ok || name == "" {
+		return gotoTarget{}, fmt.Errorf("unsupported ARN resource %s:%s", service, kind)
+	}
+
+	switch rid {
+	case "iam/policy":
+		return gotoTarget{rid: rid, path: arn}, nil
+	case "iam/user", "iam/role":
+		// Drop the IAM path, e.g. role/service-role/name
+		return gotoTarget{rid: rid, path: name[strings.LastIndex(name, "/")+1:]}, nil
+	case "eks/nodegroup":
+		// nodegroup/cluster/name/uuid
+		segs := strings.Split(name, "/")
+		if len(segs) < 2 {
+			return gotoTarget{}, fmt.Errorf("malformed node group ARN %q", arn)
+		}
+		return gotoTarget{rid: rid, path: region + "/" + segs[0] + "/" + segs[1]}, nil
+	default:
+		return gotoTarget{rid: rid, path: region + "/" + name}, nil
+	}
+}
+
+// findGotoTarget returns the first target that exists.
+func findGotoTarget(ctx context.Context, factory dao.Factory, targets []gotoTarget) (gotoTarget, error) {
+	var errs []error
+	for _, t := range targets {
+		service, resource, _ := strings.Cut(t.rid, "/")
+		acc, err := dao.AccessorFor(factory, &dao.ResourceID{Service: service, Resource: resource})
+		if err != nil {
+			return gotoTarget{}, err
+		}
+		if _, err := acc.Get(ctx, t.path); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return t, nil
+	}
+
+	if len(targets) == 1 {
+		return gotoTarget{}, errs[0]
+	}
+	return gotoTarget{}, fmt.Errorf("no S3 bucket, IAM role or IAM user named %q", targets[0].path)
+}
+
+// describeView pushes the describe view of a resource.
+func (c *Command) describeView(factory dao.Factory, target gotoTarget) {
+	service, resource, _ := strings.Cut(target.rid, "/")
+	view := NewDescribe(&dao.ResourceID{Service: service, Resource: resource})
+	view.SetFactory(factory)
+	view.SetPath(target.path)
+	view.SetApp(c.app)
+	view.SetBackFn(func() {
+		c.app.Content.Pop()
+	})
+	view.SetPushFn(func(name string, comp ui.Component) {
+		c.app.Content.Push(name, comp)
+		c.app.SetFocus(comp)
+	})
+	if err := view.Init(context.Background()); err != nil {
+		c.app.Flash().Errf("Failed to open %s: %v", target.path, err)
+		return
+	}
+
+	c.app.Content.Push("describe", view)
+	c.app.SetFocus(view)
+	view.Start()
+}
+
+// splitCmd opens a resource view for another profile beside the main one,
+// e.g. "split prod ec2". The resource defaults to the main view's. Without
+// arguments it closes the split pane.
+func (c *Command) splitCmd(args []string) error {
+	if len(args) == 0 {
+		if !c.app.CloseSplit() {
+			return fmt.Errorf("usage: split <profile> [resource]")
+		}
+		c.app.Flash().Info("Split closed")
+		return nil
+	}
+	if len(args) > 2 {
+		return fmt.Errorf("usage: split <profile> [resource]")
+	}
+
+	factory := c.app.GetFactory()
+	if factory == nil || factory.Client() == nil {
+		return aws.ErrNoConnection
+	}
+
+	rid := "ec2/instance"
+	if current, ok := c.app.Content.CurrentPage().(interface{ GetResourceID() *dao.ResourceID }); ok {
+		if id := current.GetResourceID(); id != nil && awsCommands[id.Service] {
+			rid = id.String()
+		}
+	}
+	if len(args) == 2 {
+		rid = c.resolveAlias(args[1])
+	}
+	service, resourceType, _ := strings.Cut(rid, "/")
+	if !awsCommands[service] || resourceType == "" {
+		return fmt.Errorf("unknown resource: %s", rid)
+	}
+
+	client, err := factory.Client().ForProfile(args[0])
+	if err != nil {
+		return err
+	}
+	splitFactory := dao.NewFactory(client)
+
+	view, browser := resourceView(rid, service, resourceType)
+	browser.SetApp(c.app)
+	browser.SetFactory(splitFactory)
+	browser.SetPushFn(func(name string, comp ui.Component) {
+		if pages := c.app.SplitPages(); pages != nil {
+			pages.Push(name, comp)
+			c.app.SetFocus(comp)
+		}
+	})
+	browser.SetPopFn(func() {
+		if pages := c.app.SplitPages(); pages != nil {
+			pages.Pop()
+		}
+	})
+	if err := view.Init(context.Background()); err != nil {
+		return fmt.Errorf("failed to initialize view: %w", err)
+	}
+
+	c.app.OpenSplit(splitFactory, rid, view)
+	c.app.Flash().Infof("Split: %s as %s (<ctrl-w> switches pane)", rid, args[0])
+	return nil
+}
+
+// resourceCmd navigates to a resource view, optionally with server-side list filters.
+func (c *Command) resourceCmd(rid string, filters map[string]string) error {
 	// Parse resource ID (e.g., "ec2/instance")
 	parts := strings.Split(rid, "/")
 	if len(parts) < 1 {
@@ -171,30 +690,18 @@ func (c *Command) resourceCmd(rid string) error {
 		return fmt.Errorf("resource type required for service: %s", service)
 	}
 
-	// Create specialized view based on resource type
-	var view ui.Component
-	var browser *Browser
-	switch rid {
-	case "ec2/instance":
-		ec2View := NewEC2Instance()
-		browser = ec2View.Browser
-		view = ec2View
-	case "s3/bucket":
-		s3View := NewS3Browser()
-		browser = s3View.Browser
-		view = s3View
-	case "vpc/securitygroup":
-		sgView := NewSecurityGroup()
-		browser = sgView.Browser
-		view = sgView
-	default:
-		// Fall back to generic browser
-		resourceID := &dao.ResourceID{
-			Service:  service,
-			Resource: resourceType,
+	view, browser := resourceView(rid, service, resourceType)
+
+	// Filters are applied server-side, so the DAO must support them
+	if len(filters) > 0 {
+		acc, err := dao.AccessorFor(c.app.GetFactory(), browser.GetResourceID())
+		if err != nil {
+			return err
 		}
-		browser = NewBrowser(resourceID)
-		view = browser
+		if _, ok := acc.(dao.FilteredLister); !ok {
+			return fmt.Errorf("filters not supported for %s", rid)
+		}
+		browser.SetListFilters(filters)
 	}
 
 	// Set factory and navigation functions on browser
@@ -219,7 +726,11 @@ func (c *Command) resourceCmd(rid string) error {
 		return fmt.Errorf("failed to initialize view: %w", err)
 	}
 
-	c.app.Flash().Infof("Navigating to %s...", rid)
+	if len(filters) > 0 {
+		c.app.Flash().Infof("Navigating to %s (%d filters)...", rid, len(filters))
+	} else {
+		c.app.Flash().Infof("Navigating to %s...", rid)
+	}
 	c.app.Content.Push(rid, view)
 
 	// Set focus to the view so keyboard navigation works
@@ -231,6 +742,83 @@ func (c *Command) resourceCmd(rid string) error {
 	return nil
 }
 
+// resourceView creates the view for a resource type: a specialized view
+// where one exists, otherwise a generic browser.
+func resourceView(rid, service, resourceType string) (ui.Component, *Browser) {
+	var view ui.Component
+	var browser *Browser
+	switch rid {
+	case "ec2/instance":
+		ec2View := NewEC2Instance()
+		browser = ec2View.Browser
+		view = ec2View
+	case "ec2/volume":
+		volumeView := NewEC2Volume()
+		browser = volumeView.Browser
+		view = volumeView
+	case "s3/bucket":
+		s3View := NewS3Browser()
+		browser = s3View.Browser
+		view = s3View
+	case "iam/user":
+		userView := NewIAMUser()
+		browser = userView.Browser
+		view = userView
+	case "iam/role":
+		roleView := NewIAMRole()
+		browser = roleView.Browser
+		view = roleView
+	case "eks/cluster":
+		clusterView := NewEKSCluster()
+		browser = clusterView.Browser
+		view = clusterView
+	case "eks/nodegroup":
+		ngView := NewEKSNodeGroup()
+		browser = ngView.Browser
+		view = ngView
+	case "vpc/securitygroup":
+		sgView := NewSecurityGroup()
+		browser = sgView.Browser
+		view = sgView
+	default:
+		// Fall back to generic browser
+		resourceID := &dao.ResourceID{
+			Service:  service,
+			Resource: resourceType,
+		}
+		browser = NewBrowser(resourceID)
+		view = browser
+	}
+
+	return view, browser
+}
+
+// parseFilters parses "name=value" command arguments into list filters,
+// e.g. "tag:Env=prod" or "instance-state-name=running,stopped". A bare
+// argument is a resource name prefix, e.g. ":eks prod-".
+func parseFilters(args []string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	filters := make(map[string]string, len(args))
+	for _, arg := range args {
+		if !strings.Contains(arg, "=") {
+			if _, ok := filters[dao.NameFilter]; ok {
+				return nil, fmt.Errorf("only one name prefix allowed, got %q", arg)
+			}
+			filters[dao.NameFilter] = arg
+			continue
+		}
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("invalid filter %q (expected name=value)", arg)
+		}
+		filters[name] = value
+	}
+	return filters, nil
+}
+
 // parseCommand parses a command string into command name and arguments.
 func (c *Command) parseCommand(cmd string) (string, []string) {
 	parts := strings.Fields(cmd)
@@ -244,10 +832,83 @@ func (c *Command) parseCommand(cmd string) (string, []string) {
 	return cmdName, args
 }
 
-// resolveAlias resolves a command alias to its full form.
+// resolveAlias resolves a command alias to its full form, following
+// aliases that point at other aliases.
 func (c *Command) resolveAlias(cmd string) string {
-	if alias, ok := c.aliases[cmd]; ok {
-		return alias
+	for i := 0; i < maxAliasDepth; i++ {
+		alias, ok := c.aliases[cmd]
+		if !ok || alias == cmd {
+			break
+		}
+		cmd = alias
 	}
 	return cmd
 }
+
+// aliasCmd lists, adds or removes user aliases.
+// Usage: alias | alias add <name> <target> | alias rm <name>
+func (c *Command) aliasCmd(args []string) error {
+	if len(args) == 0 {
+		names := c.aliasNames()
+		sort.Strings(names)
+		pairs := make([]string, 0, len(names))
+		for _, name := range names {
+			pairs = append(pairs, name+"→"+c.aliases[name])
+		}
+		c.app.Flash().Info(strings.Join(pairs, " "))
+		return nil
+	}
+
+	switch {
+	case args[0] == "add" && len(args) == 3:
+		name, target := args[1], args[2]
+		if _, ok := c.aliases[name]; !ok && awsCommands[name] {
+			return fmt.Errorf("%s is a built-in command", name)
+		}
+		if err := c.validateTarget(target); err != nil {
+			return err
+		}
+		c.userAliases.Set(name, target)
+		c.aliases[name] = target
+		c.app.cmdBar.AddCommands([]string{name})
+		if err := c.userAliases.SaveTo(config.AppAliasesFile); err != nil {
+			return fmt.Errorf("alias added but not saved: %w", err)
+		}
+		c.app.Flash().Infof("Alias %s → %s", name, target)
+		return nil
+
+	case args[0] == "rm" && len(args) == 2:
+		name := args[1]
+		if _, ok := c.userAliases.All()[name]; !ok {
+			return fmt.Errorf("no user alias %q", name)
+		}
+		c.userAliases.Delete(name)
+		delete(c.aliases, name)
+		if def, ok := defaultAliases[name]; ok {
+			c.aliases[name] = def
+		} else if !awsCommands[name] {
+			c.app.cmdBar.RemoveCommands([]string{name})
+		}
+		if err := c.userAliases.SaveTo(config.AppAliasesFile); err != nil {
+			return fmt.Errorf("alias removed but not saved: %w", err)
+		}
+		c.app.Flash().Infof("Removed alias %s", name)
+		return nil
+	}
+
+	return fmt.Errorf("usage: alias [add <name> <target> | rm <name>]")
+}
+
+// validateTarget checks that an alias target resolves to a known command or resource.
+func (c *Command) validateTarget(target string) error {
+	resolved := c.resolveAlias(target)
+	if awsCommands[resolved] {
+		return nil
+	}
+
+	service, resource, _ := strings.Cut(resolved, "/")
+	if !awsCommands[service] || resource == "" {
+		return fmt.Errorf("unknown alias target: %s", target)
+	}
+	return nil
+}
@@ -9,11 +9,13 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
 	"github.com/a1s/a1s/internal/dao"
 	"github.com/a1s/a1s/internal/ui"
 	"github.com/derailed/tcell/v2"
@@ -21,6 +23,12 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// colorTagRx matches tview color tags such as [aqua::] or [-::].
+var colorTagRx = regexp.MustCompile(`\[[a-zA-Z0-9#:\-]*\]`)
+
+// describeFormats is the order in which the format key cycles through formats.
+var describeFormats = []string{"yaml", "json", "table"}
+
 // Describe displays detailed information about a specific AWS resource.
 type Describe struct {
 	*tview.TextView
@@ -30,10 +38,18 @@ type Describe struct {
 	path       string
 	format     string
 	rawData    interface{}
+	obj        dao.AWSObject
+	tags       map[string]string
 	actions    *ui.KeyActions
 	backFn     func()
+	pushFn     func(name string, c ui.Component)
 	wrapOn     bool
 	app        *App
+	content    string // static YAML shown instead of the fetched resource
+	verbose    bool   // keep empty fields that toCleanMap would drop
+	search     string
+	matches    []int // lines containing search hits
+	matchIdx   int
 }
 
 // NewDescribe creates a new resource detail view.
@@ -51,7 +67,7 @@ func NewDescribe(rid *dao.ResourceID) *Describe {
 	d.SetScrollable(true)
 	d.SetBorder(true)
 	d.SetBorderPadding(0, 0, 1, 1)
-	d.SetBorderColor(tcell.ColorAqua)
+	d.SetBorderColor(ui.CurrentTheme().Color(ui.CurrentTheme().Key))
 
 	return d
 }
@@ -94,11 +110,21 @@ func (d *Describe) SetPath(path string) {
 	d.updateTitle()
 }
 
+// SetContent shows the given YAML text instead of fetching the resource.
+func (d *Describe) SetContent(content string) {
+	d.content = content
+}
+
 // SetBackFn sets the callback for back navigation.
 func (d *Describe) SetBackFn(fn func()) {
 	d.backFn = fn
 }
 
+// SetPushFn sets the callback used to open related resources.
+func (d *Describe) SetPushFn(fn func(name string, c ui.Component)) {
+	d.pushFn = fn
+}
+
 // SetApp sets the application instance.
 func (d *Describe) SetApp(app *App) {
 	d.app = app
@@ -108,19 +134,24 @@ func (d *Describe) SetApp(app *App) {
 func (d *Describe) Refresh() {
 	d.Clear()
 
+	if d.content != "" {
+		d.display()
+		d.ScrollToBeginning()
+		return
+	}
+
 	if d.path == "" {
-		d.SetText("[red::]No resource selected[-::]")
+		d.SetText(ui.CurrentTheme().Paint(ui.CurrentTheme().Bad, "No resource selected"))
 		return
 	}
 
 	// Fetch resource data
 	if err := d.fetchData(); err != nil {
-		d.SetText(fmt.Sprintf("[red::]Error fetching resource: %v[-::]", err))
+		d.SetText(ui.CurrentTheme().Paint(ui.CurrentTheme().Bad, fmt.Sprintf("Error fetching resource: %v", err)))
 		return
 	}
 
-	d.SetText(d.generateContent())
-	d.updateTitle()
+	d.display()
 	d.ScrollToBeginning()
 }
 
@@ -135,15 +166,21 @@ func (d *Describe) fetchData() error {
 		return fmt.Errorf("no accessor for %s: %w", d.resourceID.String(), err)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(d.app, config.TimeoutGet))
 	defer cancel()
 
-	obj, err := accessor.Get(ctx, d.path)
+	get := accessor.Get
+	if ml, ok := accessor.(dao.BucketMetricsLister); ok {
+		get = ml.GetWithMetrics
+	}
+	obj, err := get(ctx, d.path)
 	if err != nil {
 		return err
 	}
 
+	d.obj = obj
 	d.rawData = obj.GetRaw()
+	d.tags = obj.GetTags()
 	return nil
 }
 
@@ -154,11 +191,104 @@ func (d *Describe) bindKeys() {
 	d.actions.Bulk(ui.KeyMap{
 		ui.KeyY:        ui.NewKeyAction("YAML", d.formatCmd("yaml"), true),
 		ui.KeyJ:        ui.NewKeyAction("JSON", d.formatCmd("json"), true),
+		ui.KeyT:        ui.NewKeyAction("Table", d.formatCmd("table"), true),
+		ui.KeyF:        ui.NewKeyAction("Next Format", d.cycleFormatCmd, true),
+		ui.KeyN:        ui.NewKeyAction("Next Match", d.nextMatchCmd(1), true),
+		ui.KeyShiftN:   ui.NewKeyAction("Prev Match", d.nextMatchCmd(-1), true),
 		ui.KeyW:        ui.NewKeyAction("Wrap", d.toggleWrap, true),
+		ui.KeyC:        ui.NewKeyAction("Copy", d.copyCmd, true),
+		ui.KeyV:        ui.NewKeyAction("Verbose", d.toggleVerbose, true),
 		ui.KeyE:        ui.NewKeyAction("Edit", d.edit, true),
 		tcell.KeyEsc:   ui.NewKeyAction("Back", d.backCmd, true),
 		ui.KeyQ:        ui.NewSharedKeyAction("Back", d.backCmd, false),
 	})
+
+	// Static content can only be viewed
+	if d.content != "" {
+		d.actions.Delete(ui.KeyY, ui.KeyJ, ui.KeyT, ui.KeyF, ui.KeyV, ui.KeyE)
+		return
+	}
+
+	if d.pushFn != nil && d.factory != nil {
+		if accessor, err := dao.AccessorFor(d.factory, d.resourceID); err == nil {
+			if _, ok := accessor.(dao.Relater); ok {
+				d.actions.Add(ui.KeyR, ui.NewKeyAction("Related", d.relatedCmd, true))
+			}
+		}
+	}
+}
+
+// relatedCmd opens a list of resources related to the described one.
+func (d *Describe) relatedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if d.app == nil || d.pushFn == nil {
+		return nil
+	}
+	if d.obj == nil {
+		d.app.Flash().Warn("Resource not loaded yet")
+		return nil
+	}
+
+	view := NewRelated(d.resourceID, d.path, d.obj)
+	view.SetApp(d.app)
+	view.SetFactory(d.factory)
+	view.SetPushFn(d.pushFn)
+	view.SetBackFn(d.backFn)
+	if err := view.Init(context.Background()); err != nil {
+		d.app.Flash().Errf("Failed to open related resources: %v", err)
+		return nil
+	}
+
+	d.pushFn("related", view)
+	view.Start()
+
+	return nil
+}
+
+// copyCmd copies the displayed content, without color markup, to the clipboard.
+func (d *Describe) copyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if d.app == nil {
+		return nil
+	}
+	if d.content == "" && d.rawData == nil {
+		d.app.Flash().Warn("Nothing to copy")
+		return nil
+	}
+
+	text := d.plainContent()
+	if err := ui.CopyToClipboard(text); err != nil {
+		d.app.Flash().Errf("Copy failed: %v", err)
+		return nil
+	}
+	d.app.Flash().Infof("Copied %s (%d bytes)", strings.ToUpper(d.format), len(text))
+
+	return nil
+}
+
+// plainContent returns the content in the current format without color tags.
+func (d *Describe) plainContent() string {
+	if d.content != "" {
+		return d.content
+	}
+
+	switch d.format {
+	case "json":
+		return d.generateJSON()
+	case "table":
+		return plainTable(d.cleanData())
+	default:
+		out, err := yaml.Marshal(d.cleanData())
+		if err != nil {
+			return fmt.Sprintf("# Error generating YAML: %v", err)
+		}
+		return string(out)
+	}
+}
+
+// toggleVerbose switches between terse output and output keeping empty fields.
+func (d *Describe) toggleVerbose(evt *tcell.EventKey) *tcell.EventKey {
+	d.verbose = !d.verbose
+	d.display()
+	return nil
 }
 
 // toggleWrap toggles word wrap on/off.
@@ -245,13 +375,24 @@ func (d *Describe) formatCmd(format string) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		d.format = format
 		d.Clear()
-		d.SetText(d.generateContent())
-		d.updateTitle()
+		d.display()
 		d.ScrollToBeginning()
 		return nil
 	}
 }
 
+// cycleFormatCmd switches to the next format in describeFormats.
+func (d *Describe) cycleFormatCmd(evt *tcell.EventKey) *tcell.EventKey {
+	next := describeFormats[0]
+	for i, f := range describeFormats {
+		if f == d.format {
+			next = describeFormats[(i+1)%len(describeFormats)]
+			break
+		}
+	}
+	return d.formatCmd(next)(evt)
+}
+
 // backCmd handles going back to the previous view.
 func (d *Describe) backCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if d.backFn != nil {
@@ -263,19 +404,130 @@ func (d *Describe) backCmd(evt *tcell.EventKey) *tcell.EventKey {
 // updateTitle updates the view title with current context.
 func (d *Describe) updateTitle() {
 	format := strings.ToUpper(d.format)
+	if d.verbose {
+		format += " VERBOSE"
+	}
 	title := fmt.Sprintf(" %s/%s [%s] ", d.resourceID.String(), d.path, format)
+	if d.search != "" {
+		pos := 0
+		if len(d.matches) > 0 {
+			pos = d.matchIdx + 1
+		}
+		title += fmt.Sprintf("</%s %d/%d> ", tview.Escape(d.search), pos, len(d.matches))
+	}
 	d.SetTitle(title)
 }
 
+// SetFilter searches the displayed text, highlighting matches and jumping to the first.
+// An empty search clears the highlights.
+func (d *Describe) SetFilter(search string) {
+	d.search = search
+	d.matchIdx = 0
+	d.display()
+	d.scrollToMatch()
+}
+
+// display renders the content, highlighting search matches.
+func (d *Describe) display() {
+	content := d.generateContent()
+	d.matches = nil
+	if d.search != "" {
+		content, d.matches = highlightMatches(content, d.search)
+	}
+	if d.matchIdx >= len(d.matches) {
+		d.matchIdx = 0
+	}
+
+	d.SetText(content)
+	d.updateTitle()
+}
+
+// nextMatchCmd returns a handler moving step matches forward (or back when negative).
+func (d *Describe) nextMatchCmd(step int) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if len(d.matches) == 0 {
+			return nil
+		}
+		d.matchIdx = (d.matchIdx + step + len(d.matches)) % len(d.matches)
+		d.updateTitle()
+		d.scrollToMatch()
+		return nil
+	}
+}
+
+// scrollToMatch scrolls the current match into view.
+func (d *Describe) scrollToMatch() {
+	if len(d.matches) == 0 {
+		return
+	}
+	d.ScrollTo(d.matches[d.matchIdx], 0)
+}
+
+// highlightMatches wraps case-insensitive occurrences of search in the visible
+// text with highlight markup, leaving color tags untouched.
+// It returns the highlighted content and the lines holding matches.
+func highlightMatches(content, search string) (string, []int) {
+	if search == "" {
+		return content, nil
+	}
+	rx := regexp.MustCompile("(?i)" + regexp.QuoteMeta(search))
+	lines := strings.Split(content, "\n")
+	var matched []int
+
+	for i, line := range lines {
+		var (
+			sb    strings.Builder
+			found bool
+			last  int
+		)
+		highlight := func(text string) {
+			var pos int
+			for _, loc := range rx.FindAllStringIndex(text, -1) {
+				found = true
+				sb.WriteString(text[pos:loc[0]])
+				sb.WriteString(searchMark(text[loc[0]:loc[1]]))
+				pos = loc[1]
+			}
+			sb.WriteString(text[pos:])
+		}
+		for _, loc := range colorTagRx.FindAllStringIndex(line, -1) {
+			highlight(line[last:loc[0]])
+			sb.WriteString(line[loc[0]:loc[1]])
+			last = loc[1]
+		}
+		highlight(line[last:])
+
+		if found {
+			lines[i] = sb.String()
+			matched = append(matched, i)
+		}
+	}
+
+	return strings.Join(lines, "\n"), matched
+}
+
+// searchMark highlights a search match, in reverse video when colors are off.
+func searchMark(text string) string {
+	if ui.NoColor() {
+		return "[::r]" + text + "[::-]"
+	}
+	return "[black:yellow:]" + text + "[-:-:]"
+}
+
 // generateContent generates the display content based on format.
 func (d *Describe) generateContent() string {
+	if d.content != "" {
+		return d.highlightYAML(d.content)
+	}
 	if d.rawData == nil {
-		return "[red::]No data available[-::]"
+		return ui.CurrentTheme().Paint(ui.CurrentTheme().Bad, "No data available")
 	}
 
 	switch d.format {
 	case "json":
 		return d.generateJSON()
+	case "table":
+		return d.generateTable()
 	default:
 		return d.generateYAML()
 	}
@@ -284,11 +536,11 @@ func (d *Describe) generateContent() string {
 // generateYAML generates YAML format output matching AWS CLI style with syntax highlighting.
 func (d *Describe) generateYAML() string {
 	// Convert to a clean map for YAML output
-	data := d.toCleanMap(d.rawData)
+	data := d.cleanData()
 
 	out, err := yaml.Marshal(data)
 	if err != nil {
-		return fmt.Sprintf("[red::]# Error generating YAML: %v[-::]", err)
+		return ui.CurrentTheme().Paint(ui.CurrentTheme().Bad, fmt.Sprintf("# Error generating YAML: %v", err))
 	}
 
 	// Apply syntax highlighting
@@ -297,6 +549,7 @@ func (d *Describe) generateYAML() string {
 
 // highlightYAML applies syntax highlighting to YAML content.
 func (d *Describe) highlightYAML(content string) string {
+	theme := ui.CurrentTheme()
 	var result strings.Builder
 	lines := strings.Split(content, "\n")
 
@@ -330,15 +583,14 @@ func (d *Describe) highlightYAML(content string) string {
 
 			actualKey := key[keyStart:]
 
-			// Color the key in teal/cyan, value based on type
-			// derailed/tview format: [fg:bg:attrs]text[-::-]
+			// Color the key with the theme's key color, value based on type
 			if value == "" || strings.TrimSpace(value) == "" {
 				// Key only (nested object starts)
-				result.WriteString(fmt.Sprintf("%s[aqua::]%s[-::]\n", indent, actualKey))
+				result.WriteString(fmt.Sprintf("%s%s\n", indent, theme.Paint(theme.Key, actualKey)))
 			} else {
 				// Key: value pair
 				coloredValue := d.colorizeValue(strings.TrimSpace(value))
-				result.WriteString(fmt.Sprintf("%s[aqua::]%s[-::] %s\n", indent, actualKey, coloredValue))
+				result.WriteString(fmt.Sprintf("%s%s %s\n", indent, theme.Paint(theme.Key, actualKey), coloredValue))
 			}
 		} else if strings.HasPrefix(strings.TrimSpace(line), "-") {
 			// List item without key
@@ -361,42 +613,42 @@ func (d *Describe) highlightYAML(content string) string {
 	return result.String()
 }
 
-// colorizeValue applies color based on value type.
-// Uses derailed/tview format: [fg:bg:attrs]text[-::-]
+// colorizeValue applies the theme color for the value's type.
 func (d *Describe) colorizeValue(value string) string {
+	theme := ui.CurrentTheme()
 	trimmed := strings.Trim(value, "\"'")
 
-	// Boolean values - green for true, red for false
+	// Boolean values
 	if trimmed == "true" || trimmed == "True" {
-		return "[green::]" + value + "[-::]"
+		return theme.Paint(theme.OK, value)
 	}
 	if trimmed == "false" || trimmed == "False" {
-		return "[red::]" + value + "[-::]"
+		return theme.Paint(theme.Bad, value)
 	}
 
-	// Numbers - purple/fuchsia
+	// Numbers
 	if _, err := fmt.Sscanf(trimmed, "%d", new(int)); err == nil {
-		return "[fuchsia::]" + value + "[-::]"
+		return theme.Paint(theme.Number, value)
 	}
 	if _, err := fmt.Sscanf(trimmed, "%f", new(float64)); err == nil {
-		return "[fuchsia::]" + value + "[-::]"
+		return theme.Paint(theme.Number, value)
 	}
 
-	// Null/nil - gray
+	// Null/nil
 	if trimmed == "null" || trimmed == "nil" || trimmed == "~" {
-		return "[gray::]" + value + "[-::]"
+		return theme.Paint(theme.Dim, value)
 	}
 
 	// Status values
 	lower := strings.ToLower(trimmed)
 	if lower == "running" || lower == "active" || lower == "available" || lower == "attached" || lower == "enabled" {
-		return "[green::]" + value + "[-::]"
+		return theme.Paint(theme.OK, value)
 	}
 	if lower == "stopped" || lower == "terminated" || lower == "failed" || lower == "error" || lower == "disabled" {
-		return "[red::]" + value + "[-::]"
+		return theme.Paint(theme.Bad, value)
 	}
 	if lower == "pending" || lower == "starting" || lower == "stopping" || lower == "updating" {
-		return "[yellow::]" + value + "[-::]"
+		return theme.Paint(theme.Pending, value)
 	}
 
 	// Default - no color change
@@ -405,7 +657,7 @@ func (d *Describe) colorizeValue(value string) string {
 
 // generateJSON generates JSON format output.
 func (d *Describe) generateJSON() string {
-	data := d.toCleanMap(d.rawData)
+	data := d.cleanData()
 
 	out, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
@@ -415,8 +667,129 @@ func (d *Describe) generateJSON() string {
 	return string(out)
 }
 
+// generateTable generates aligned key/value rows from the flattened clean data,
+// with nested keys dotted and list items indexed (e.g. Placement.AvailabilityZone).
+func (d *Describe) generateTable() string {
+	keys, rows, width := flatRows(d.cleanData())
+	if len(keys) == 0 {
+		return ui.CurrentTheme().Paint(ui.CurrentTheme().Dim, "No data")
+	}
+
+	var sb strings.Builder
+	for _, k := range keys {
+		pad := strings.Repeat(" ", width-len(k))
+		sb.WriteString(fmt.Sprintf("%s%s  %s\n", ui.CurrentTheme().Paint(ui.CurrentTheme().Key, tview.Escape(k)), pad, d.colorizeValue(tview.Escape(rows[k]))))
+	}
+	return sb.String()
+}
+
+// plainTable renders data as the table format without any markup, for copying.
+func plainTable(data interface{}) string {
+	keys, rows, width := flatRows(data)
+	if len(keys) == 0 {
+		return "No data\n"
+	}
+
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(fmt.Sprintf("%-*s  %s\n", width, k, rows[k]))
+	}
+	return sb.String()
+}
+
+// flatRows flattens data into sorted dotted key paths, their values and the
+// width of the longest key.
+func flatRows(data interface{}) (keys []string, rows map[string]string, width int) {
+	rows = make(map[string]string)
+	flattenClean("", data, rows)
+
+	keys = make([]string, 0, len(rows))
+	for k := range rows {
+		keys = append(keys, k)
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, rows, width
+}
+
+// flattenClean flattens a cleaned value into dotted key paths.
+func flattenClean(prefix string, v interface{}, out map[string]string) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if len(val) == 0 && prefix != "" {
+			out[prefix] = "{}"
+		}
+		for k, item := range val {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			flattenClean(key, item, out)
+		}
+	case map[string]string:
+		for k, item := range val {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			out[key] = item
+		}
+	case []interface{}:
+		if len(val) == 0 && prefix != "" {
+			out[prefix] = "[]"
+		}
+		for i, item := range val {
+			flattenClean(fmt.Sprintf("%s[%d]", prefix, i), item, out)
+		}
+	case nil:
+	default:
+		out[prefix] = fmt.Sprintf("%v", val)
+	}
+}
+
+// cleanData returns the cleaned raw data, adding tags that were fetched
+// separately from the raw object (e.g. S3 object tags).
+func (d *Describe) cleanData() interface{} {
+	data := d.toCleanMap(d.rawData)
+	bucket, _ := d.obj.(*dao.S3BucketObject)
+	if len(d.tags) == 0 && (bucket == nil || bucket.Metrics == nil) {
+		return data
+	}
+
+	m, ok := data.(map[string]interface{})
+	if !ok {
+		return data
+	}
+	if _, exists := m["Tags"]; !exists && len(d.tags) > 0 {
+		m["Tags"] = d.tags
+	}
+	if bucket != nil && bucket.Metrics != nil {
+		m["Storage"] = storageDetails(bucket.Metrics)
+	}
+	return m
+}
+
+// storageDetails describes bucket metrics with when and how they were
+// measured, since CloudWatch storage metrics are daily.
+func storageDetails(m *dao.BucketMetrics) map[string]interface{} {
+	source := "CloudWatch"
+	if m.Counted {
+		source = "listed"
+	}
+	return map[string]interface{}{
+		"Size":    formatBytes(m.SizeBytes),
+		"Objects": m.Objects,
+		"Source":  source,
+		"AsOf":    m.Timestamp.Format(time.RFC3339),
+	}
+}
+
 // toCleanMap converts AWS SDK structs to clean maps for serialization.
 // This handles AWS SDK's pointer-heavy types and produces clean output.
+// Unless verbose is on, empty strings, collections and unset booleans are dropped;
+// verbose keeps them as "", [], {} and false.
 func (d *Describe) toCleanMap(obj interface{}) interface{} {
 	if obj == nil {
 		return nil
@@ -451,24 +824,29 @@ func (d *Describe) toCleanMap(obj interface{}) interface{} {
 				continue
 			}
 
-			// Skip nil pointers
+			// Skip nil pointers; verbose shows unset booleans as false
 			if fieldVal.Kind() == reflect.Ptr && fieldVal.IsNil() {
-				continue
+				if !d.verbose || field.Type.Elem().Kind() != reflect.Bool {
+					continue
+				}
+				fieldVal = reflect.ValueOf(false)
 			}
 
-			// Skip empty slices
-			if fieldVal.Kind() == reflect.Slice && fieldVal.Len() == 0 {
-				continue
-			}
+			if !d.verbose {
+				// Skip empty slices
+				if fieldVal.Kind() == reflect.Slice && fieldVal.Len() == 0 {
+					continue
+				}
 
-			// Skip empty maps
-			if fieldVal.Kind() == reflect.Map && fieldVal.Len() == 0 {
-				continue
-			}
+				// Skip empty maps
+				if fieldVal.Kind() == reflect.Map && fieldVal.Len() == 0 {
+					continue
+				}
 
-			// Skip zero-value strings for cleaner output
-			if fieldVal.Kind() == reflect.String && fieldVal.String() == "" {
-				continue
+				// Skip zero-value strings for cleaner output
+				if fieldVal.Kind() == reflect.String && fieldVal.String() == "" {
+					continue
+				}
 			}
 
 			// Get field name (use JSON tag if available for AWS SDK compatibility)
@@ -486,20 +864,20 @@ func (d *Describe) toCleanMap(obj interface{}) interface{} {
 			}
 		}
 
-		if len(result) == 0 {
+		if len(result) == 0 && !d.verbose {
 			return nil
 		}
 		return result
 
 	case reflect.Slice:
-		var result []interface{}
+		result := []interface{}{}
 		for i := 0; i < val.Len(); i++ {
 			item := d.toCleanMap(val.Index(i).Interface())
 			if item != nil {
 				result = append(result, item)
 			}
 		}
-		if len(result) == 0 {
+		if len(result) == 0 && !d.verbose {
 			return nil
 		}
 		return result
@@ -514,7 +892,7 @@ func (d *Describe) toCleanMap(obj interface{}) interface{} {
 				result[key] = cleanVal
 			}
 		}
-		if len(result) == 0 {
+		if len(result) == 0 && !d.verbose {
 			return nil
 		}
 		// Sort keys for consistent output
@@ -522,7 +900,7 @@ func (d *Describe) toCleanMap(obj interface{}) interface{} {
 
 	case reflect.String:
 		s := val.String()
-		if s == "" {
+		if s == "" && !d.verbose {
 			return nil
 		}
 		return s
@@ -563,27 +941,25 @@ func (d *Describe) sortedMap(m map[string]interface{}) map[string]interface{} {
 	return result
 }
 
-// edit opens the resource for editing via Cloud Control API.
+// edit opens the resource for editing via Cloud Control API or its DAO.
 func (d *Describe) edit(evt *tcell.EventKey) *tcell.EventKey {
 	if d.resourceID == nil {
 		return nil
 	}
 
-	// Check if resource type is supported by Cloud Control
-	_, ok := dao.GetCloudFormationType(d.resourceID)
-	if !ok {
-		// Resource type not supported - silently ignore if no app
-		if d.app != nil {
-			d.app.Flash().Warnf("Edit not supported for %s", d.resourceID.String())
-		}
+	if d.app == nil {
 		return nil
 	}
 
 	// Validate dependencies
 	if d.factory == nil {
-		if d.app != nil {
-			d.app.Flash().Err(errors.New("no factory available"))
-		}
+		d.app.Flash().Err(errors.New("no factory available"))
+		return nil
+	}
+
+	// Check if resource type is supported by Cloud Control or its DAO
+	if !editSupported(d.factory, d.resourceID) {
+		d.app.Flash().Warnf("Edit not supported for %s", d.resourceID.String())
 		return nil
 	}
 
@@ -616,22 +992,22 @@ func (d *Describe) edit(evt *tcell.EventKey) *tcell.EventKey {
 
 	// Perform edit
 	ctx := context.Background()
-	err := EditResource(ctx, d.app.Application, client, d.resourceID, d.path, region)
-
-	if err != nil {
-		if errors.Is(err, ErrEditorCancelled) {
-			d.app.Flash().Info("Edit cancelled")
-		} else if errors.Is(err, ErrNoChanges) {
-			d.app.Flash().Info("No changes detected")
-		} else {
-			d.app.Flash().Errf("Edit failed: %v", err)
+	EditResource(ctx, d.app, d.factory, d.resourceID, d.path, region, func(err error) {
+		if err != nil {
+			if errors.Is(err, ErrEditorCancelled) {
+				d.app.Flash().Info("Edit cancelled")
+			} else if errors.Is(err, ErrNoChanges) {
+				d.app.Flash().Info("No changes detected")
+			} else {
+				d.app.Flash().Errf("Edit failed: %v", err)
+			}
+			return
 		}
-		return nil
-	}
 
-	// Success - refresh the view
-	d.app.Flash().Info("Resource updated successfully")
-	d.Refresh()
+		// Success - refresh the view
+		d.app.Flash().Info("Resource updated successfully")
+		d.Refresh()
+	})
 
 	return nil
 }
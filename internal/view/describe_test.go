@@ -0,0 +1,83 @@
+package view
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHighlightMatches(t *testing.T) {
+	tests := map[string]struct {
+		content, search string
+		want            string
+		lines           []int
+	}{
+		"ascii": {
+			content: "name: web\nid: i-1\nWebHook: on",
+			search:  "web",
+			want:    "name: " + searchMark("web") + "\nid: i-1\n" + searchMark("Web") + "Hook: on",
+			lines:   []int{0, 2},
+		},
+		"skips color tags": {
+			content: "[aqua::]key[-::]: key",
+			search:  "key",
+			want:    "[aqua::]" + searchMark("key") + "[-::]: " + searchMark("key"),
+			lines:   []int{0},
+		},
+		"lowercase shrinks": {
+			content: "İstanbul: bucket",
+			search:  "bucket",
+			want:    "İstanbul: " + searchMark("bucket"),
+			lines:   []int{0},
+		},
+		"lowercase grows": {
+			content: "ȺȺȺ tag",
+			search:  "tag",
+			want:    "ȺȺȺ " + searchMark("tag"),
+			lines:   []int{0},
+		},
+		"non-ascii needle": {
+			content: "owner: Zoë ZOË",
+			search:  "zoë",
+			want:    "owner: " + searchMark("Zoë") + " " + searchMark("ZOË"),
+			lines:   []int{0},
+		},
+		"no match": {
+			content: "a: b",
+			search:  "zz",
+			want:    "a: b",
+		},
+		"empty search": {
+			content: "a: b",
+			want:    "a: b",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, lines := highlightMatches(tt.content, tt.search)
+			if got != tt.want {
+				t.Errorf("content:\n got %q\nwant %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(lines, tt.lines) {
+				t.Errorf("lines: got %v, want %v", lines, tt.lines)
+			}
+		})
+	}
+}
+
+func TestPlainTable(t *testing.T) {
+	data := map[string]interface{}{
+		"InstanceId": "i-1",
+		"SecurityGroups": []interface{}{
+			map[string]interface{}{"GroupId": "sg-1"},
+		},
+		"Tags": map[string]string{"Name": "[web]"},
+	}
+
+	want := "InstanceId                 i-1\n" +
+		"SecurityGroups[0].GroupId  sg-1\n" +
+		"Tags.Name                  [web]\n"
+	if got := plainTable(data); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -5,9 +5,13 @@ package view
 
 import (
 	"context"
-	"time"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
 	"github.com/a1s/a1s/internal/dao"
 	"github.com/a1s/a1s/internal/ui"
 	"github.com/derailed/tcell/v2"
@@ -51,6 +55,10 @@ func (e *EC2Instance) bindEC2Keys(aa *ui.KeyActions) {
 		ui.KeyC:      ui.NewKeyAction("Connect (SSH/SSM)", e.connectCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Setup SSM", e.setupSSMCmd, true),
 		ui.KeyL:      ui.NewKeyAction("View Logs", e.logsCmd, true),
+		ui.KeyM:      ui.NewKeyAction("Metrics", e.metricsCmd, true),
+		ui.KeyT:      ui.NewKeyAction("Change Type", e.changeTypeCmd, true),
+		ui.KeyP:      ui.NewKeyAction("Port Forward", e.portForwardCmd, true),
+		ui.KeyX:      ui.NewKeyAction("Run Command", e.runCommandCmd, true),
 	})
 }
 
@@ -64,25 +72,13 @@ func (e *EC2Instance) connectCmd(*tcell.EventKey) *tcell.EventKey {
 	e.mx.RLock()
 	app := e.app
 	factory := e.factory
-	region := e.region
 	e.mx.RUnlock()
 
 	if app == nil || factory == nil {
 		return nil
 	}
 
-	// Get region from model if available
-	if model := e.GetModel(); model != nil {
-		if ns := model.GetNamespace(); ns != "" && ns != "*" && ns != "all" {
-			region = ns
-		}
-	}
-	if region == "" {
-		region = factory.Region()
-	}
-	if region == "" {
-		region = aws.DefaultRegion
-	}
+	_, region := e.selectedPath()
 
 	// Show connection method dialog
 	// EC2IC (Instance Connect) is default - works without direct network access
@@ -154,7 +150,10 @@ func (e *EC2Instance) connectSSM(instanceID, region string) {
 	}
 }
 
-// connectSSH starts an SSH session to the instance.
+// connectSSH starts an SSH session to the instance. The login is detected
+// from the AMI, else taken from the ssh.user setting, else asked for.
+// Instances without a public IP are reached on their private IP through
+// the SSH bastion, when one is set.
 func (e *EC2Instance) connectSSH(instanceID, region string) {
 	e.mx.RLock()
 	app := e.app
@@ -177,28 +176,88 @@ func (e *EC2Instance) connectSSH(instanceID, region string) {
 		return
 	}
 
-	// Get public IP
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	app.Flash().Infof("Looking up %s...", instanceID)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutGet))
+		defer cancel()
 
-	publicIP, err := aws.GetInstancePublicIP(ctx, ec2Client, instanceID)
-	if err != nil {
-		app.Flash().Errf("Cannot SSH: %v", err)
-		return
-	}
+		bastion := app.SSHBastion()
+		host, err := aws.GetInstancePublicIP(ctx, ec2Client, instanceID)
+		if errors.Is(err, aws.ErrNoPublicIP) && bastion != "" {
+			host, err = aws.GetInstancePrivateIP(ctx, ec2Client, instanceID)
+		} else {
+			bastion = ""
+		}
+		var sshUser string
+		var detected bool
+		if err == nil {
+			sshUser, detected = aws.DetectSSHUser(ctx, ec2Client, instanceID)
+		}
 
-	// Detect SSH user
-	sshUser := aws.DetectSSHUser(ctx, ec2Client, instanceID)
+		app.QueueUpdateDraw(func() {
+			switch {
+			case errors.Is(err, aws.ErrNoPublicIP):
+				app.Flash().Errf("Cannot SSH: %s has no public IP and no bastion is configured, use EC2 Connect or SSM", instanceID)
+				return
+			case err != nil:
+				app.Flash().Errf("Cannot SSH: %v", err)
+				return
+			}
 
-	app.Flash().Infof("Connecting via SSH to %s@%s...", sshUser, publicIP)
+			if !detected {
+				sshUser = app.SSHUser()
+			}
+			if sshUser == "" {
+				e.promptSSHUser(instanceID, func(user string) {
+					e.execSSH(host, user, bastion)
+				})
+				return
+			}
+			e.execSSH(host, sshUser, bastion)
+		})
+	}()
+}
+
+// promptSSHUser asks for the SSH login of an instance whose AMI is not
+// recognized, then calls fn with it.
+func (e *EC2Instance) promptSSHUser(instanceID string, fn func(user string)) {
+	e.mx.RLock()
+	app := e.app
+	e.mx.RUnlock()
+
+	const label = "User"
+	dialog := ui.NewFormDialog(app.Content, "SSH Login for "+instanceID)
+	dialog.AddInputField(label, "ec2-user")
+	dialog.SetOnSubmit(func(values map[string]string) {
+		user := strings.TrimSpace(values[label])
+		if user == "" {
+			app.Flash().Warn("SSH user is required")
+			return
+		}
+		fn(user)
+	})
+	dialog.Show()
+}
+
+// execSSH suspends the TUI and runs ssh to user@host, jumping through
+// bastion unless it is empty.
+func (e *EC2Instance) execSSH(host, user, bastion string) {
+	e.mx.RLock()
+	app := e.app
+	e.mx.RUnlock()
+
+	if bastion != "" {
+		app.Flash().Infof("Connecting via SSH to %s@%s through %s...", user, host, bastion)
+	} else {
+		app.Flash().Infof("Connecting via SSH to %s@%s...", user, host)
+	}
 
-	// Build SSH config
 	cfg := aws.DefaultSSHConfig()
-	cfg.User = sshUser
+	cfg.User = user
+	cfg.ProxyJump = bastion
 
-	// Suspend TUI and run SSH
 	suspended := app.Suspend(func() {
-		if err := aws.ExecSSH(publicIP, cfg); err != nil {
+		if err := aws.ExecSSH(host, cfg); err != nil {
 			// Error will be shown after resume
 		}
 	})
@@ -208,6 +267,151 @@ func (e *EC2Instance) connectSSH(instanceID, region string) {
 	}
 }
 
+// portForwardCmd asks for ports and forwards a local port to the selected
+// instance over SSM until the session is interrupted.
+func (e *EC2Instance) portForwardCmd(*tcell.EventKey) *tcell.EventKey {
+	instanceID := e.GetSelectedItem()
+	if instanceID == "" {
+		return nil
+	}
+
+	e.mx.RLock()
+	app := e.app
+	e.mx.RUnlock()
+
+	if app == nil {
+		return nil
+	}
+
+	_, region := e.selectedPath()
+	if state := e.GetField(instanceID, "STATE"); state != "" && state != "running" {
+		app.Flash().Warnf("Cannot forward ports to %s instance %s", state, instanceID)
+		return nil
+	}
+
+	const (
+		remoteLabel = "Remote Port"
+		localLabel  = "Local Port"
+	)
+	dialog := ui.NewFormDialog(app.Content, "Port Forward to "+instanceID)
+	dialog.AddInputField(remoteLabel, "")
+	dialog.AddInputField(localLabel, "")
+	dialog.SetOnSubmit(func(values map[string]string) {
+		remote, err := parsePort(values[remoteLabel])
+		if err != nil {
+			app.Flash().Errf("Invalid remote port: %v", err)
+			return
+		}
+		local := remote
+		if s := strings.TrimSpace(values[localLabel]); s != "" {
+			if local, err = parsePort(s); err != nil {
+				app.Flash().Errf("Invalid local port: %v", err)
+				return
+			}
+		}
+		e.execPortForward(instanceID, region, remote, local)
+	})
+	dialog.Show()
+
+	return nil
+}
+
+// parsePort parses a TCP port number.
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if port < 1 || port > 65535 {
+		return 0, fmt.Errorf("%d is out of range", port)
+	}
+	return port, nil
+}
+
+// execPortForward suspends the TUI while the SSM port-forwarding session
+// runs, then reports how it ended.
+func (e *EC2Instance) execPortForward(instanceID, region string, remote, local int) {
+	e.mx.RLock()
+	app := e.app
+	e.mx.RUnlock()
+
+	var err error
+	suspended := app.Suspend(func() {
+		fmt.Printf("Forwarding localhost:%d to %s:%d, press Ctrl-C to stop\n", local, instanceID, remote)
+		err = aws.ExecSSMPortForward(instanceID, region, remote, local)
+	})
+
+	switch {
+	case !suspended:
+		app.Flash().Errf("Failed to suspend application for port forwarding")
+	case err != nil:
+		app.Flash().Errf("Port forward to %s ended: %v", instanceID, err)
+	default:
+		app.Flash().Infof("Port forward to %s:%d closed", instanceID, remote)
+	}
+}
+
+// runCommandCmd asks for a shell command and runs it through SSM on the
+// marked instances, or the selected one, showing the output in a new view.
+func (e *EC2Instance) runCommandCmd(*tcell.EventKey) *tcell.EventKey {
+	instanceID := e.GetSelectedItem()
+	if instanceID == "" {
+		return nil
+	}
+
+	e.mx.RLock()
+	app := e.app
+	factory := e.factory
+	pushFn := e.pushFn
+	e.mx.RUnlock()
+
+	if app == nil || factory == nil || pushFn == nil {
+		return nil
+	}
+
+	_, region := e.selectedPath()
+	ids := e.GetMarkedItems()
+	if len(ids) == 0 {
+		ids = []string{instanceID}
+	}
+	targets := make([]ssmTarget, len(ids))
+	for i, id := range ids {
+		targets[i] = ssmTarget{instanceID: id, region: region}
+		if r := e.GetField(id, "REGION"); r != "" {
+			targets[i].region = r
+		}
+	}
+
+	title := "Run Command on " + instanceID
+	if len(ids) > 1 {
+		title = fmt.Sprintf("Run Command on %d instances", len(ids))
+	}
+
+	const label = "Command"
+	dialog := ui.NewFormDialog(app.Content, title)
+	dialog.AddInputField(label, "")
+	dialog.SetOnSubmit(func(values map[string]string) {
+		command := strings.TrimSpace(values[label])
+		if command == "" {
+			app.Flash().Warn("Command is required")
+			return
+		}
+
+		view := NewSSMRun(command, targets, factory.Client())
+		view.SetApp(app)
+		if err := view.Init(context.Background()); err != nil {
+			app.Flash().Errf("Failed to run command: %v", err)
+			return
+		}
+		pushFn("run", view)
+		view.Start()
+		view.Run()
+	})
+	dialog.Show()
+
+	return nil
+}
+
 // logsCmd shows CloudWatch logs for the selected instance.
 func (e *EC2Instance) logsCmd(*tcell.EventKey) *tcell.EventKey {
 	path := e.GetSelectedItem()
@@ -218,8 +422,8 @@ func (e *EC2Instance) logsCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
-// setupSSMCmd enables SSM access on the selected instance.
-func (e *EC2Instance) setupSSMCmd(*tcell.EventKey) *tcell.EventKey {
+// metricsCmd shows CloudWatch sparklines for the selected instance.
+func (e *EC2Instance) metricsCmd(*tcell.EventKey) *tcell.EventKey {
 	instanceID := e.GetSelectedItem()
 	if instanceID == "" {
 		return nil
@@ -228,26 +432,45 @@ func (e *EC2Instance) setupSSMCmd(*tcell.EventKey) *tcell.EventKey {
 	e.mx.RLock()
 	app := e.app
 	factory := e.factory
-	region := e.region
+	pushFn := e.pushFn
 	e.mx.RUnlock()
 
-	if app == nil || factory == nil {
+	if app == nil || factory == nil || pushFn == nil {
 		return nil
 	}
 
-	// Get region from model if available
-	if model := e.GetModel(); model != nil {
-		if ns := model.GetNamespace(); ns != "" && ns != "*" && ns != "all" {
-			region = ns
-		}
+	_, region := e.selectedPath()
+	view := NewInstanceMetrics(instanceID, region, factory.Client())
+	view.SetApp(app)
+	if err := view.Init(context.Background()); err != nil {
+		app.Flash().Errf("Failed to open metrics: %v", err)
+		return nil
 	}
-	if region == "" {
-		region = factory.Region()
+
+	pushFn("metrics", view)
+	view.Start()
+
+	return nil
+}
+
+// setupSSMCmd enables SSM access on the selected instance.
+func (e *EC2Instance) setupSSMCmd(*tcell.EventKey) *tcell.EventKey {
+	instanceID := e.GetSelectedItem()
+	if instanceID == "" {
+		return nil
 	}
-	if region == "" {
-		region = aws.DefaultRegion
+
+	e.mx.RLock()
+	app := e.app
+	factory := e.factory
+	e.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return nil
 	}
 
+	_, region := e.selectedPath()
+
 	// Show confirmation dialog
 	confirm := ui.NewConfirm(app.Content)
 	confirm.SetMessage("Setup SSM access for " + instanceID + "?\n\nThis will attach an IAM role with SSM permissions.")
@@ -292,7 +515,7 @@ func (e *EC2Instance) doSetupSSM(instanceID, region string) {
 
 	// Run setup in background
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutAction))
 		defer cancel()
 
 		result, err := aws.SetupSSMAccess(ctx, ec2Client, iamClient, instanceID)
@@ -306,3 +529,192 @@ func (e *EC2Instance) doSetupSSM(instanceID, region string) {
 		})
 	}()
 }
+
+// instanceSizes are the sizes offered by the change type picker, within the
+// current type's family.
+var instanceSizes = []string{
+	"nano", "micro", "small", "medium", "large", "xlarge", "2xlarge", "4xlarge",
+	"8xlarge", "12xlarge", "16xlarge", "24xlarge",
+}
+
+// instanceTypeChoices returns the sizes of current's family, e.g. t3.nano to
+// t3.24xlarge for t3.micro, keeping current first if it is not listed.
+func instanceTypeChoices(current string) []string {
+	family, _, ok := strings.Cut(current, ".")
+	if !ok {
+		return []string{current}
+	}
+
+	choices := make([]string, 0, len(instanceSizes)+1)
+	var found bool
+	for _, size := range instanceSizes {
+		t := family + "." + size
+		found = found || t == current
+		choices = append(choices, t)
+	}
+	if !found {
+		choices = append([]string{current}, choices...)
+	}
+	return choices
+}
+
+// changeTypeCmd changes the instance type of the selected instance. The type
+// can only be changed while stopped, so a running instance is stopped first
+// once the user agrees.
+func (e *EC2Instance) changeTypeCmd(*tcell.EventKey) *tcell.EventKey {
+	instanceID := e.GetSelectedItem()
+	if instanceID == "" {
+		return nil
+	}
+
+	e.mx.RLock()
+	app := e.app
+	e.mx.RUnlock()
+
+	if app == nil {
+		return nil
+	}
+
+	_, region := e.selectedPath()
+	current := e.GetField(instanceID, "TYPE")
+	state := e.GetField(instanceID, "STATE")
+	if state == "terminated" || state == "shutting-down" {
+		app.Flash().Warnf("Cannot change type of %s instance %s", state, instanceID)
+		return nil
+	}
+
+	if state == "stopped" {
+		e.typePicker(instanceID, region, current, false, false)
+		return nil
+	}
+
+	confirm := ui.NewConfirm(app.Content)
+	confirm.SetMessage(fmt.Sprintf("Instance %s is %s.\n\nIts type can only be changed while stopped. Stop it now?", instanceID, state))
+	confirm.SetDangerous(true)
+	confirm.SetOnConfirm(func() {
+		e.typePicker(instanceID, region, current, true, state == "running")
+	})
+	confirm.Show()
+
+	return nil
+}
+
+// typePicker asks for the new instance type and whether to start the
+// instance afterwards, then applies the change.
+func (e *EC2Instance) typePicker(instanceID, region, current string, stop, restart bool) {
+	e.mx.RLock()
+	app := e.app
+	e.mx.RUnlock()
+
+	const (
+		typeLabel  = "Instance Type"
+		otherLabel = "Other Type"
+		startLabel = "Start After"
+	)
+	startDefault := "no"
+	if restart {
+		startDefault = "yes"
+	}
+
+	dialog := ui.NewFormDialog(app.Content, "Change Type of "+instanceID)
+	dialog.AddDropDownSelected(typeLabel, instanceTypeChoices(current), current)
+	dialog.AddInputField(otherLabel, "")
+	dialog.AddDropDownSelected(startLabel, []string{"yes", "no"}, startDefault)
+	dialog.SetOnSubmit(func(values map[string]string) {
+		newType := strings.TrimSpace(values[otherLabel])
+		if newType == "" {
+			newType = values[typeLabel]
+		}
+		if newType == "" || newType == current {
+			app.Flash().Warnf("Instance %s is already %s", instanceID, current)
+			return
+		}
+		e.doChangeType(instanceID, region, newType, stop, values[startLabel] == "yes")
+	})
+	dialog.Show()
+}
+
+// doChangeType stops the instance if asked, changes its type, optionally
+// starts it again and refreshes the view.
+func (e *EC2Instance) doChangeType(instanceID, region, newType string, stop, start bool) {
+	e.mx.RLock()
+	app := e.app
+	factory := e.factory
+	e.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return
+	}
+
+	ec2Client := factory.Client().EC2(region)
+	if ec2Client == nil {
+		app.Flash().Errf("Failed to get EC2 client")
+		return
+	}
+
+	acc, err := dao.AccessorFor(factory, &dao.EC2InstanceRID)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	instances, ok := acc.(*dao.EC2Instance)
+	if !ok {
+		app.Flash().Errf("Changing type not supported for %s", dao.EC2InstanceRID.String())
+		return
+	}
+
+	dryRun := app.DryRun()
+	prefix := dryRunPrefix(dryRun)
+	progress := func(format string, args ...interface{}) {
+		app.QueueUpdateDraw(func() {
+			app.Flash().Infof(prefix+format, args...)
+		})
+	}
+
+	go func() {
+		ctx := dryRunContext(context.Background(), dryRun)
+
+		err := func() error {
+			if stop {
+				progress("Stopping %s...", instanceID)
+				actx, cancel := context.WithTimeout(ctx, timeoutFor(app, config.TimeoutAction))
+				err := aws.StopInstance(actx, ec2Client, instanceID)
+				cancel()
+				if err != nil {
+					return err
+				}
+				// A dry run did not stop anything to wait for
+				if !dryRun {
+					if err := aws.WaitInstanceStopped(ctx, ec2Client, instanceID, aws.DefaultWaiterTimeout); err != nil {
+						return err
+					}
+				}
+			}
+
+			progress("Changing type of %s to %s...", instanceID, newType)
+			actx, cancel := context.WithTimeout(ctx, timeoutFor(app, config.TimeoutAction))
+			defer cancel()
+			if err := instances.ModifyInstanceType(actx, region, instanceID, newType); err != nil {
+				return err
+			}
+
+			if start {
+				progress("Starting %s...", instanceID)
+				return aws.StartInstance(actx, ec2Client, instanceID)
+			}
+			return nil
+		}()
+
+		app.QueueUpdateDraw(func() {
+			switch {
+			case err != nil:
+				app.Flash().Errf("Change type failed: %v", err)
+			case dryRun:
+				app.Flash().Infof("Dry run: changing type of %s to %s would succeed", instanceID, newType)
+			default:
+				app.Flash().Infof("Instance %s changed to %s", instanceID, newType)
+				e.refresh(nil)
+			}
+		})
+	}()
+}
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/render"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	// metricsWindow is how far back instance metrics reach.
+	metricsWindow = time.Hour
+	// metricsPeriod matches EC2 basic monitoring granularity.
+	metricsPeriod = 5 * time.Minute
+)
+
+// sparkBlocks are the sparkline glyphs, lowest to highest.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// instanceMetric describes one CloudWatch metric shown for an instance.
+type instanceMetric struct {
+	name   string
+	stat   string
+	format func(float64) string
+}
+
+var instanceMetrics = []instanceMetric{
+	{name: "CPUUtilization", stat: "Average", format: func(v float64) string {
+		return fmt.Sprintf("%.1f%%", v)
+	}},
+	{name: "NetworkIn", stat: "Sum", format: formatMetricBytes},
+	{name: "NetworkOut", stat: "Sum", format: formatMetricBytes},
+}
+
+// InstanceMetrics shows CloudWatch sparklines for an EC2 instance.
+type InstanceMetrics struct {
+	*tview.TextView
+
+	instanceID string
+	region     string
+	client     aws.Connection
+	app        *App
+	actions    *ui.KeyActions
+}
+
+// NewInstanceMetrics returns a metrics view for the given instance, read
+// with the client of the view the instance was picked from.
+func NewInstanceMetrics(instanceID, region string, client aws.Connection) *InstanceMetrics {
+	m := &InstanceMetrics{
+		TextView:   tview.NewTextView(),
+		instanceID: instanceID,
+		region:     region,
+		client:     client,
+		actions:    ui.NewKeyActions(),
+	}
+
+	m.SetDynamicColors(true)
+	m.SetWrap(false)
+	m.SetScrollable(true)
+	m.SetBorder(true)
+	m.SetBorderPadding(1, 1, 2, 2)
+	m.SetBorderColor(ui.CurrentTheme().Color(ui.CurrentTheme().Border))
+	m.SetTitle(fmt.Sprintf(" metrics/%s/%s [last %s] ", region, instanceID, render.HumanDuration(metricsWindow)))
+
+	return m
+}
+
+// Init initializes the metrics view.
+func (m *InstanceMetrics) Init(ctx context.Context) error {
+	m.actions.Bulk(ui.KeyMap{
+		ui.KeyR: ui.NewKeyAction("Refresh", m.refreshCmd, true),
+	})
+	m.SetInputCapture(m.keyboard)
+	return nil
+}
+
+// Start fetches the metrics in the background.
+func (m *InstanceMetrics) Start() {
+	if m.app == nil {
+		return
+	}
+	if m.client == nil {
+		m.SetText(ui.CurrentTheme().Paint(ui.CurrentTheme().Bad, "No AWS connection"))
+		return
+	}
+	client := m.client
+
+	m.SetText(ui.CurrentTheme().Paint(ui.CurrentTheme().Dim, "Loading metrics..."))
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(m.app, config.TimeoutGet))
+		defer cancel()
+
+		queries := make([]aws.MetricQuery, len(instanceMetrics))
+		for i, metric := range instanceMetrics {
+			queries[i] = aws.MetricQuery{
+				ID:         strings.ToLower(metric.name),
+				Namespace:  "AWS/EC2",
+				MetricName: metric.name,
+				Dimensions: map[string]string{"InstanceId": m.instanceID},
+				Stat:       metric.stat,
+				Period:     metricsPeriod,
+			}
+		}
+
+		end := time.Now()
+		series, err := client.GetMetricData(ctx, m.region, queries, end.Add(-metricsWindow), end)
+		m.app.QueueUpdateDraw(func() {
+			if err != nil {
+				m.SetText(ui.CurrentTheme().Paint(ui.CurrentTheme().Bad, tview.Escape(err.Error())))
+				m.app.Flash().Errf("Failed to load metrics: %v", err)
+				return
+			}
+			m.SetText(m.render(series))
+		})
+	}()
+}
+
+// Stop stops the metrics view.
+func (m *InstanceMetrics) Stop() {}
+
+// Name returns the component name for breadcrumbs.
+func (m *InstanceMetrics) Name() string {
+	return "metrics"
+}
+
+// Hints returns the menu hints for this view.
+func (m *InstanceMetrics) Hints() ui.MenuHints {
+	return m.actions.Hints()
+}
+
+// SetApp sets the application instance.
+func (m *InstanceMetrics) SetApp(app *App) {
+	m.app = app
+}
+
+// render formats one sparkline row per metric.
+func (m *InstanceMetrics) render(series []aws.MetricSeries) string {
+	theme := ui.CurrentTheme()
+	var b strings.Builder
+	for i, metric := range instanceMetrics {
+		var s aws.MetricSeries
+		if i < len(series) {
+			s = series[i]
+		}
+
+		fmt.Fprintf(&b, "[::b]%s[::-] ", theme.Paint(theme.Key, fmt.Sprintf("%-15s", metric.name)))
+		if len(s.Values) == 0 {
+			b.WriteString(theme.Paint(theme.Dim, "no data (is the instance running?)") + "\n\n")
+			continue
+		}
+
+		lo, hi := s.Values[0], s.Values[0]
+		for _, v := range s.Values {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+		last := s.Values[len(s.Values)-1]
+		fmt.Fprintf(&b, "%s  min %s  max %s  last %s\n\n",
+			theme.Paint(theme.OK, sparkline(s.Values, lo, hi)), theme.Paint(theme.Text, metric.format(lo)),
+			theme.Paint(theme.Text, metric.format(hi)), theme.Paint(theme.Text, metric.format(last)))
+	}
+	b.WriteString(theme.Paint(theme.Dim, fmt.Sprintf("%s per point, %s in %s", render.HumanDuration(metricsPeriod), m.instanceID, m.region)))
+	return b.String()
+}
+
+// sparkline maps values onto block glyphs scaled between lo and hi.
+func sparkline(values []float64, lo, hi float64) string {
+	out := make([]rune, len(values))
+	top := len(sparkBlocks) - 1
+	for i, v := range values {
+		idx := 0
+		if hi > lo {
+			idx = int(math.Round((v - lo) / (hi - lo) * float64(top)))
+		}
+		out[i] = sparkBlocks[idx]
+	}
+	return string(out)
+}
+
+// formatMetricBytes formats a byte count metric.
+func formatMetricBytes(v float64) string {
+	return render.FormatSize(int64(v))
+}
+
+// refreshCmd reloads the metrics.
+func (m *InstanceMetrics) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	m.Start()
+	return nil
+}
+
+// keyboard handles metrics view key events.
+func (m *InstanceMetrics) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+	if action, ok := m.actions.Get(key); ok {
+		return action.Action(evt)
+	}
+	return evt
+}
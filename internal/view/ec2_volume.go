@@ -0,0 +1,490 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/derailed/tcell/v2"
+)
+
+// Attach and snapshot form field labels.
+const (
+	attachInstanceLabel = "Instance ID"
+	attachDeviceLabel   = "Device"
+	snapshotDescLabel   = "Description"
+)
+
+// defaultVolumeDevice is the device name offered when attaching a volume.
+const defaultVolumeDevice = "/dev/sdf"
+
+// Modify volume form field labels.
+const (
+	volumeSizeLabel       = "Size (GiB)"
+	volumeTypeLabel       = "Type"
+	volumeIOPSLabel       = "IOPS"
+	volumeThroughputLabel = "Throughput (MiB/s)"
+)
+
+// volumeTypes are the EBS volume types offered by the modify form.
+var volumeTypes = []string{"gp3", "gp2", "io2", "io1", "st1", "sc1", "standard"}
+
+// modificationPollInterval is how often a volume modification is polled.
+const modificationPollInterval = 5 * time.Second
+
+// EC2Volume represents an EBS volume view with volume management actions.
+type EC2Volume struct {
+	*Browser
+}
+
+// NewEC2Volume returns a new EBS volume view.
+func NewEC2Volume() *EC2Volume {
+	return &EC2Volume{
+		Browser: NewBrowser(&dao.EC2VolumeRID),
+	}
+}
+
+// Init initializes the EBS volume view.
+func (v *EC2Volume) Init(ctx context.Context) error {
+	if err := v.Browser.Init(ctx); err != nil {
+		return err
+	}
+
+	v.bindVolumeKeys(v.Actions())
+	return nil
+}
+
+// Name returns the component name for breadcrumbs.
+func (v *EC2Volume) Name() string {
+	return "ec2-volume"
+}
+
+// bindVolumeKeys sets up volume-specific key bindings.
+func (v *EC2Volume) bindVolumeKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyM:      ui.NewKeyAction("Modify", v.modifyCmd, true),
+		ui.KeyA:      ui.NewKeyAction("Attach", v.attachCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Detach", v.detachCmd, true),
+		ui.KeyS:      ui.NewKeyAction("Snapshot", v.snapshotCmd, true),
+	})
+}
+
+// selectedVolume returns the ID and region of the selected volume.
+func (v *EC2Volume) selectedVolume() (volumeID, region string) {
+	id := v.GetSelectedItem()
+	if id == "" {
+		return "", ""
+	}
+
+	_, region = v.selectedPath()
+	return id, region
+}
+
+// volumeAccessor returns the EBS volume DAO.
+func (v *EC2Volume) volumeAccessor(factory dao.Factory) (*dao.EC2Volume, error) {
+	acc, err := dao.AccessorFor(factory, &dao.EC2VolumeRID)
+	if err != nil {
+		return nil, err
+	}
+	volumes, ok := acc.(*dao.EC2Volume)
+	if !ok {
+		return nil, fmt.Errorf("volume actions not supported for %s", dao.EC2VolumeRID.String())
+	}
+	return volumes, nil
+}
+
+// modifyCmd loads the selected volume and shows the modify form.
+func (v *EC2Volume) modifyCmd(*tcell.EventKey) *tcell.EventKey {
+	volumeID, region := v.selectedVolume()
+	if volumeID == "" {
+		return nil
+	}
+
+	v.mx.RLock()
+	app := v.app
+	factory := v.factory
+	v.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return nil
+	}
+
+	volumes, err := v.volumeAccessor(factory)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutGet))
+		defer cancel()
+
+		obj, err := volumes.Get(ctx, region+"/"+volumeID)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Errf("Failed to load volume %s: %v", volumeID, err)
+				return
+			}
+			volume, ok := obj.GetRaw().(types.Volume)
+			if !ok {
+				app.Flash().Errf("Unexpected volume data for %s", volumeID)
+				return
+			}
+			v.showModifyForm(volumes, volume, volumeID, region)
+		})
+	}()
+
+	return nil
+}
+
+// showModifyForm asks for the new volume settings, prefilled with the
+// current ones, and applies those that changed.
+func (v *EC2Volume) showModifyForm(volumes *dao.EC2Volume, volume types.Volume, volumeID, region string) {
+	v.mx.RLock()
+	app := v.app
+	v.mx.RUnlock()
+
+	size := int32Text(volume.Size)
+	iops := int32Text(volume.Iops)
+	throughput := int32Text(volume.Throughput)
+
+	dialog := ui.NewFormDialog(app.Content, "Modify "+volumeID)
+	dialog.AddInputField(volumeSizeLabel, size)
+	dialog.AddDropDownSelected(volumeTypeLabel, volumeTypes, string(volume.VolumeType))
+	dialog.AddInputField(volumeIOPSLabel, iops)
+	dialog.AddInputField(volumeThroughputLabel, throughput)
+	dialog.SetOnSubmit(func(values map[string]string) {
+		newSize, err := changedInt32(values[volumeSizeLabel], size)
+		if err != nil {
+			app.Flash().Errf("Invalid size: %v", err)
+			return
+		}
+		newIOPS, err := changedInt32(values[volumeIOPSLabel], iops)
+		if err != nil {
+			app.Flash().Errf("Invalid IOPS: %v", err)
+			return
+		}
+		newThroughput, err := changedInt32(values[volumeThroughputLabel], throughput)
+		if err != nil {
+			app.Flash().Errf("Invalid throughput: %v", err)
+			return
+		}
+		newType := values[volumeTypeLabel]
+		if newType == string(volume.VolumeType) {
+			newType = ""
+		}
+
+		if newSize == 0 && newType == "" && newIOPS == 0 && newThroughput == 0 {
+			app.Flash().Info("No changes to apply")
+			return
+		}
+		v.doModify(volumes, volumeID, region, newSize, newType, newIOPS, newThroughput)
+	})
+	dialog.Show()
+}
+
+// int32Text formats an optional int32 for a form field.
+func int32Text(n *int32) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*n))
+}
+
+// changedInt32 parses a form value, returning 0 when it is empty or equal to
+// the current value.
+func changedInt32(value, current string) (int32, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == current {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%q is not a positive number", value)
+	}
+	return int32(n), nil
+}
+
+// doModify requests the volume modification and follows its progress.
+func (v *EC2Volume) doModify(volumes *dao.EC2Volume, volumeID, region string, size int32, volType string, iops, throughput int32) {
+	v.mx.RLock()
+	app := v.app
+	v.mx.RUnlock()
+
+	dryRun := app.DryRun()
+	app.Flash().Infof("%sModifying %s...", dryRunPrefix(dryRun), volumeID)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(dryRunContext(context.Background(), dryRun), timeoutFor(app, config.TimeoutAction))
+		defer cancel()
+
+		err := volumes.Modify(ctx, region, volumeID, size, volType, iops, throughput)
+		app.QueueUpdateDraw(func() {
+			switch {
+			case err != nil:
+				app.Flash().Errf("Modify failed: %v", err)
+			case dryRun:
+				app.Flash().Infof("Dry run: modifying %s would succeed", volumeID)
+			default:
+				app.Flash().Infof("Modification of %s requested", volumeID)
+				v.refresh(nil)
+				v.watchModification(volumes, volumeID, region)
+			}
+		})
+	}()
+}
+
+// watchModification polls a volume modification in the background, flashing
+// its state and progress whenever they change, until it completes, fails or
+// the waiter timeout elapses. The volume is usable again once optimizing.
+func (v *EC2Volume) watchModification(volumes *dao.EC2Volume, volumeID, region string) {
+	v.mx.RLock()
+	app := v.app
+	v.mx.RUnlock()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), aws.DefaultWaiterTimeout)
+		defer cancel()
+
+		ticker := time.NewTicker(modificationPollInterval)
+		defer ticker.Stop()
+
+		var last string
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			mod, err := volumes.Modification(ctx, region, volumeID)
+			if err != nil {
+				app.QueueUpdateDraw(func() {
+					app.Flash().Warnf("Failed to follow modification of %s: %v", volumeID, err)
+				})
+				return
+			}
+			if mod == nil {
+				continue
+			}
+
+			state := mod.ModificationState
+			status := string(state)
+			if mod.Progress != nil {
+				status = fmt.Sprintf("%s (%d%%)", state, *mod.Progress)
+			}
+			if status == last {
+				continue
+			}
+			last = status
+
+			done := state == types.VolumeModificationStateCompleted || state == types.VolumeModificationStateFailed
+			app.QueueUpdateDraw(func() {
+				switch state {
+				case types.VolumeModificationStateFailed:
+					msg := "unknown reason"
+					if mod.StatusMessage != nil {
+						msg = *mod.StatusMessage
+					}
+					app.Flash().Errf("Modification of %s failed: %s", volumeID, msg)
+				default:
+					app.Flash().Infof("Modification of %s: %s", volumeID, status)
+				}
+				if done && v.HasFocus() {
+					v.refresh(nil)
+				}
+			})
+			if done {
+				return
+			}
+		}
+	}()
+}
+
+// attachCmd prompts for an instance and device and attaches the selected
+// volume to it.
+func (v *EC2Volume) attachCmd(*tcell.EventKey) *tcell.EventKey {
+	volumeID, region := v.selectedVolume()
+	if volumeID == "" {
+		return nil
+	}
+
+	v.mx.RLock()
+	app := v.app
+	factory := v.factory
+	v.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return nil
+	}
+
+	if state := v.GetSelectedField("STATE"); state != "" && state != string(types.VolumeStateAvailable) {
+		app.Flash().Warnf("Volume %s is %s, only available volumes can be attached", volumeID, state)
+		return nil
+	}
+
+	volumes, err := v.volumeAccessor(factory)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+
+	dialog := ui.NewFormDialog(app.Content, "Attach "+volumeID)
+	dialog.AddInputField(attachInstanceLabel, "")
+	dialog.AddInputField(attachDeviceLabel, defaultVolumeDevice)
+	dialog.SetOnSubmit(func(values map[string]string) {
+		instanceID := strings.TrimSpace(values[attachInstanceLabel])
+		device := strings.TrimSpace(values[attachDeviceLabel])
+		if instanceID == "" {
+			app.Flash().Warn("Instance ID is required")
+			return
+		}
+		if device == "" {
+			device = defaultVolumeDevice
+		}
+
+		v.runVolumeAction(fmt.Sprintf("Attaching %s to %s", volumeID, instanceID), func(ctx context.Context) (string, error) {
+			if err := volumes.Attach(ctx, region, volumeID, instanceID, device); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Attached %s to %s as %s", volumeID, instanceID, device), nil
+		})
+	})
+	dialog.Show()
+
+	return nil
+}
+
+// detachCmd confirms and detaches the selected volume, optionally forcing it.
+func (v *EC2Volume) detachCmd(*tcell.EventKey) *tcell.EventKey {
+	volumeID, region := v.selectedVolume()
+	if volumeID == "" {
+		return nil
+	}
+
+	v.mx.RLock()
+	app := v.app
+	factory := v.factory
+	v.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return nil
+	}
+
+	if state := v.GetSelectedField("STATE"); state != "" && state != string(types.VolumeStateInUse) {
+		app.Flash().Warnf("Volume %s is %s, not attached", volumeID, state)
+		return nil
+	}
+
+	volumes, err := v.volumeAccessor(factory)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+
+	msg := "Detach " + volumeID + "?"
+	if instanceID := v.GetSelectedField("ATTACHED-TO"); instanceID != "" && instanceID != "-" {
+		msg = fmt.Sprintf("Detach %s from %s?", volumeID, instanceID)
+	}
+	msg += "\n\nUnmount it first. Force detaching can lose data and should only be used if a detach is stuck."
+
+	dialog := ui.NewDialog(app.Content, "detach-dialog")
+	dialog.SetMessage(msg)
+	dialog.SetButtons([]string{"Detach", "Force Detach", "Cancel"})
+	dialog.SetButtonHandler(func(idx int, label string) {
+		if idx > 1 {
+			return
+		}
+		force := idx == 1
+
+		v.runVolumeAction("Detaching "+volumeID, func(ctx context.Context) (string, error) {
+			if err := volumes.Detach(ctx, region, volumeID, force); err != nil {
+				return "", err
+			}
+			return "Detaching " + volumeID + " requested", nil
+		})
+	})
+	dialog.Show()
+
+	return nil
+}
+
+// snapshotCmd prompts for a description and snapshots the selected volume.
+func (v *EC2Volume) snapshotCmd(*tcell.EventKey) *tcell.EventKey {
+	volumeID, region := v.selectedVolume()
+	if volumeID == "" {
+		return nil
+	}
+
+	v.mx.RLock()
+	app := v.app
+	factory := v.factory
+	v.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return nil
+	}
+
+	volumes, err := v.volumeAccessor(factory)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+
+	dialog := ui.NewFormDialog(app.Content, "Snapshot "+volumeID)
+	dialog.AddInputField(snapshotDescLabel, "")
+	dialog.SetOnSubmit(func(values map[string]string) {
+		description := strings.TrimSpace(values[snapshotDescLabel])
+
+		v.runVolumeAction("Creating snapshot of "+volumeID, func(ctx context.Context) (string, error) {
+			snapshotID, err := volumes.CreateSnapshot(ctx, region, volumeID, description)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Snapshot %s of %s started", snapshotID, volumeID), nil
+		})
+	})
+	dialog.Show()
+
+	return nil
+}
+
+// runVolumeAction runs fn in the background, flashing progress and the
+// message it returns, and refreshes the view on success. Under dry run fn
+// only validates the request.
+func (v *EC2Volume) runVolumeAction(progress string, fn func(ctx context.Context) (string, error)) {
+	v.mx.RLock()
+	app := v.app
+	v.mx.RUnlock()
+
+	dryRun := app.DryRun()
+	app.Flash().Infof("%s%s...", dryRunPrefix(dryRun), progress)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(dryRunContext(context.Background(), dryRun), timeoutFor(app, config.TimeoutAction))
+		defer cancel()
+
+		msg, err := fn(ctx)
+		app.QueueUpdateDraw(func() {
+			switch {
+			case err != nil:
+				app.Flash().Errf("%s failed: %v", progress, err)
+			case dryRun:
+				app.Flash().Infof("Dry run: %s would succeed", strings.ToLower(progress[:1])+progress[1:])
+			default:
+				app.Flash().Info(msg)
+				v.refresh(nil)
+			}
+		})
+	}()
+}
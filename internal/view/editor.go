@@ -9,12 +9,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
-	"time"
+	"strings"
 
 	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
 	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/render"
+	"github.com/a1s/a1s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/wI2L/jsondiff"
 )
@@ -34,6 +38,7 @@ type EditSession struct {
 	OriginalJSON map[string]interface{} // Full resource state (for reference)
 	EditableJSON map[string]interface{} // Filtered editable properties only
 	Schema       *aws.ResourceSchema    // Schema with property classifications
+	EditedJSON   map[string]interface{} // Last submitted edit, reopened on retry
 	TempFile     string
 	ErrorMsg     string // Error to display at top of file on retry
 }
@@ -148,8 +153,12 @@ func (e *EditSession) writeJSONWithError(f *os.File) error {
 		buf.WriteString("// ---\n\n")
 	}
 
-	// Use EditableJSON if available, otherwise fall back to OriginalJSON
-	jsonToWrite := e.EditableJSON
+	// Reopen the last submitted edit, otherwise use EditableJSON if
+	// available and fall back to OriginalJSON
+	jsonToWrite := e.EditedJSON
+	if jsonToWrite == nil {
+		jsonToWrite = e.EditableJSON
+	}
 	if jsonToWrite == nil {
 		jsonToWrite = e.OriginalJSON
 	}
@@ -187,14 +196,15 @@ func GeneratePatch(original, modified map[string]interface{}) (string, error) {
 	return string(patchBytes), nil
 }
 
-// ApplyUpdate sends the patch to Cloud Control API.
-func (e *EditSession) ApplyUpdate(ctx context.Context, client aws.Connection, patchDocument string) error {
+// ApplyUpdate sends the patch to Cloud Control API and waits for the update
+// to finish, reporting progress events to progress.
+func (e *EditSession) ApplyUpdate(ctx context.Context, client aws.Connection, patchDocument string, progress func(aws.UpdateProgress)) error {
 	ccClient := client.CloudControl(e.Region)
 	if ccClient == nil {
 		return errors.New("failed to get CloudControl client")
 	}
 
-	return aws.UpdateResourceState(ctx, ccClient, e.TypeName, e.Identifier, patchDocument)
+	return aws.UpdateResourceState(ctx, ccClient, e.TypeName, e.Identifier, patchDocument, progress)
 }
 
 // Cleanup removes the temporary file.
@@ -251,36 +261,63 @@ func stripErrorComment(content []byte) []byte {
 	return content
 }
 
+// EditDone receives the outcome of an edit flow: nil once the changes are
+// applied, ErrEditorCancelled, ErrNoChanges or the failure.
+type EditDone func(error)
+
 // EditResource performs the full edit flow for a resource.
-// This is the main entry point for the edit feature.
-func EditResource(ctx context.Context, app *tview.Application, client aws.Connection, rid *dao.ResourceID, path, region string) error {
-	// Get CloudFormation type
-	typeName, ok := dao.GetCloudFormationType(rid)
-	if !ok {
-		return fmt.Errorf("edit not supported for %s", rid.String())
+// This is the main entry point for the edit feature. Cloud Control is tried
+// first; resources it cannot fetch fall back to their DAO's Editable support.
+// done is called once the flow ends, possibly after the user reviewed the
+// changes in a dialog. factory is the calling view's, which may differ from
+// the app's in a split pane.
+func EditResource(ctx context.Context, app *App, factory dao.Factory, rid *dao.ResourceID, path, region string, done EditDone) {
+	client := factory.Client()
+	if client == nil {
+		done(fmt.Errorf("failed to get AWS client"))
+		return
 	}
 
-	// Extract identifier from path
-	identifier := aws.ExtractIdentifier(rid.String(), path)
+	var ccErr error
+	if typeName, ok := dao.GetCloudFormationType(rid); ok {
+		session := NewEditSession(rid, typeName, aws.ExtractIdentifier(rid.String(), path), region)
 
-	// Create session
-	session := NewEditSession(rid, typeName, identifier, region)
-	defer session.Cleanup()
+		fetchCtx, cancel := context.WithTimeout(ctx, timeoutFor(app, config.TimeoutGet))
+		ccErr = session.FetchResource(fetchCtx, client)
+		cancel()
+		if ccErr == nil {
+			editCloudControl(ctx, app, factory, session, done)
+			return
+		}
+	}
 
-	// Fetch current state
-	fetchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
+	if editable, ok := editableFor(factory, rid); ok {
+		EditDAO(ctx, app, editable, rid, path, done)
+		return
+	}
 
-	if err := session.FetchResource(fetchCtx, client); err != nil {
-		return fmt.Errorf("failed to fetch resource: %w", err)
+	if ccErr != nil {
+		done(fmt.Errorf("failed to fetch resource: %w", ccErr))
+		return
 	}
+	done(fmt.Errorf("edit not supported for %s", rid.String()))
+}
+
+// editCloudControl edits a resource fetched through Cloud Control and applies
+// the changes as a JSON Patch.
+func editCloudControl(ctx context.Context, app *App, factory dao.Factory, session *EditSession, done EditDone) {
+	rid, typeName, region := session.ResourceID, session.TypeName, session.Region
+	client := factory.Client()
 
 	// Fetch schema and filter to editable properties only
 	cfClient := client.CloudFormation(region)
 	if cfClient != nil {
-		schemaCtx, schemaCancel := context.WithTimeout(ctx, 10*time.Second)
+		schemaCtx, schemaCancel := context.WithTimeout(ctx, timeoutFor(app, config.TimeoutGet))
 		schema, err := aws.GetResourceSchema(schemaCtx, cfClient, typeName)
 		schemaCancel()
+		if err != nil {
+			slog.Debug("Resource schema unavailable, editing all properties", "type", typeName, "err", err)
+		}
 
 		if err == nil && schema != nil {
 			session.Schema = schema
@@ -293,44 +330,259 @@ func EditResource(ctx context.Context, app *tview.Application, client aws.Connec
 		session.EditableJSON = session.OriginalJSON
 	}
 
-	// Edit loop (allows retry on error)
-	for {
-		// Open editor
-		modified, err := session.StartEdit(app)
+	editLoop(app, session, func(modified map[string]interface{}) error {
+		// Generate patch (compare against editable properties)
+		patch, err := GeneratePatch(session.EditableJSON, modified)
 		if err != nil {
-			if errors.Is(err, ErrEditorCancelled) {
-				return ErrEditorCancelled
-			}
 			return err
 		}
 
-		// Generate patch (compare against editable properties)
-		patch, err := GeneratePatch(session.EditableJSON, modified)
-		if err != nil {
-			if errors.Is(err, ErrNoChanges) {
-				// If we had an error message and user saved without changes, treat as cancel
-				if session.ErrorMsg != "" {
-					return ErrEditorCancelled
-				}
-				return ErrNoChanges
-			}
+		// Validate locally, then apply update
+		if err := validateEdit(factory, rid, modified); err != nil {
 			return err
 		}
+		updateCtx, updateCancel := context.WithTimeout(ctx, timeoutFor(app, config.TimeoutAction))
+		defer updateCancel()
+		return session.ApplyUpdate(updateCtx, client, patch, func(p aws.UpdateProgress) {
+			app.QueueUpdateDraw(func() {
+				app.Flash().Info(formatUpdateProgress(session.Identifier, p))
+			})
+		})
+	}, done)
+}
 
-		// Apply update
-		updateCtx, updateCancel := context.WithTimeout(ctx, 2*time.Minute)
-		err = session.ApplyUpdate(updateCtx, client, patch)
-		updateCancel()
+// formatUpdateProgress renders a Cloud Control progress event for the flash.
+func formatUpdateProgress(identifier string, p aws.UpdateProgress) string {
+	msg := fmt.Sprintf("Updating %s: %s", identifier, p.Status)
+	if p.Message != "" {
+		msg += " - " + p.Message
+	}
+	return msg + " (" + render.HumanDuration(p.Elapsed) + ")"
+}
 
-		if err != nil {
-			// Set error and retry
-			session.SetError(err.Error())
-			// Update editable to match what user submitted (for next diff)
-			session.EditableJSON = modified
-			continue
+// editLoop opens the editor, previews the changes in a diff dialog and calls
+// apply in the background once the user confirms them. A failed apply reopens
+// the editor with the error on top; saving that attempt again unchanged
+// cancels the edit.
+func editLoop(app *App, session *EditSession, apply func(modified map[string]interface{}) error, done EditDone) {
+	finish := func(err error) {
+		session.Cleanup()
+		done(err)
+	}
+
+	session.Cleanup()
+	modified, err := session.StartEdit(app.Application)
+	if err != nil {
+		slog.Error("Editor failed", "resource", session.Identifier, "err", err)
+		finish(err)
+		return
+	}
+
+	if session.ErrorMsg != "" && jsonEqual(session.EditedJSON, modified) {
+		finish(ErrEditorCancelled)
+		return
+	}
+	session.EditedJSON = modified
+
+	diff, err := FormatPatch(session.EditableJSON, modified)
+	if err != nil {
+		if errors.Is(err, ErrNoChanges) && session.ErrorMsg != "" {
+			err = ErrEditorCancelled
+		}
+		finish(err)
+		return
+	}
+
+	dialog := ui.NewDiffDialog(app.Content, "Review changes to "+session.Identifier, diff)
+	dialog.SetOnApply(func() {
+		app.Flash().Infof("Applying changes to %s...", session.Identifier)
+		slog.Info("Applying edit", "resource", session.Identifier)
+		go func() {
+			err := apply(modified)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					slog.Error("Edit failed", "resource", session.Identifier, "err", err)
+					session.SetError(err.Error())
+					editLoop(app, session, apply, done)
+					return
+				}
+				finish(nil)
+			})
+		}()
+	})
+	dialog.SetOnEdit(func() {
+		session.SetError("")
+		editLoop(app, session, apply, done)
+	})
+	dialog.SetOnCancel(func() {
+		finish(ErrEditorCancelled)
+	})
+	dialog.Show()
+}
+
+// FormatPatch renders the changes between original and modified as colored
+// +/- lines, one per JSON Patch operation.
+// Returns ErrNoChanges if identical.
+func FormatPatch(original, modified map[string]interface{}) (string, error) {
+	patch, err := jsondiff.Compare(original, modified)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate patch: %w", err)
+	}
+	if len(patch) == 0 {
+		return "", ErrNoChanges
+	}
+
+	theme := ui.CurrentTheme()
+	var b strings.Builder
+	for _, op := range patch {
+		switch op.Type {
+		case jsondiff.OperationAdd:
+			b.WriteString(theme.Paint(theme.OK, fmt.Sprintf("+ %s: %s", tview.Escape(op.Path), diffValue(op.Value))) + "\n")
+		case jsondiff.OperationRemove:
+			b.WriteString(theme.Paint(theme.Bad, fmt.Sprintf("- %s: %s", tview.Escape(op.Path), diffValue(op.OldValue))) + "\n")
+		case jsondiff.OperationReplace:
+			b.WriteString(theme.Paint(theme.Bad, fmt.Sprintf("- %s: %s", tview.Escape(op.Path), diffValue(op.OldValue))) + "\n")
+			b.WriteString(theme.Paint(theme.OK, fmt.Sprintf("+ %s: %s", tview.Escape(op.Path), diffValue(op.Value))) + "\n")
+		case jsondiff.OperationMove:
+			b.WriteString(theme.Paint(theme.Pending, fmt.Sprintf("~ %s -> %s", tview.Escape(op.From), tview.Escape(op.Path))) + "\n")
+		case jsondiff.OperationCopy:
+			b.WriteString(theme.Paint(theme.OK, fmt.Sprintf("+ %s: copy of %s", tview.Escape(op.Path), tview.Escape(op.From))) + "\n")
 		}
+	}
+
+	return b.String(), nil
+}
+
+// diffValue formats a patch value as compact JSON.
+func diffValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return tview.Escape(fmt.Sprintf("%v", v))
+	}
+	return tview.Escape(string(b))
+}
+
+// editSupported reports whether rid can be edited through Cloud Control or
+// an Editable DAO.
+func editSupported(factory dao.Factory, rid *dao.ResourceID) bool {
+	if _, ok := dao.GetCloudFormationType(rid); ok {
+		return true
+	}
+	_, ok := editableFor(factory, rid)
+	return ok
+}
+
+// editableFor returns the resource's Editable DAO, if it has one.
+func editableFor(factory dao.Factory, rid *dao.ResourceID) (dao.Editable, bool) {
+	if factory == nil {
+		return nil, false
+	}
+	accessor, err := dao.AccessorFor(factory, rid)
+	if err != nil {
+		return nil, false
+	}
+	editable, ok := accessor.(dao.Editable)
+	return editable, ok
+}
+
+// EditDAO performs the edit flow through a resource's service-specific Editable
+// implementation. Only the top-level properties that changed are applied.
+func EditDAO(ctx context.Context, app *App, editable dao.Editable, rid *dao.ResourceID, path string, done EditDone) {
+	fetchCtx, cancel := context.WithTimeout(ctx, timeoutFor(app, config.TimeoutGet))
+	props, err := editable.GetEditable(fetchCtx, path)
+	cancel()
+	if err != nil {
+		done(fmt.Errorf("failed to fetch resource: %w", err))
+		return
+	}
+
+	session := NewEditSession(rid, "", path, "")
+	session.EditableJSON = props
+
+	editLoop(app, session, func(modified map[string]interface{}) error {
+		updateCtx, updateCancel := context.WithTimeout(ctx, timeoutFor(app, config.TimeoutAction))
+		defer updateCancel()
+		return editable.ApplyEdit(updateCtx, path, changedProperties(props, modified))
+	}, done)
+}
+
+// changedProperties returns the top-level properties of modified that differ
+// from original, with removed properties set to nil.
+func changedProperties(original, modified map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+	for k, v := range modified {
+		if old, ok := original[k]; !ok || !jsonEqual(old, v) {
+			patch[k] = v
+		}
+	}
+	for k := range original {
+		if _, ok := modified[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	return patch
+}
 
-		// Success
+// jsonEqual reports whether a and b encode to the same JSON.
+func jsonEqual(a, b interface{}) bool {
+	ab, errA := json.Marshal(a)
+	bb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ab, bb)
+}
+
+// validateEdit runs the resource's EditValidator, if any, on the edited properties.
+func validateEdit(factory dao.Factory, rid *dao.ResourceID, props map[string]interface{}) error {
+	if factory == nil {
+		return nil
+	}
+	accessor, err := dao.AccessorFor(factory, rid)
+	if err != nil {
 		return nil
 	}
+	if v, ok := accessor.(dao.EditValidator); ok {
+		return v.ValidateEdit(props)
+	}
+	return nil
+}
+
+// EditTags performs the tag edit flow for a resource.
+// Tags are presented as a flat JSON map and applied through the resource's Taggable DAO.
+func EditTags(ctx context.Context, app *App, taggable dao.Taggable, rid *dao.ResourceID, path string, done EditDone) {
+	fetchCtx, cancel := context.WithTimeout(ctx, timeoutFor(app, config.TimeoutGet))
+	tags, err := taggable.GetTags(fetchCtx, path)
+	cancel()
+	if err != nil {
+		done(fmt.Errorf("failed to fetch tags: %w", err))
+		return
+	}
+
+	session := NewEditSession(rid, "", path, "")
+	session.EditableJSON = make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		session.EditableJSON[k] = v
+	}
+
+	editLoop(app, session, func(modified map[string]interface{}) error {
+		newTags, err := toTagMap(modified)
+		if err != nil {
+			return err
+		}
+		updateCtx, updateCancel := context.WithTimeout(ctx, timeoutFor(app, config.TimeoutAction))
+		defer updateCancel()
+		return taggable.SetTags(updateCtx, path, newTags)
+	}, done)
+}
+
+// toTagMap converts an edited JSON object into a tag map.
+// Tag values must be strings.
+func toTagMap(m map[string]interface{}) (map[string]string, error) {
+	tags := make(map[string]string, len(m))
+	for k, v := range m {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("tag %q must have a string value", k)
+		}
+		tags[k] = s
+	}
+	return tags, nil
 }
@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// EKSCluster represents an EKS cluster view with kubeconfig generation.
+type EKSCluster struct {
+	*Browser
+}
+
+// NewEKSCluster returns a new EKS cluster view.
+func NewEKSCluster() *EKSCluster {
+	return &EKSCluster{
+		Browser: NewBrowser(&dao.EKSClusterRID),
+	}
+}
+
+// Init initializes the cluster view.
+func (e *EKSCluster) Init(ctx context.Context) error {
+	if err := e.Browser.Init(ctx); err != nil {
+		return err
+	}
+
+	e.bindClusterKeys(e.Actions())
+	return nil
+}
+
+// bindClusterKeys sets up cluster-specific key bindings.
+func (e *EKSCluster) bindClusterKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftK: ui.NewKeyAction("Kubeconfig", e.kubeconfigCmd, true),
+		ui.KeyShiftP: ui.NewKeyAction("Print Kubeconfig", e.printKubeconfigCmd, true),
+		ui.KeyN:      ui.NewKeyAction("Nodes/Namespaces", e.k8sCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyActionWithOpts("Delete", e.deleteCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}),
+	})
+}
+
+// deleteCmd deletes the selected cluster once the user types its name.
+// Clusters that still have node groups or Fargate profiles are refused by EKS.
+func (e *EKSCluster) deleteCmd(*tcell.EventKey) *tcell.EventKey {
+	path, _ := e.selectedPath()
+	if path == "" {
+		return nil
+	}
+
+	name := e.GetSelectedItem()
+	e.confirmDelete("cluster", name, path, func() { e.refresh(nil) })
+	return nil
+}
+
+// k8sCmd opens a read-only view of the selected cluster's Kubernetes nodes
+// and namespaces.
+func (e *EKSCluster) k8sCmd(*tcell.EventKey) *tcell.EventKey {
+	path, _ := e.selectedPath()
+	if path == "" {
+		return nil
+	}
+
+	e.mx.RLock()
+	app := e.app
+	factory := e.factory
+	pushFn := e.pushFn
+	e.mx.RUnlock()
+
+	if app == nil || factory == nil || pushFn == nil {
+		return nil
+	}
+
+	view := NewClusterK8s(path)
+	view.SetApp(app)
+	view.SetFactory(factory)
+	if err := view.Init(context.Background()); err != nil {
+		app.Flash().Errf("Failed to open cluster: %v", err)
+		return nil
+	}
+
+	pushFn("k8s", view)
+	view.Start()
+
+	return nil
+}
+
+// kubeconfigCmd writes the selected cluster's kubeconfig to a temp file and
+// offers to merge it into the user's kubeconfig.
+func (e *EKSCluster) kubeconfigCmd(*tcell.EventKey) *tcell.EventKey {
+	e.fetchKubeconfig(func(app *App, path, kubeconfig string) {
+		tmp, err := writeTempKubeconfig(kubeconfig)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+
+		target := config.KubeconfigPath()
+		confirm := ui.NewConfirm(app.Content)
+		confirm.SetTitle("Kubeconfig")
+		confirm.SetMessage(fmt.Sprintf("Kubeconfig written to %s\n\nMerge it into %s?", tmp, target))
+		confirm.SetOnConfirm(func() {
+			name, err := config.MergeKubeconfig(target, []byte(kubeconfig))
+			if err != nil {
+				app.Flash().Errf("Kubeconfig merge failed: %v", err)
+				return
+			}
+			app.Flash().Infof("Added context %s to %s", name, target)
+		})
+		confirm.SetOnCancel(func() {
+			app.Flash().Infof("Kubeconfig written to %s", tmp)
+		})
+		confirm.Show()
+	})
+	return nil
+}
+
+// printKubeconfigCmd shows the selected cluster's kubeconfig without writing it.
+func (e *EKSCluster) printKubeconfigCmd(*tcell.EventKey) *tcell.EventKey {
+	e.fetchKubeconfig(func(app *App, path, kubeconfig string) {
+		e.mx.RLock()
+		pushFn := e.pushFn
+		popFn := e.popFn
+		factory := e.factory
+		e.mx.RUnlock()
+
+		if pushFn == nil {
+			return
+		}
+
+		descView := NewDescribe(&dao.EKSClusterRID)
+		descView.SetFactory(factory)
+		descView.SetPath(path)
+		descView.SetApp(app)
+		descView.SetContent(kubeconfig)
+		descView.SetBackFn(func() {
+			if popFn != nil {
+				popFn()
+			}
+		})
+		if err := descView.Init(context.Background()); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+
+		pushFn("kubeconfig", descView)
+		descView.Start()
+	})
+	return nil
+}
+
+// fetchKubeconfig generates the selected cluster's kubeconfig in the background
+// and hands it to fn on the UI thread.
+func (e *EKSCluster) fetchKubeconfig(fn func(app *App, path, kubeconfig string)) {
+	path, _ := e.selectedPath()
+	if path == "" {
+		return
+	}
+
+	e.mx.RLock()
+	app := e.app
+	factory := e.factory
+	e.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return
+	}
+
+	acc, err := dao.AccessorFor(factory, &dao.EKSClusterRID)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	cluster, ok := acc.(*dao.EKSCluster)
+	if !ok {
+		app.Flash().Errf("Kubeconfig not supported for %s", dao.EKSClusterRID.String())
+		return
+	}
+
+	app.Flash().Info("Generating kubeconfig...")
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutGet))
+		defer cancel()
+
+		kubeconfig, err := cluster.GetKubeconfig(ctx, path)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Errf("Failed to generate kubeconfig: %v", err)
+				return
+			}
+			fn(app, path, kubeconfig)
+		})
+	}()
+}
+
+// writeTempKubeconfig writes a kubeconfig to a private temp file and returns its path.
+func writeTempKubeconfig(kubeconfig string) (string, error) {
+	f, err := os.CreateTemp("", "a1s-kubeconfig-*.yaml")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(kubeconfig); err != nil {
+		return "", fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+
+	return f.Name(), nil
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Kubernetes kinds the cluster view can list.
+const (
+	k8sNodes      = "nodes"
+	k8sNamespaces = "namespaces"
+)
+
+// ClusterK8s lists Kubernetes nodes or namespaces of an EKS cluster, read-only.
+type ClusterK8s struct {
+	*Table
+
+	path    string // region/cluster-name
+	kind    string
+	app     *App
+	factory dao.Factory
+	last    *k8sListing // last listing, for re-rendering
+}
+
+// k8sListing holds the objects of one kind listed from the cluster.
+type k8sListing struct {
+	kind       string
+	nodes      []dao.K8sNode
+	namespaces []dao.K8sNamespace
+}
+
+// NewClusterK8s returns a Kubernetes view for the cluster at path.
+func NewClusterK8s(path string) *ClusterK8s {
+	return &ClusterK8s{
+		Table: NewTable(&dao.ResourceID{Service: "eks", Resource: "k8s"}),
+		path:  path,
+		kind:  k8sNodes,
+	}
+}
+
+// Init initializes the Kubernetes view.
+func (c *ClusterK8s) Init(ctx context.Context) error {
+	if err := c.Table.Init(ctx); err != nil {
+		return err
+	}
+
+	c.Actions().Bulk(ui.KeyMap{
+		ui.KeyN:        ui.NewKeyAction("Nodes", c.kindCmd(k8sNodes), true),
+		ui.KeyS:        ui.NewKeyAction("Namespaces", c.kindCmd(k8sNamespaces), true),
+		ui.KeyShiftA:   ui.NewKeyAction("Toggle Age", c.toggleTimesCmd, true),
+		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", c.refreshCmd, true),
+	})
+	c.Actions().Delete(tcell.KeyEnter)
+	return nil
+}
+
+// Name returns the component name for breadcrumbs.
+func (c *ClusterK8s) Name() string {
+	return c.path + " " + c.kind
+}
+
+// SetApp sets the App reference for flash messages.
+func (c *ClusterK8s) SetApp(app *App) {
+	c.app = app
+}
+
+// SetFactory sets the AWS factory.
+func (c *ClusterK8s) SetFactory(f dao.Factory) {
+	c.factory = f
+}
+
+// Start connects to the cluster and lists the current kind.
+func (c *ClusterK8s) Start() {
+	if c.app == nil || c.factory == nil {
+		return
+	}
+
+	acc, err := dao.AccessorFor(c.factory, &dao.EKSClusterRID)
+	if err != nil {
+		c.showError(err.Error())
+		return
+	}
+	cluster, ok := acc.(*dao.EKSCluster)
+	if !ok {
+		c.showError("Kubernetes access not supported")
+		return
+	}
+
+	kind := c.kind
+	c.app.Flash().Infof("Connecting to %s...", c.path)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(c.app, config.TimeoutList))
+		defer cancel()
+
+		var listing *k8sListing
+		k8s, err := cluster.K8sClient(ctx, c.path)
+		if err == nil {
+			listing, err = loadK8s(ctx, k8s, kind)
+		}
+		c.app.QueueUpdateDraw(func() {
+			if err != nil {
+				c.showError(k8sErrorMessage(err))
+				c.app.Flash().Err(err)
+				return
+			}
+			c.last = listing
+			data := c.render(listing)
+			c.app.Flash().Infof("%d %s in %s", data.RowCount(), kind, c.path)
+			c.UpdateUI(data)
+		})
+	}()
+}
+
+// loadK8s lists kind from the cluster.
+func loadK8s(ctx context.Context, k8s *dao.K8sClient, kind string) (*k8sListing, error) {
+	listing := &k8sListing{kind: kind}
+
+	var err error
+	if kind == k8sNamespaces {
+		listing.namespaces, err = k8s.Namespaces(ctx)
+	} else {
+		listing.nodes, err = k8s.Nodes(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return listing, nil
+}
+
+// render converts a listing to TableData.
+func (c *ClusterK8s) render(listing *k8sListing) *model1.TableData {
+	data := model1.NewTableData()
+	data.SetNamespace(c.path)
+
+	if listing.kind == k8sNamespaces {
+		data.SetHeader(model1.Header{{Name: "NAME"}, {Name: "STATUS"}, {Name: "AGE"}})
+		for _, ns := range listing.namespaces {
+			row := model1.NewRow(3)
+			row.ID = ns.Name
+			row.Fields[0] = ns.Name
+			row.Fields[1] = ns.Phase
+			row.Fields[2] = formatTime(c.app, &ns.CreatedAt)
+			data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
+		}
+		return data
+	}
+
+	data.SetHeader(model1.Header{
+		{Name: "NAME"},
+		{Name: "STATUS"},
+		{Name: "TYPE"},
+		{Name: "ZONE"},
+		{Name: "VERSION"},
+		{Name: "INTERNAL IP"},
+		{Name: "AGE"},
+	})
+	for _, node := range listing.nodes {
+		row := model1.NewRow(7)
+		row.ID = node.Name
+		row.Fields[0] = node.Name
+		row.Fields[1] = "NotReady"
+		if node.Ready {
+			row.Fields[1] = "Ready"
+		}
+		row.Fields[2] = node.InstanceType
+		row.Fields[3] = node.Zone
+		row.Fields[4] = node.Version
+		row.Fields[5] = node.InternalIP
+		row.Fields[6] = formatTime(c.app, &node.CreatedAt)
+		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
+	}
+	return data
+}
+
+// toggleTimesCmd switches AGE between relative ages and absolute
+// timestamps, re-rendering the last listing.
+func (c *ClusterK8s) toggleTimesCmd(*tcell.EventKey) *tcell.EventKey {
+	if toggleTimes(c.app) && c.last != nil {
+		c.UpdateUI(c.render(c.last))
+	}
+	return nil
+}
+
+// kindCmd returns a handler switching the listed kind.
+func (c *ClusterK8s) kindCmd(kind string) ui.ActionHandler {
+	return func(*tcell.EventKey) *tcell.EventKey {
+		c.kind = kind
+		c.Start()
+		return nil
+	}
+}
+
+// refreshCmd reloads the current kind.
+func (c *ClusterK8s) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	c.Start()
+	return nil
+}
+
+// showError displays an error in the table.
+func (c *ClusterK8s) showError(msg string) {
+	data := model1.NewTableData()
+	data.SetNamespace(c.path)
+	data.SetError(msg)
+	c.UpdateUI(data)
+}
+
+// k8sErrorMessage explains the common reasons a cluster can't be listed.
+func k8sErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, dao.ErrK8sUnauthorized):
+		return "Access denied by the cluster. Grant this IAM identity an EKS access entry (or aws-auth mapping)."
+	case errors.Is(err, dao.ErrK8sUnreachable):
+		return "Cluster API endpoint unreachable. Private endpoints need VPN or VPC access."
+	default:
+		return fmt.Sprintf("Failed to list cluster resources: %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// EKSNodeGroup represents an EKS node group view with scaling.
+type EKSNodeGroup struct {
+	*Browser
+}
+
+// NewEKSNodeGroup returns a new EKS node group view.
+func NewEKSNodeGroup() *EKSNodeGroup {
+	return &EKSNodeGroup{
+		Browser: NewBrowser(&dao.EKSNodeGroupRID),
+	}
+}
+
+// Init initializes the node group view.
+func (n *EKSNodeGroup) Init(ctx context.Context) error {
+	if err := n.Browser.Init(ctx); err != nil {
+		return err
+	}
+
+	n.bindNodeGroupKeys(n.Actions())
+	return nil
+}
+
+// bindNodeGroupKeys sets up node group-specific key bindings.
+func (n *EKSNodeGroup) bindNodeGroupKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyS: ui.NewKeyAction("Scale", n.scaleCmd, true),
+	})
+}
+
+// scaleCmd prompts for a new desired size for the selected node group.
+func (n *EKSNodeGroup) scaleCmd(*tcell.EventKey) *tcell.EventKey {
+	path, _ := n.selectedPath()
+	if path == "" {
+		return nil
+	}
+
+	n.mx.RLock()
+	app := n.app
+	factory := n.factory
+	n.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return nil
+	}
+
+	acc, err := dao.AccessorFor(factory, &dao.EKSNodeGroupRID)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+	ng, ok := acc.(*dao.EKSNodeGroup)
+	if !ok {
+		app.Flash().Errf("Scaling not supported for %s", dao.EKSNodeGroupRID.String())
+		return nil
+	}
+
+	name := path[strings.LastIndex(path, "/")+1:]
+	app.Flash().Infof("Fetching scaling config for %s...", name)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutGet))
+		defer cancel()
+
+		min, max, desired, err := ng.GetScalingConfig(ctx, path)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Errf("Failed to get scaling config: %v", err)
+				return
+			}
+			n.showScaleDialog(app, ng, path, name, min, max, desired)
+		})
+	}()
+
+	return nil
+}
+
+// showScaleDialog asks for the desired size and scales the node group.
+func (n *EKSNodeGroup) showScaleDialog(app *App, ng *dao.EKSNodeGroup, path, name string, min, max, desired int32) {
+	dialog := ui.NewInputDialog(app.Content, "Scale "+name, fmt.Sprintf("Desired size (%d-%d): ", min, max))
+	dialog.SetText(strconv.Itoa(int(desired)))
+	dialog.SetOnSubmit(func(text string) {
+		size, err := parseDesiredSize(text, min, max)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		if size == desired {
+			app.Flash().Infof("%s already at %d nodes", name, size)
+			return
+		}
+
+		app.Flash().Infof("Scaling %s to %d nodes...", name, size)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutAction))
+			defer cancel()
+
+			err := ng.Scale(ctx, path, size)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					app.Flash().Errf("Scale failed: %v", err)
+					return
+				}
+				app.Flash().Infof("Scaling %s from %d to %d nodes", name, desired, size)
+				n.refresh(nil)
+			})
+		}()
+	})
+	dialog.Show()
+}
+
+// parseDesiredSize parses a desired node count and checks it against the scaling bounds.
+func parseDesiredSize(text string, min, max int32) (int32, error) {
+	size, err := strconv.ParseInt(strings.TrimSpace(text), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", text)
+	}
+	if int32(size) < min || int32(size) > max {
+		return 0, fmt.Errorf("size %d is outside the node group bounds %d-%d", size, min, max)
+	}
+	return int32(size), nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
+	"github.com/derailed/tcell/v2"
+)
+
+// FlashLog lists recent flash messages, newest first, so messages that
+// cleared while the user was elsewhere can still be read.
+type FlashLog struct {
+	*Table
+
+	flash *Flash
+}
+
+// NewFlashLog returns a log view of flash's history.
+func NewFlashLog(flash *Flash) *FlashLog {
+	return &FlashLog{
+		Table: NewTable(&dao.ResourceID{Service: "a1s", Resource: "messages"}),
+		flash: flash,
+	}
+}
+
+// Init initializes the log view.
+func (l *FlashLog) Init(ctx context.Context) error {
+	if err := l.Table.Init(ctx); err != nil {
+		return err
+	}
+	l.Actions().Delete(tcell.KeyEnter)
+	return nil
+}
+
+// Name returns the component name for breadcrumbs.
+func (l *FlashLog) Name() string {
+	return "messages"
+}
+
+// Start renders the current history.
+func (l *FlashLog) Start() {
+	l.UpdateUI(l.render(l.flash.History()))
+}
+
+// render converts flash entries to TableData, newest first.
+func (l *FlashLog) render(entries []FlashEntry) *model1.TableData {
+	data := model1.NewTableData()
+	data.SetHeader(model1.Header{
+		{Name: "TIME"},
+		{Name: "LEVEL"},
+		{Name: "MESSAGE"},
+	})
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		row := model1.NewRow(3)
+		row.ID = fmt.Sprintf("%04d", i)
+		row.Fields[0] = e.Time.Format("15:04:05")
+		row.Fields[1] = flashLevelName(e.Level)
+		row.Fields[2] = e.Message
+		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
+	}
+
+	return data
+}
+
+// flashLevelName returns the log label for level.
+func flashLevelName(level FlashLevel) string {
+	switch level {
+	case FlashWarn:
+		return "WARN"
+	case FlashErr:
+		return "ERROR"
+	default:
+		return "INFO"
+	}
+}
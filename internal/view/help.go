@@ -4,6 +4,9 @@
 package view
 
 import (
+	"strings"
+
+	"github.com/a1s/a1s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 )
@@ -15,9 +18,12 @@ type HelpBind struct {
 }
 
 // Help displays a full-screen help view with keybindings (k9s style).
+// Global keys are fixed; the view column lists the active view's hints.
 type Help struct {
 	*tview.Table
-	closeFn func()
+	closeFn  func()
+	viewName string
+	hints    ui.MenuHints
 }
 
 // NewHelp creates a new help view.
@@ -34,12 +40,20 @@ func (h *Help) SetCloseFn(fn func()) {
 	h.closeFn = fn
 }
 
+// SetHints shows the key bindings of the named view, including resource
+// actions registered for it.
+func (h *Help) SetHints(name string, hints ui.MenuHints) {
+	h.viewName, h.hints = name, hints
+	h.Clear()
+	h.populateHelp()
+}
+
 // build constructs the help UI.
 func (h *Help) build() {
 	h.SetBorder(true)
 	h.SetTitle(" Help ")
 	h.SetTitleAlign(tview.AlignCenter)
-	h.SetBorderColor(tcell.ColorYellow)
+	h.SetBorderColor(ui.CurrentTheme().Color(ui.CurrentTheme().Header))
 	h.SetBackgroundColor(tcell.ColorDefault)
 	h.SetSelectable(false, false)
 
@@ -76,7 +90,9 @@ func (h *Help) populateHelp() {
 		{":role", "Roles"},
 		{":policy", "Policies"},
 		{":eks", "EKS"},
+		{":ng", "NodeGroups"},
 		{":vol", "Volumes"},
+		{":ssm", "SSM Nodes"},
 	}
 
 	// Column 2: General
@@ -84,9 +100,21 @@ func (h *Help) populateHelp() {
 		{"<:>", "Command"},
 		{"</>", "Filter"},
 		{"<?>", "Help"},
+		{"<F>", "Messages"},
+		{"<C-x>", "Dismiss Error"},
 		{"<esc>", "Back"},
 		{"<q>", "Quit"},
-		{"<r>", "Refresh"},
+		{"<C-r>", "Refresh"},
+		{":refresh", "Interval"},
+		{":timeout", "Timeouts"},
+		{":reconnect", "Reconnect"},
+		{":whoami", "Identity"},
+		{":overview", "Overview"},
+		{":split", "Split Profile"},
+		{"<C-w>", "Switch Pane"},
+		{":dry-run", "Dry Run"},
+		{":ssh-bastion", "SSH Bastion"},
+		{":goto", "Go To ID"},
 	}
 
 	// Column 3: Navigation
@@ -95,25 +123,26 @@ func (h *Help) populateHelp() {
 		{"<k>", "Up"},
 		{"<g>", "Top"},
 		{"<G>", "Bottom"},
-		{"<enter>", "Select"},
-		{"<d>", "Describe"},
-		{"<e>", "Edit"},
-		{"<y>", "YAML"},
+		{"<space>", "Mark"},
+		{"<[>", "Crumb Back"},
+		{"<]>", "Crumb Forward"},
 	}
 
-	// Column 4: Actions
-	col4 := []HelpBind{
-		{"<s>", "Stop"},
-		{"<C-s>", "Start"},
-		{"<C-r>", "Reboot"},
-		{"<c>", "Connect"},
-		{"<S>", "SSM"},
-		{"<C-d>", "Delete"},
-		{"<bksp>", "Back"},
+	// Column 4: Active view
+	col4 := make([]HelpBind, 0, len(h.hints))
+	for _, hint := range h.hints {
+		if hint.IsBlank() || hint.Description == "" {
+			continue
+		}
+		col4 = append(col4, HelpBind{helpKey(hint.Mnemonic), hint.Description})
+	}
+	viewHeader := "VIEW"
+	if h.viewName != "" {
+		viewHeader = strings.ToUpper(h.viewName)
 	}
 
 	columns := [][]HelpBind{col1, col2, col3, col4}
-	headers := []string{"RESOURCES", "GENERAL", "NAVIGATION", "ACTIONS"}
+	headers := []string{"RESOURCES", "GENERAL", "NAVIGATION", viewHeader}
 
 	// Find max rows
 	maxRows := 0
@@ -126,12 +155,13 @@ func (h *Help) populateHelp() {
 	// Each logical column = 2 table columns (key + desc) + 1 spacer
 	// colWidth = 3 (key, desc, spacer)
 	colWidth := 3
+	theme := ui.CurrentTheme()
 	for colIdx, col := range columns {
 		baseCol := colIdx * colWidth
 
 		// Header
 		header := tview.NewTableCell(headers[colIdx]).
-			SetTextColor(tcell.ColorAqua).
+			SetTextColor(theme.Color(theme.Key)).
 			SetAttributes(tcell.AttrBold).
 			SetSelectable(false)
 		h.SetCell(0, baseCol, header)
@@ -140,15 +170,15 @@ func (h *Help) populateHelp() {
 		for rowIdx, bind := range col {
 			row := rowIdx + 1
 
-			// Key cell (yellow)
+			// Key cell
 			keyCell := tview.NewTableCell(bind.Key).
-				SetTextColor(tcell.ColorYellow).
+				SetTextColor(theme.Color(theme.Header)).
 				SetSelectable(false)
 			h.SetCell(row, baseCol, keyCell)
 
-			// Desc cell (white) with expansion to fill space
+			// Desc cell with expansion to fill space
 			descCell := tview.NewTableCell(bind.Desc).
-				SetTextColor(tcell.ColorWhite).
+				SetTextColor(theme.Color(theme.Text)).
 				SetSelectable(false).
 				SetExpansion(1)
 			h.SetCell(row, baseCol+1, descCell)
@@ -167,7 +197,21 @@ func (h *Help) populateHelp() {
 
 	// Footer
 	footer := tview.NewTableCell("<esc> to close").
-		SetTextColor(tcell.ColorGray).
+		SetTextColor(theme.Color(theme.Dim)).
 		SetSelectable(false)
 	h.SetCell(maxRows+2, 0, footer)
 }
+
+// helpKey formats a menu mnemonic such as "Shift-Y" or "Ctrl-D" the way the
+// help screen shows keys, e.g. "<Y>" or "<C-d>".
+func helpKey(mnemonic string) string {
+	switch {
+	case strings.HasPrefix(mnemonic, "Shift-"):
+		return "<" + strings.ToUpper(strings.TrimPrefix(mnemonic, "Shift-")) + ">"
+	case strings.HasPrefix(mnemonic, "Ctrl-"):
+		return "<C-" + strings.ToLower(strings.TrimPrefix(mnemonic, "Ctrl-")) + ">"
+	case len(mnemonic) == 1:
+		return "<" + mnemonic + ">"
+	}
+	return "<" + strings.ToLower(mnemonic) + ">"
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// IAMRole represents an IAM role view.
+type IAMRole struct {
+	*Browser
+}
+
+// NewIAMRole returns a new IAM role view.
+func NewIAMRole() *IAMRole {
+	return &IAMRole{
+		Browser: NewBrowser(&dao.IAMRoleRID),
+	}
+}
+
+// Init initializes the IAM role view.
+func (r *IAMRole) Init(ctx context.Context) error {
+	if err := r.Browser.Init(ctx); err != nil {
+		return err
+	}
+
+	r.bindRoleKeys(r.Actions())
+	return nil
+}
+
+// bindRoleKeys sets up IAM role-specific key bindings.
+func (r *IAMRole) bindRoleKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftS: ui.NewKeyAction("Simulate Policy", r.simulateCmd, true),
+	})
+}
+
+// simulateCmd runs the IAM policy simulator for the selected role.
+func (r *IAMRole) simulateCmd(*tcell.EventKey) *tcell.EventKey {
+	roleName := r.GetSelectedItem()
+	if roleName == "" {
+		return nil
+	}
+
+	r.mx.RLock()
+	app := r.app
+	factory := r.factory
+	pushFn := r.pushFn
+	r.mx.RUnlock()
+
+	if app == nil || factory == nil || pushFn == nil {
+		return nil
+	}
+
+	acc, err := dao.AccessorFor(factory, &dao.IAMRoleRID)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+	roles, ok := acc.(*dao.IAMRole)
+	if !ok {
+		app.Flash().Warn("Policy simulation is not supported for roles")
+		return nil
+	}
+
+	simulatePolicy(app, pushFn, roleName, func(ctx context.Context, actions []string, resource string) ([]dao.PolicyDecision, error) {
+		return roles.SimulatePolicy(ctx, roleName, actions, resource)
+	})
+	return nil
+}
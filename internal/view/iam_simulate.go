@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Policy simulator form labels.
+const (
+	simulateActionLabel   = "Actions"
+	simulateResourceLabel = "Resource ARN"
+)
+
+// simulateFunc simulates actions on resource for one principal.
+type simulateFunc func(ctx context.Context, actions []string, resource string) ([]dao.PolicyDecision, error)
+
+// simulatePolicy asks for actions and a resource, runs the IAM policy
+// simulator for principal and pushes the decisions.
+func simulatePolicy(app *App, pushFn func(string, ui.Component), principal string, run simulateFunc) {
+	form := ui.NewFormDialog(app.Content, "Simulate Policy for "+principal)
+	form.AddInputField(simulateActionLabel, "")
+	form.AddInputField(simulateResourceLabel, "*")
+	form.SetOnSubmit(func(values map[string]string) {
+		actions := splitActions(values[simulateActionLabel])
+		if len(actions) == 0 {
+			app.Flash().Warn("Enter at least one action, e.g. s3:GetObject")
+			return
+		}
+		resource := strings.TrimSpace(values[simulateResourceLabel])
+		if resource == "*" {
+			resource = ""
+		}
+
+		app.Flash().Infof("Simulating %s for %s...", strings.Join(actions, ", "), principal)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutGet))
+			defer cancel()
+
+			decisions, err := run(ctx, actions, resource)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					app.Flash().Errf("Policy simulation failed: %v", err)
+					return
+				}
+
+				view := NewPolicySimulation(principal, decisions)
+				if err := view.Init(context.Background()); err != nil {
+					app.Flash().Errf("Failed to show simulation: %v", err)
+					return
+				}
+				pushFn("simulation", view)
+				view.Start()
+				app.Flash().Info(simulationSummary(decisions))
+			})
+		}()
+	})
+	form.Show()
+}
+
+// splitActions splits a comma or space separated action list.
+func splitActions(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
+// simulationSummary counts allowed and denied decisions.
+func simulationSummary(decisions []dao.PolicyDecision) string {
+	allowed := 0
+	for _, d := range decisions {
+		if d.Allowed() {
+			allowed++
+		}
+	}
+	return fmt.Sprintf("%d allowed, %d denied", allowed, len(decisions)-allowed)
+}
+
+// PolicySimulation lists policy simulator decisions for one principal.
+type PolicySimulation struct {
+	*Table
+
+	principal string
+	decisions []dao.PolicyDecision
+	allowed   map[string]bool
+	mx        sync.RWMutex
+}
+
+// NewPolicySimulation returns a view of decisions for principal.
+func NewPolicySimulation(principal string, decisions []dao.PolicyDecision) *PolicySimulation {
+	return &PolicySimulation{
+		Table:     NewTable(&dao.ResourceID{Service: "iam", Resource: "simulation"}),
+		principal: principal,
+		decisions: decisions,
+	}
+}
+
+// Init initializes the simulation view.
+func (p *PolicySimulation) Init(ctx context.Context) error {
+	if err := p.Table.Init(ctx); err != nil {
+		return err
+	}
+	p.Actions().Delete(tcell.KeyEnter)
+	p.SetRowColorFn(p.rowColor)
+	return nil
+}
+
+// Name returns the component name for breadcrumbs.
+func (p *PolicySimulation) Name() string {
+	return p.principal + " simulation"
+}
+
+// Start renders the decisions.
+func (p *PolicySimulation) Start() {
+	p.UpdateUI(p.render())
+}
+
+// render converts decisions to TableData.
+func (p *PolicySimulation) render() *model1.TableData {
+	data := model1.NewTableData()
+	data.SetHeader(model1.Header{
+		{Name: "ACTION"},
+		{Name: "RESOURCE"},
+		{Name: "DECISION"},
+		{Name: "MATCHED STATEMENTS"},
+		{Name: "MISSING CONTEXT"},
+	})
+
+	allowed := make(map[string]bool, len(p.decisions))
+	for i, d := range p.decisions {
+		statements := make([]string, 0, len(d.Statements))
+		for _, st := range d.Statements {
+			statements = append(statements, st.String())
+		}
+
+		row := model1.NewRow(5)
+		row.ID = fmt.Sprintf("%04d", i)
+		row.Fields[0] = d.Action
+		row.Fields[1] = d.Resource
+		row.Fields[2] = d.Decision
+		row.Fields[3] = strings.Join(statements, ", ")
+		row.Fields[4] = strings.Join(d.MissingContext, ", ")
+		if row.Fields[3] == "" {
+			row.Fields[3] = "-"
+		}
+		if row.Fields[4] == "" {
+			row.Fields[4] = "-"
+		}
+		allowed[row.ID] = d.Allowed()
+		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
+	}
+
+	p.mx.Lock()
+	p.allowed = allowed
+	p.mx.Unlock()
+
+	return data
+}
+
+// rowColor colors allowed decisions as OK and denied ones as bad.
+func (p *PolicySimulation) rowColor(id string) (tcell.Color, bool) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	allowed, ok := p.allowed[id]
+	if !ok {
+		return tcell.ColorDefault, false
+	}
+	theme := ui.CurrentTheme()
+	if allowed {
+		return theme.Color(theme.OK), true
+	}
+	return theme.Color(theme.Bad), true
+}
@@ -0,0 +1,450 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
+	"github.com/a1s/a1s/internal/render"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	// secretDialogID is the page identifier of the new access key dialog.
+	secretDialogID = "secret-dialog"
+
+	// staleKeyAge is the age after which access keys are flagged for rotation.
+	staleKeyAge = 90 * 24 * time.Hour
+)
+
+// IAMUser represents an IAM user view with access key management.
+type IAMUser struct {
+	*Browser
+}
+
+// NewIAMUser returns a new IAM user view.
+func NewIAMUser() *IAMUser {
+	return &IAMUser{
+		Browser: NewBrowser(&dao.IAMUserRID),
+	}
+}
+
+// Init initializes the IAM user view.
+func (u *IAMUser) Init(ctx context.Context) error {
+	if err := u.Browser.Init(ctx); err != nil {
+		return err
+	}
+
+	u.bindUserKeys(u.Actions())
+	return nil
+}
+
+// bindUserKeys sets up IAM user-specific key bindings.
+func (u *IAMUser) bindUserKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftK: ui.NewKeyAction("Access Keys", u.accessKeysCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Simulate Policy", u.simulateCmd, true),
+		ui.KeyShiftP: ui.NewKeyAction("Toggle Policies", u.togglePoliciesCmd, true),
+	})
+}
+
+// togglePoliciesCmd shows or hides the POLICIES and GROUPS columns. They
+// cost three API calls per user, so they are off by default.
+func (u *IAMUser) togglePoliciesCmd(*tcell.EventKey) *tcell.EventKey {
+	u.mx.RLock()
+	app := u.app
+	u.mx.RUnlock()
+
+	if app == nil {
+		return nil
+	}
+
+	if app.TogglePolicyCounts() {
+		app.Flash().Info("Counting policies and groups per user...")
+	} else {
+		app.Flash().Info("Hiding policy counts")
+	}
+	u.Start()
+	return nil
+}
+
+// simulateCmd runs the IAM policy simulator for the selected user.
+func (u *IAMUser) simulateCmd(*tcell.EventKey) *tcell.EventKey {
+	username := u.GetSelectedItem()
+	if username == "" {
+		return nil
+	}
+
+	u.mx.RLock()
+	app := u.app
+	factory := u.factory
+	pushFn := u.pushFn
+	u.mx.RUnlock()
+
+	if app == nil || factory == nil || pushFn == nil {
+		return nil
+	}
+
+	acc, err := dao.AccessorFor(factory, &dao.IAMUserRID)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+	users, ok := acc.(*dao.IAMUser)
+	if !ok {
+		app.Flash().Warn("Policy simulation is not supported for users")
+		return nil
+	}
+
+	simulatePolicy(app, pushFn, username, func(ctx context.Context, actions []string, resource string) ([]dao.PolicyDecision, error) {
+		return users.SimulatePolicy(ctx, username, actions, resource)
+	})
+	return nil
+}
+
+// accessKeysCmd shows the access keys of the selected user.
+func (u *IAMUser) accessKeysCmd(*tcell.EventKey) *tcell.EventKey {
+	username := u.GetSelectedItem()
+	if username == "" {
+		return nil
+	}
+
+	u.mx.RLock()
+	app := u.app
+	factory := u.factory
+	pushFn := u.pushFn
+	u.mx.RUnlock()
+
+	if app == nil || factory == nil || pushFn == nil {
+		return nil
+	}
+
+	view := NewAccessKeys(username)
+	view.SetApp(app)
+	view.SetFactory(factory)
+	if err := view.Init(context.Background()); err != nil {
+		app.Flash().Errf("Failed to open access keys: %v", err)
+		return nil
+	}
+
+	pushFn("access-keys", view)
+	view.Start()
+
+	return nil
+}
+
+// AccessKeys lists and manages the access keys of an IAM user.
+type AccessKeys struct {
+	*Table
+
+	username string
+	app      *App
+	factory  dao.Factory
+	keys     []dao.AccessKeyMetadata // last listing, for re-rendering
+	stale    map[string]struct{}
+	mx       sync.RWMutex
+}
+
+// NewAccessKeys returns a new access key view for the given user.
+func NewAccessKeys(username string) *AccessKeys {
+	return &AccessKeys{
+		Table:    NewTable(&dao.ResourceID{Service: "iam", Resource: "accesskey"}),
+		username: username,
+	}
+}
+
+// Init initializes the access key view.
+func (a *AccessKeys) Init(ctx context.Context) error {
+	if err := a.Table.Init(ctx); err != nil {
+		return err
+	}
+
+	a.bindKeys(a.Actions())
+	a.SetRowColorFn(a.rowColor)
+	return nil
+}
+
+// Name returns the component name for breadcrumbs.
+func (a *AccessKeys) Name() string {
+	return a.username + " access keys"
+}
+
+// SetApp sets the App reference for flash messages and dialogs.
+func (a *AccessKeys) SetApp(app *App) {
+	a.app = app
+}
+
+// SetFactory sets the AWS factory.
+func (a *AccessKeys) SetFactory(f dao.Factory) {
+	a.factory = f
+}
+
+// bindKeys sets up access key bindings.
+func (a *AccessKeys) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyC:        ui.NewKeyAction("Create Key", a.createCmd, true),
+		ui.KeyShiftA:   ui.NewKeyAction("Toggle Age", a.toggleTimesCmd, true),
+		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", a.refreshCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyActionWithOpts("Delete Key", a.deleteCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}),
+	})
+}
+
+// Start loads the user's access keys.
+func (a *AccessKeys) Start() {
+	iamUser, err := a.accessor()
+	if err != nil {
+		a.showError(err.Error())
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(a.app, config.TimeoutList))
+		defer cancel()
+
+		keys, err := iamUser.AccessKeyUsage(ctx, a.username)
+		a.app.QueueUpdateDraw(func() {
+			if err != nil && !errors.Is(err, dao.ErrPartialList) {
+				a.showError(fmt.Sprintf("Failed to list access keys: %v", err))
+				return
+			}
+			a.UpdateUI(a.render(keys))
+			if n := a.staleCount(); n > 0 {
+				a.app.Flash().Warnf("%d access key(s) of %s older or unused for over %s; consider rotating",
+					n, a.username, render.HumanDuration(staleKeyAge))
+			}
+			if err != nil {
+				a.app.Flash().Warnf("Some last-used times unavailable: %v", err)
+			}
+		})
+	}()
+}
+
+// render converts access key metadata to TableData.
+func (a *AccessKeys) render(keys []dao.AccessKeyMetadata) *model1.TableData {
+	data := model1.NewTableData()
+	data.SetNamespace(a.username)
+	data.SetHeader(model1.Header{
+		{Name: "ACCESS KEY ID"},
+		{Name: "STATUS"},
+		{Name: "CREATED"},
+		{Name: "AGE"},
+		{Name: "LAST USED"},
+	})
+
+	stale := make(map[string]struct{})
+	for _, key := range keys {
+		row := model1.NewRow(5)
+		row.ID = key.AccessKeyID
+		row.Fields[0] = key.AccessKeyID
+		row.Fields[1] = key.Status
+		row.Fields[2] = key.CreateDate
+		row.Fields[3] = formatTime(a.app, key.CreatedAt)
+		row.Fields[4] = "never"
+		if key.LastUsed != nil {
+			row.Fields[4] = formatTime(a.app, key.LastUsed)
+			if a.app == nil || !a.app.AbsoluteTimes() {
+				row.Fields[4] += " ago"
+			}
+		}
+		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
+
+		if key.Stale(staleKeyAge) {
+			stale[key.AccessKeyID] = struct{}{}
+		}
+	}
+
+	a.mx.Lock()
+	a.keys = keys
+	a.stale = stale
+	a.mx.Unlock()
+
+	return data
+}
+
+// toggleTimesCmd switches AGE and LAST USED between relative ages and
+// absolute timestamps, re-rendering the listed keys.
+func (a *AccessKeys) toggleTimesCmd(*tcell.EventKey) *tcell.EventKey {
+	if !toggleTimes(a.app) {
+		return nil
+	}
+
+	a.mx.RLock()
+	keys := a.keys
+	a.mx.RUnlock()
+	a.UpdateUI(a.render(keys))
+	return nil
+}
+
+// rowColor highlights stale keys in red.
+func (a *AccessKeys) rowColor(id string) (tcell.Color, bool) {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	if _, ok := a.stale[id]; ok {
+		return ui.CurrentTheme().Color(ui.CurrentTheme().Bad), true
+	}
+	return tcell.ColorDefault, false
+}
+
+// staleCount returns the number of stale keys currently listed.
+func (a *AccessKeys) staleCount() int {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	return len(a.stale)
+}
+
+// showError displays an error in the table.
+func (a *AccessKeys) showError(msg string) {
+	data := model1.NewTableData()
+	data.SetNamespace(a.username)
+	data.SetError(msg)
+	a.UpdateUI(data)
+}
+
+// accessor returns the IAM user DAO.
+func (a *AccessKeys) accessor() (*dao.IAMUser, error) {
+	if a.app == nil || a.factory == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	acc, err := dao.AccessorFor(a.factory, &dao.IAMUserRID)
+	if err != nil {
+		return nil, err
+	}
+
+	iamUser, ok := acc.(*dao.IAMUser)
+	if !ok {
+		return nil, fmt.Errorf("IAM user accessor does not support access keys")
+	}
+	return iamUser, nil
+}
+
+// refreshCmd reloads the access keys.
+func (a *AccessKeys) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	a.Start()
+	return nil
+}
+
+// createCmd creates a new access key after confirmation.
+func (a *AccessKeys) createCmd(*tcell.EventKey) *tcell.EventKey {
+	iamUser, err := a.accessor()
+	if err != nil {
+		if a.app != nil {
+			a.app.Flash().Err(err)
+		}
+		return nil
+	}
+
+	confirm := ui.NewConfirm(a.app.Content)
+	confirm.SetMessage(fmt.Sprintf("Create a new access key for %s?", a.username))
+	confirm.SetOnConfirm(func() {
+		a.app.Flash().Infof("Creating access key for %s...", a.username)
+
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(a.app, config.TimeoutAction))
+			defer cancel()
+
+			key, err := iamUser.CreateAccessKey(ctx, a.username)
+			a.app.QueueUpdateDraw(func() {
+				if err != nil {
+					a.app.Flash().Errf("Create access key failed: %v", err)
+					return
+				}
+				a.showSecret(key)
+				a.Start()
+			})
+		}()
+	})
+	confirm.Show()
+
+	return nil
+}
+
+// showSecret displays a newly created key; the secret cannot be retrieved again.
+func (a *AccessKeys) showSecret(key *dao.AccessKey) {
+	modal := tview.NewModal()
+	modal.SetText(fmt.Sprintf(
+		"Access key created. The secret is shown only once!\n\nAccess Key ID: %s\nSecret Access Key: %s",
+		key.AccessKeyID, key.SecretAccessKey))
+	modal.AddButtons([]string{"Copy Secret", "Copy ID", "Close"})
+	modal.SetDoneFunc(func(idx int, label string) {
+		switch label {
+		case "Copy Secret":
+			a.copy(key.SecretAccessKey, "secret access key")
+		case "Copy ID":
+			a.copy(key.AccessKeyID, "access key ID")
+		default:
+			a.app.Content.RemovePage(secretDialogID)
+		}
+	})
+
+	a.app.Content.AddPage(secretDialogID, modal, true, true)
+}
+
+// copy copies text to the clipboard, flashing what was copied.
+func (a *AccessKeys) copy(text, what string) {
+	if err := ui.CopyToClipboard(text); err != nil {
+		a.app.Flash().Errf("Copy failed: %v", err)
+		return
+	}
+	a.app.Flash().Infof("Copied %s", what)
+}
+
+// deleteCmd deletes the selected access key after confirmation.
+func (a *AccessKeys) deleteCmd(*tcell.EventKey) *tcell.EventKey {
+	keyID := a.GetSelectedItem()
+	if keyID == "" {
+		return nil
+	}
+
+	iamUser, err := a.accessor()
+	if err != nil {
+		if a.app != nil {
+			a.app.Flash().Err(err)
+		}
+		return nil
+	}
+
+	confirm := ui.NewConfirm(a.app.Content)
+	confirm.SetMessage(fmt.Sprintf("Delete access key %s of %s?\n\nApplications using it will lose access!", keyID, a.username))
+	confirm.SetDangerous(true)
+	confirm.SetConfirmationText(keyID)
+	confirm.SetOnConfirm(func() {
+		if a.app.DryRun() {
+			a.app.Flash().Infof("Dry run: would delete access key %s", keyID)
+			return
+		}
+		a.app.Flash().Infof("Deleting access key %s...", keyID)
+
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(a.app, config.TimeoutAction))
+			defer cancel()
+
+			err := iamUser.DeleteAccessKey(ctx, a.username, keyID)
+			a.app.QueueUpdateDraw(func() {
+				if err != nil {
+					a.app.Flash().Errf("Delete access key failed: %v", err)
+					return
+				}
+				a.app.Flash().Infof("Deleted access key %s", keyID)
+				a.Start()
+			})
+		}()
+	})
+	confirm.Show()
+
+	return nil
+}
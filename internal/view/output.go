@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats supported by WriteResources.
+const (
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+	OutputTable = "table"
+)
+
+// outputObject is the scriptable form of a listed resource.
+type outputObject struct {
+	ID       string            `json:"id" yaml:"id"`
+	Name     string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Region   string            `json:"region,omitempty" yaml:"region,omitempty"`
+	ARN      string            `json:"arn,omitempty" yaml:"arn,omitempty"`
+	Tags     map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Resource interface{}       `json:"resource,omitempty" yaml:"resource,omitempty"`
+}
+
+// ResolveResource resolves a resource name as typed on the command line,
+// e.g. "ec2", "subnets", a user alias or "vpc/subnet", to its ResourceID.
+func ResolveResource(name string) (*dao.ResourceID, error) {
+	c := &Command{aliases: make(map[string]string)}
+	for k, v := range defaultAliases {
+		c.aliases[k] = v
+	}
+	userAliases := &config.Aliases{Alias: make(map[string]string)}
+	if err := userAliases.LoadFrom(config.AppAliasesFile); err == nil {
+		for k, v := range userAliases.All() {
+			c.aliases[k] = v
+		}
+	}
+
+	service, resource, _ := strings.Cut(c.resolveAlias(name), "/")
+	if !awsCommands[service] || resource == "" {
+		return nil, fmt.Errorf("unknown resource: %s", name)
+	}
+	return &dao.ResourceID{Service: service, Resource: resource}, nil
+}
+
+// ListResources lists a resource in region. Regional services listed in
+// aws.RegionAll are listed in every enabled region and merged; regions
+// that fail are named in an ErrPartialList error next to the rest.
+func ListResources(ctx context.Context, factory dao.Factory, rid *dao.ResourceID, region string) ([]dao.AWSObject, error) {
+	accessor, err := dao.AccessorFor(factory, rid)
+	if err != nil {
+		return nil, err
+	}
+	if region != aws.RegionAll || aws.IsGlobalService(rid.Service) {
+		return accessor.List(ctx, region)
+	}
+
+	regions := enabledRegions(ctx, factory)
+	results := make([][]dao.AWSObject, len(regions))
+	errs := make([]error, len(regions))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxRegionWorkers)
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i], errs[i] = accessor.List(ctx, region)
+		}(i, region)
+	}
+	wg.Wait()
+
+	var (
+		objects  []dao.AWSObject
+		failed   []string
+		firstErr error
+	)
+	for i, region := range regions {
+		objects = append(objects, results[i]...)
+		if errs[i] != nil {
+			failed = append(failed, region)
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+		}
+	}
+	if len(failed) > 0 && len(failed) == len(regions) && len(objects) == 0 {
+		return nil, firstErr
+	}
+	if len(failed) > 0 {
+		return objects, fmt.Errorf("%w: %s in %s", dao.ErrPartialList, rid, strings.Join(failed, ", "))
+	}
+	return objects, nil
+}
+
+// WriteResources writes objects to w as JSON, YAML or a table with the same
+// columns as the resource browser.
+func WriteResources(w io.Writer, rid *dao.ResourceID, objects []dao.AWSObject, region, format string) error {
+	switch format {
+	case OutputJSON, OutputYAML:
+		clean := &Describe{}
+		out := make([]outputObject, 0, len(objects))
+		for _, obj := range objects {
+			out = append(out, outputObject{
+				ID:       obj.GetID(),
+				Name:     obj.GetName(),
+				Region:   obj.GetRegion(),
+				ARN:      obj.GetARN(),
+				Tags:     obj.GetTags(),
+				Resource: clean.toCleanMap(obj.GetRaw()),
+			})
+		}
+
+		if format == OutputYAML {
+			enc := yaml.NewEncoder(w)
+			enc.SetIndent(2)
+			if err := enc.Encode(out); err != nil {
+				return err
+			}
+			return enc.Close()
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+
+	case OutputTable:
+		return writeTable(w, rid, objects, region)
+	}
+
+	return fmt.Errorf("unknown output format %q (use %s, %s or %s)", format, OutputJSON, OutputYAML, OutputTable)
+}
+
+// writeTable renders objects with the browser's columns.
+func writeTable(w io.Writer, rid *dao.ResourceID, objects []dao.AWSObject, region string) error {
+	b := &Browser{}
+	data := b.renderObjects(objects, region, rid)
+
+	header := data.Header()
+	if len(header) == 0 {
+		header = b.displayHeader(rid)
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	names := make([]string, 0, len(header))
+	for _, col := range header {
+		names = append(names, col.Name)
+	}
+	fmt.Fprintln(tw, strings.Join(names, "\t"))
+
+	for i := 0; i < data.RowEvents().Len(); i++ {
+		re, _ := data.RowEvents().At(i)
+		fmt.Fprintln(tw, strings.Join(re.Row.Fields, "\t"))
+	}
+
+	return tw.Flush()
+}
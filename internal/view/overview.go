@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/derailed/tcell/v2"
+)
+
+// overviewTile is one resource type counted by the overview.
+type overviewTile struct {
+	rid   dao.ResourceID
+	title string
+	// detail optionally breaks the count down, e.g. by state.
+	detail func(objects []dao.AWSObject) string
+}
+
+// overviewTiles are the resources counted by the overview, in display order.
+var overviewTiles = []overviewTile{
+	{rid: dao.EC2InstanceRID, title: "EC2 Instances", detail: instanceStates},
+	{rid: dao.EC2VolumeRID, title: "EBS Volumes", detail: unattachedVolumes},
+	{rid: dao.S3BucketRID, title: "S3 Buckets"},
+	{rid: dao.IAMUserRID, title: "IAM Users"},
+	{rid: dao.IAMRoleRID, title: "IAM Roles"},
+	{rid: dao.EKSClusterRID, title: "EKS Clusters"},
+}
+
+// overviewCount is the outcome of counting one tile.
+type overviewCount struct {
+	done   bool
+	count  int
+	detail string
+	err    error
+}
+
+// Overview is the landing view. It counts key resources in the active region
+// concurrently and shows one row per resource type; Enter opens its browser.
+type Overview struct {
+	*Table
+
+	app    *App
+	region string
+	counts map[string]overviewCount
+	gen    int // bumped by Start so late results of a previous run are dropped
+	mx     sync.Mutex
+}
+
+// NewOverview returns a new overview.
+func NewOverview(app *App) *Overview {
+	return &Overview{
+		Table:  NewTable(&dao.ResourceID{Service: "a1s", Resource: "overview"}),
+		app:    app,
+		counts: make(map[string]overviewCount, len(overviewTiles)),
+	}
+}
+
+// Init initializes the overview.
+func (o *Overview) Init(ctx context.Context) error {
+	if err := o.Table.Init(ctx); err != nil {
+		return err
+	}
+
+	o.SetEnterFn(o.openCmd)
+	o.Actions().Add(tcell.KeyEnter, ui.NewKeyAction("Open", o.enterCmd, true))
+	o.Actions().Add(tcell.KeyCtrlR, ui.NewKeyAction("Refresh", o.refreshCmd, true))
+	return nil
+}
+
+// Name returns the component name for breadcrumbs.
+func (o *Overview) Name() string {
+	return "overview"
+}
+
+// Start counts every tile in the background, updating rows as counts arrive.
+func (o *Overview) Start() {
+	factory := o.app.GetFactory()
+	if factory == nil {
+		data := model1.NewTableData()
+		data.SetError("No AWS connection")
+		o.UpdateUI(data)
+		return
+	}
+
+	region := factory.Region()
+	if region == "" {
+		region = aws.DefaultRegion
+	}
+
+	o.mx.Lock()
+	o.gen++
+	gen := o.gen
+	o.region = region
+	o.counts = make(map[string]overviewCount, len(overviewTiles))
+	o.mx.Unlock()
+	o.UpdateUI(o.render())
+
+	for _, tile := range overviewTiles {
+		go func(tile overviewTile) {
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(o.app, config.TimeoutList))
+			defer cancel()
+
+			result := countTile(ctx, factory, tile, region)
+			o.app.QueueUpdateDraw(func() {
+				o.mx.Lock()
+				if gen != o.gen {
+					o.mx.Unlock()
+					return
+				}
+				o.counts[tile.rid.String()] = result
+				o.mx.Unlock()
+				o.UpdateUI(o.render())
+			})
+		}(tile)
+	}
+}
+
+// countTile lists a tile's resources in region, or in every enabled region
+// for regional resources when all regions are active. Regions that fail only
+// mark the count as partial.
+func countTile(ctx context.Context, factory dao.Factory, tile overviewTile, region string) overviewCount {
+	rid := tile.rid
+	objects, err := ListResources(ctx, factory, &rid, region)
+	if err != nil && !errors.Is(err, dao.ErrPartialList) {
+		return overviewCount{done: true, err: err}
+	}
+
+	result := overviewCount{done: true, count: len(objects), err: err}
+	if tile.detail != nil {
+		result.detail = tile.detail(objects)
+	}
+	return result
+}
+
+// render converts the counts gathered so far to TableData.
+func (o *Overview) render() *model1.TableData {
+	o.mx.Lock()
+	defer o.mx.Unlock()
+
+	data := model1.NewTableData()
+	data.SetNamespace(o.region)
+	data.SetHeader(model1.Header{
+		{Name: "RESOURCE"},
+		{Name: "COUNT"},
+		{Name: "DETAIL"},
+	})
+
+	for _, tile := range overviewTiles {
+		row := model1.NewRow(3)
+		row.ID = tile.rid.String()
+		row.Fields[0] = tile.title
+
+		c := o.counts[row.ID]
+		switch {
+		case !c.done:
+			row.Fields[1] = "..."
+		case errors.Is(c.err, dao.ErrPartialList):
+			row.Fields[1] = strconv.Itoa(c.count) + "+"
+			row.Fields[2] = strings.TrimPrefix(c.detail+"; "+c.err.Error(), "; ")
+		case c.err != nil:
+			row.Fields[1] = "?"
+			row.Fields[2] = fmt.Sprintf("error: %v", c.err)
+		default:
+			row.Fields[1] = strconv.Itoa(c.count)
+			row.Fields[2] = c.detail
+		}
+		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
+	}
+
+	return data
+}
+
+// instanceStates breaks instances down by state, running first.
+func instanceStates(objects []dao.AWSObject) string {
+	states := make(map[string]int)
+	for _, obj := range objects {
+		if inst, ok := obj.GetRaw().(types.Instance); ok && inst.State != nil {
+			states[string(inst.State.Name)]++
+		}
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if (names[i] == "running") != (names[j] == "running") {
+			return names[i] == "running"
+		}
+		return names[i] < names[j]
+	})
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s %d", name, states[name]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// unattachedVolumes counts volumes not attached to any instance.
+func unattachedVolumes(objects []dao.AWSObject) string {
+	var n int
+	for _, obj := range objects {
+		if dao.IsIdle(obj) {
+			n++
+		}
+	}
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("unattached %d", n)
+}
+
+// openCmd opens the browser of the selected resource type.
+func (o *Overview) openCmd(*tcell.EventKey) *tcell.EventKey {
+	rid := o.GetSelectedItem()
+	if rid == "" {
+		return nil
+	}
+	if err := o.app.command.resourceCmd(rid, nil); err != nil {
+		o.app.Flash().Err(err)
+	}
+	return nil
+}
+
+// refreshCmd recounts every resource type.
+func (o *Overview) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	o.Start()
+	return nil
+}
@@ -34,9 +34,12 @@ func NewProfileSwitcher(app *App) *ProfileSwitcher {
 	p.SetBorder(true)
 	p.SetTitle(" Profiles ")
 	p.SetTitleAlign(tview.AlignCenter)
-	p.SetBorderColor(tcell.ColorAqua)
+	p.SetBorderColor(ui.CurrentTheme().Color(ui.CurrentTheme().Border))
 	p.SetBackgroundColor(tcell.ColorDefault)
 	p.SetSelectable(true, false)
+	if ui.NoColor() {
+		p.SetSelectedStyle(ui.SelectedStyle(tcell.ColorDefault, tcell.ColorDefault))
+	}
 	p.SetFixed(1, 0)
 
 	return p
@@ -129,12 +132,13 @@ func (p *ProfileSwitcher) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 // loadProfiles loads available profiles.
 func (p *ProfileSwitcher) loadProfiles() {
 	p.Clear()
+	theme := ui.CurrentTheme()
 
 	// Build header
-	headers := []string{"", "PROFILE", "REGION", "STATUS"}
+	headers := []string{"", "PROFILE", "REGION", "AUTH", "STATUS"}
 	for col, h := range headers {
 		cell := tview.NewTableCell(h).
-			SetTextColor(tcell.ColorYellow).
+			SetTextColor(theme.Color(theme.Header)).
 			SetSelectable(false).
 			SetExpansion(1)
 		p.SetCell(0, col, cell)
@@ -170,7 +174,7 @@ func (p *ProfileSwitcher) loadProfiles() {
 		indicatorColor := tcell.ColorDefault
 		if name == p.current {
 			indicator = "●"
-			indicatorColor = tcell.ColorGreen
+			indicatorColor = theme.Color(theme.OK)
 		}
 		indicatorCell := tview.NewTableCell(indicator).
 			SetTextColor(indicatorColor).
@@ -179,9 +183,9 @@ func (p *ProfileSwitcher) loadProfiles() {
 		p.SetCell(row, 0, indicatorCell)
 
 		// Profile name
-		nameColor := tcell.ColorWhite
+		nameColor := theme.Color(theme.Text)
 		if name == p.current {
-			nameColor = tcell.ColorGreen
+			nameColor = theme.Color(theme.OK)
 		}
 		nameCell := tview.NewTableCell(name).
 			SetTextColor(nameColor).
@@ -195,19 +199,29 @@ func (p *ProfileSwitcher) loadProfiles() {
 			region = "(default)"
 		}
 		regionCell := tview.NewTableCell(region).
-			SetTextColor(tcell.ColorWhite).
+			SetTextColor(theme.Color(theme.Text)).
 			SetExpansion(1)
 		p.SetCell(row, 2, regionCell)
 
+		// Auth: SSO profiles need "aws sso login" when their token expires
+		auth := ""
+		if client.ProfileIsSSO(name) {
+			auth = "sso"
+		}
+		authCell := tview.NewTableCell(auth).
+			SetTextColor(theme.Color(theme.Dim)).
+			SetExpansion(1)
+		p.SetCell(row, 3, authCell)
+
 		// Status
 		status := ""
 		if name == p.current {
 			status = "active"
 		}
 		statusCell := tview.NewTableCell(status).
-			SetTextColor(tcell.ColorGreen).
+			SetTextColor(theme.Color(theme.OK)).
 			SetExpansion(1)
-		p.SetCell(row, 3, statusCell)
+		p.SetCell(row, 4, statusCell)
 	}
 
 	// Update title with count
@@ -222,7 +236,7 @@ func (p *ProfileSwitcher) loadProfiles() {
 // showNoData displays a message when no profiles found.
 func (p *ProfileSwitcher) showNoData(msg string) {
 	cell := tview.NewTableCell(msg).
-		SetTextColor(tcell.ColorGray).
+		SetTextColor(ui.CurrentTheme().Color(ui.CurrentTheme().Dim)).
 		SetAlign(tview.AlignCenter).
 		SetSelectable(false)
 	p.SetCell(1, 0, cell)
@@ -247,9 +261,16 @@ func (p *ProfileSwitcher) selectProfile() {
 		return
 	}
 
-	p.app.Flash().Infof("Switched to profile: %s", profileName)
 	p.current = profileName
-	p.loadProfiles() // Refresh to update active indicator
+
+	// Return to the previous view and reload it with the new credentials
+	if p.app.Content.StackSize() > 1 {
+		p.app.Content.Pop()
+		p.app.RefreshCurrentView()
+	} else {
+		p.loadProfiles() // Refresh to update active indicator
+	}
+	p.app.Flash().Infof("Switched to profile: %s", profileName)
 }
 
 // SetFilter implements the filterable interface (no-op for profiles).
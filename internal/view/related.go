@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Related lists the resources related to a described resource.
+// Enter describes the selected one.
+type Related struct {
+	*Table
+
+	source    *dao.ResourceID
+	path      string
+	obj       dao.AWSObject
+	app       *App
+	factory   dao.Factory
+	pushFn    func(name string, c ui.Component)
+	backFn    func()
+	relations map[string]dao.Relation
+}
+
+// NewRelated returns a view of the resources related to obj.
+func NewRelated(source *dao.ResourceID, path string, obj dao.AWSObject) *Related {
+	return &Related{
+		Table:  NewTable(&dao.ResourceID{Service: source.Service, Resource: "related"}),
+		source: source,
+		path:   path,
+		obj:    obj,
+	}
+}
+
+// Init initializes the related resources view.
+func (r *Related) Init(ctx context.Context) error {
+	if err := r.Table.Init(ctx); err != nil {
+		return err
+	}
+
+	r.SetEnterFn(r.describeCmd)
+	return nil
+}
+
+// Name returns the component name for breadcrumbs.
+func (r *Related) Name() string {
+	return r.path + " related"
+}
+
+// SetApp sets the App reference for flash messages.
+func (r *Related) SetApp(app *App) {
+	r.app = app
+}
+
+// SetFactory sets the AWS factory.
+func (r *Related) SetFactory(f dao.Factory) {
+	r.factory = f
+}
+
+// SetPushFn sets the callback used to open the selected resource.
+func (r *Related) SetPushFn(fn func(name string, c ui.Component)) {
+	r.pushFn = fn
+}
+
+// SetBackFn sets the callback for back navigation from opened resources.
+func (r *Related) SetBackFn(fn func()) {
+	r.backFn = fn
+}
+
+// Start resolves the related resources.
+func (r *Related) Start() {
+	if r.app == nil || r.factory == nil {
+		return
+	}
+
+	accessor, err := dao.AccessorFor(r.factory, r.source)
+	if err != nil {
+		r.showError(err.Error())
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(r.app, config.TimeoutGet))
+		defer cancel()
+
+		rels, err := dao.Related(ctx, accessor, r.obj)
+		r.app.QueueUpdateDraw(func() {
+			if err != nil && (!errors.Is(err, dao.ErrPartialList) || len(rels) == 0) {
+				r.showError(fmt.Sprintf("Failed to resolve related resources: %v", err))
+				return
+			}
+			if err != nil {
+				r.app.Flash().Warnf("Some related resources could not be resolved: %v", err)
+			}
+			if len(rels) == 0 {
+				r.app.Flash().Info("No related resources")
+			}
+			r.UpdateUI(r.render(rels))
+		})
+	}()
+}
+
+// render converts relations to TableData.
+func (r *Related) render(rels []dao.Relation) *model1.TableData {
+	data := model1.NewTableData()
+	data.SetNamespace(r.path)
+	data.SetHeader(model1.Header{
+		{Name: "TYPE"},
+		{Name: "ID"},
+		{Name: "DETAIL"},
+	})
+
+	r.relations = make(map[string]dao.Relation, len(rels))
+	for _, rel := range rels {
+		id := rel.RID.String() + ":" + rel.Path
+		r.relations[id] = rel
+
+		row := model1.NewRow(3)
+		row.ID = id
+		row.Fields[0] = rel.RID.String()
+		row.Fields[1] = rel.Path
+		row.Fields[2] = rel.Detail
+		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
+	}
+
+	return data
+}
+
+// describeCmd opens a describe view of the selected related resource.
+func (r *Related) describeCmd(*tcell.EventKey) *tcell.EventKey {
+	rel, ok := r.relations[r.GetSelectedItem()]
+	if !ok || r.pushFn == nil {
+		return nil
+	}
+
+	rid := rel.RID
+	descView := NewDescribe(&rid)
+	descView.SetFactory(r.factory)
+	descView.SetPath(rel.Path)
+	descView.SetApp(r.app)
+	descView.SetBackFn(r.backFn)
+	descView.SetPushFn(r.pushFn)
+	if err := descView.Init(context.Background()); err != nil {
+		r.app.Flash().Errf("Failed to open %s: %v", rel.Path, err)
+		return nil
+	}
+
+	r.pushFn("describe", descView)
+	descView.Start()
+
+	return nil
+}
+
+// showError displays an error in the table.
+func (r *Related) showError(msg string) {
+	data := model1.NewTableData()
+	data.SetNamespace(r.path)
+	data.SetError(msg)
+	r.UpdateUI(data)
+}
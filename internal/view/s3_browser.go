@@ -7,13 +7,20 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
 	"github.com/a1s/a1s/internal/dao"
 	"github.com/a1s/a1s/internal/model1"
+	"github.com/a1s/a1s/internal/render"
 	"github.com/a1s/a1s/internal/ui"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/derailed/tcell/v2"
 )
 
@@ -24,8 +31,27 @@ type S3Browser struct {
 	currentBucket string
 	currentPrefix string
 	breadcrumbs   []string
+	objects       []dao.AWSObject
+	nextToken     string
+
+	// versionedBucket is the bucket whose versioning status gates the
+	// Versions action.
+	versionedBucket string
 }
 
+const (
+	// defaultPresignExpiry is the suggested lifetime of presigned URLs.
+	defaultPresignExpiry = 15 * time.Minute
+	// maxPresignExpiry is the longest lifetime SigV4 presigning allows.
+	maxPresignExpiry = 7 * 24 * time.Hour
+	// downloadProgressInterval is the least time between folder download
+	// progress updates.
+	downloadProgressInterval = 500 * time.Millisecond
+)
+
+// s3PageSize is the number of keys loaded per S3 listing page.
+const s3PageSize int32 = 1000
+
 // NewS3Browser returns a new S3 browser.
 func NewS3Browser() *S3Browser {
 	rid := &dao.ResourceID{
@@ -61,31 +87,152 @@ func (s *S3Browser) Name() string {
 	return s.currentBucket + "/" + s.currentPrefix
 }
 
+// Crumbs returns the bucket list, bucket and prefix segments as breadcrumbs.
+func (s *S3Browser) Crumbs() []string {
+	return append([]string{"buckets"}, s.breadcrumbs...)
+}
+
+// PopCrumbs goes up n levels and reloads.
+func (s *S3Browser) PopCrumbs(n int) {
+	for i := 0; i < n; i++ {
+		if !s.upLevel() {
+			break
+		}
+	}
+	s.Start()
+}
+
 // Start starts the S3 browser with proper bucket/prefix context.
 func (s *S3Browser) Start() {
 	s.Stop()
 
+	s.mx.RLock()
+	app := s.app
+	s.mx.RUnlock()
+	if app != nil {
+		// Crumbs and hints follow the bucket/prefix and its actions
+		defer app.viewChanged()
+	}
+
 	// If no bucket selected, show bucket list (use parent's logic)
 	if s.currentBucket == "" {
+		s.versionedBucket = ""
+		s.Actions().Delete(ui.KeyV)
 		s.Browser.Start()
 		return
 	}
 
+	if s.currentBucket != s.versionedBucket {
+		s.checkVersioning()
+	}
+
 	// Load objects for the current bucket/prefix
 	s.loadS3Objects()
 }
 
-// loadS3Objects fetches S3 objects for the current bucket/prefix.
+// checkVersioning enables the Versions action when the current bucket has
+// versioning enabled or suspended.
+func (s *S3Browser) checkVersioning() {
+	s.mx.RLock()
+	factory := s.factory
+	app := s.app
+	s.mx.RUnlock()
+
+	bucket := s.currentBucket
+	s.versionedBucket = bucket
+	s.Actions().Delete(ui.KeyV)
+	if app == nil || factory == nil {
+		return
+	}
+
+	acc, err := dao.AccessorFor(factory, &dao.S3BucketRID)
+	if err != nil {
+		return
+	}
+	versioner, ok := acc.(interface {
+		GetVersioning(ctx context.Context, bucket string) (string, error)
+	})
+	if !ok {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutGet))
+		defer cancel()
+
+		status, err := versioner.GetVersioning(ctx, bucket)
+		if err != nil || (status != "Enabled" && status != "Suspended") {
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			if s.currentBucket != bucket {
+				return
+			}
+			s.Actions().Add(ui.KeyV, ui.NewKeyAction("Versions", s.versionsCmd, true))
+			app.viewChanged()
+		})
+	}()
+}
+
+// versionsCmd opens the version history of the selected object.
+func (s *S3Browser) versionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := s.GetSelectedItem()
+	if key == "" || s.currentBucket == "" {
+		return nil
+	}
+
+	s.mx.RLock()
+	pushFn := s.pushFn
+	factory := s.factory
+	app := s.app
+	s.mx.RUnlock()
+
+	if strings.HasSuffix(key, "/") {
+		if app != nil {
+			app.Flash().Warn("Folders have no versions. Select an object.")
+		}
+		return nil
+	}
+	if pushFn == nil {
+		return nil
+	}
+
+	versions := NewObjectVersions(s.currentBucket, key)
+	versions.SetApp(app)
+	versions.SetFactory(factory)
+	if err := versions.Init(context.Background()); err != nil {
+		if app != nil {
+			app.Flash().Err(err)
+		}
+		return nil
+	}
+
+	pushFn("versions", versions)
+	versions.Start()
+	return nil
+}
+
+// loadS3Objects fetches the first page of S3 objects for the current bucket/prefix.
 func (s *S3Browser) loadS3Objects() {
+	s.objects = nil
+	s.nextToken = ""
+	s.loadS3Page("")
+}
+
+// loadS3Page fetches one page of objects starting at token in the
+// background and appends it to the objects already shown. The page is
+// dropped if the browser is stopped or restarted before it arrives.
+func (s *S3Browser) loadS3Page(token string) {
 	s.mx.RLock()
 	factory := s.factory
+	app := s.app
 	s.mx.RUnlock()
 
 	if factory == nil {
 		return
 	}
 
-	// Build the path for S3Object.List
+	// Build the path for S3Object.ListPage
 	path := s.currentBucket
 	if s.currentPrefix != "" {
 		path = s.currentBucket + "/" + s.currentPrefix
@@ -99,19 +246,86 @@ func (s *S3Browser) loadS3Objects() {
 		return
 	}
 
+	pager, ok := accessor.(interface {
+		ListPage(ctx context.Context, path, token string, pageSize int32) (*dao.ListResult, error)
+	})
+	if !ok {
+		s.showError("S3 accessor does not support paging")
+		return
+	}
+
+	update := func(fn func()) {
+		if app != nil {
+			app.QueueUpdateDraw(fn)
+		} else {
+			fn()
+		}
+	}
+
 	// Fetch objects
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	browserCtx, gen := s.prepareContext(), s.nextGen()
+	go func() {
+		ctx, cancel := context.WithTimeout(browserCtx, timeoutFor(app, config.TimeoutList))
+		defer cancel()
 
-	objects, err := accessor.List(ctx, path)
-	if err != nil {
-		s.showError(fmt.Sprintf("Failed to list objects: %v", err))
-		return
+		page, err := pager.ListPage(ctx, path, token, s3PageSize)
+		update(func() {
+			if browserCtx.Err() != nil || !s.isCurrentGen(gen) {
+				return
+			}
+			if err != nil {
+				s.showError(fmt.Sprintf("Failed to list objects: %v", err))
+				return
+			}
+
+			s.objects = append(s.objects, page.Objects...)
+			s.nextToken = page.NextToken
+
+			// Render objects
+			data := s.renderS3Objects(s.objects)
+			data.SetMore(s.nextToken != "")
+			s.UpdateUI(data)
+
+			if s.nextToken != "" && app != nil {
+				app.Flash().Infof("Showing %d objects, press L to load more", len(s.objects))
+			}
+		})
+	}()
+}
+
+// loadMoreCmd loads the next page of objects, if any.
+func (s *S3Browser) loadMoreCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.currentBucket == "" {
+		return nil
 	}
 
-	// Render objects
-	data := s.renderS3Objects(objects)
-	s.UpdateUI(data)
+	if s.nextToken == "" {
+		s.mx.RLock()
+		app := s.app
+		s.mx.RUnlock()
+		if app != nil {
+			app.Flash().Info("All objects loaded")
+		}
+		return nil
+	}
+
+	s.loadS3Page(s.nextToken)
+	return nil
+}
+
+// toggleTimesCmd switches time columns between relative ages and absolute
+// timestamps, re-rendering loaded objects instead of listing them again.
+func (s *S3Browser) toggleTimesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.currentBucket == "" {
+		return s.Browser.toggleTimesCmd(evt)
+	}
+
+	if s.toggleTimes() {
+		data := s.renderS3Objects(s.objects)
+		data.SetMore(s.nextToken != "")
+		s.UpdateUI(data)
+	}
+	return nil
 }
 
 // renderS3Objects converts S3 objects to TableData.
@@ -142,11 +356,16 @@ func (s *S3Browser) renderS3Objects(objects []dao.AWSObject) *model1.TableData {
 			row.Fields[1] = "-"
 			row.Fields[3] = "STANDARD"
 
-			if t := obj.GetCreatedAt(); t != nil {
-				row.Fields[2] = t.Format("2006-01-02 15:04")
-			} else {
-				row.Fields[2] = "-"
+			if o, ok := obj.GetRaw().(types.Object); ok {
+				if o.Size != nil {
+					row.Fields[1] = formatBytes(*o.Size)
+				}
+				if o.StorageClass != "" {
+					row.Fields[3] = string(o.StorageClass)
+				}
 			}
+
+			row.Fields[2] = s.formatTime(obj.GetCreatedAt())
 		}
 
 		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
@@ -230,6 +449,12 @@ func (s *S3Browser) bindS3Keys(aa *ui.KeyActions) {
 		tcell.KeyEsc:       ui.NewKeyAction("Go Up", s.goUpCmd, false),
 		ui.KeyD:            ui.NewKeyAction("Download", s.downloadCmd, true),
 		ui.KeyU:            ui.NewKeyAction("Upload", s.uploadCmd, true),
+		ui.KeyShiftL:       ui.NewKeyAction("Load More", s.loadMoreCmd, true),
+		ui.KeyShiftY:       ui.NewKeyAction("Copy ARN", s.copyARNCmd, true),
+		ui.KeyC:            ui.NewKeyAction("Copy S3 URI", s.copyURICmd, true),
+		ui.KeyShiftO:       ui.NewKeyAction("Open Console", s.openConsoleCmd, true),
+		ui.KeyShiftA:       ui.NewKeyAction("Toggle Age", s.toggleTimesCmd, true),
+		ui.KeyShiftP:       ui.NewKeyAction("Presign URL", s.presignCmd, true),
 		tcell.KeyCtrlD: ui.NewKeyActionWithOpts("Delete", s.deleteCmd, ui.ActionOpts{
 			Visible:   true,
 			Dangerous: true,
@@ -237,6 +462,51 @@ func (s *S3Browser) bindS3Keys(aa *ui.KeyActions) {
 	})
 }
 
+// copyARNCmd copies the ARN of the selected bucket or object.
+// Object ARNs are derived from the bucket and key without an API call.
+func (s *S3Browser) copyARNCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.currentBucket == "" {
+		return s.copyARN(evt)
+	}
+
+	if key := s.GetSelectedItem(); key != "" {
+		s.copyToClipboard("arn:aws:s3:::" + s.currentBucket + "/" + key)
+	}
+	return nil
+}
+
+// copyURICmd copies the s3:// URI of the selected bucket, folder or object,
+// or of the current location when nothing is selected, ready for aws s3 cp.
+func (s *S3Browser) copyURICmd(evt *tcell.EventKey) *tcell.EventKey {
+	bucket, path := s.currentBucket, s.currentPrefix
+	if selected := s.GetSelectedItem(); selected != "" {
+		if bucket == "" {
+			bucket, path = selected, ""
+		} else {
+			path = selected
+		}
+	}
+	if bucket == "" {
+		return nil
+	}
+
+	uri := "s3://" + bucket + "/" + path
+	s.copyToClipboard(uri)
+	return nil
+}
+
+// openConsoleCmd opens the selected bucket or object in the AWS console.
+func (s *S3Browser) openConsoleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.currentBucket == "" {
+		return s.openConsole(evt)
+	}
+
+	if key := s.GetSelectedItem(); key != "" {
+		s.openURL(aws.S3ObjectConsoleURL(s.currentBucket, key))
+	}
+	return nil
+}
+
 // drillDownCmd handles drilling down into a bucket or prefix.
 func (s *S3Browser) drillDownCmd(evt *tcell.EventKey) *tcell.EventKey {
 	// Get selected item
@@ -252,24 +522,70 @@ func (s *S3Browser) drillDownCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	// If it's a "folder" (ends with /), enter it
+	// If it's a "folder" (ends with /), enter it.
+	// Row IDs hold the full prefix, not just the folder name.
 	if strings.HasSuffix(path, "/") {
-		s.SetPrefix(s.currentPrefix + path)
+		s.SetPrefix(path)
 		s.Start()
 		return nil
 	}
 
 	// It's a file - show details
-	// TODO: Show object details view
+	s.describeObject(path)
 	return nil
 }
 
+// describeObject pushes a details view for the object with the given key.
+func (s *S3Browser) describeObject(key string) {
+	s.mx.RLock()
+	pushFn := s.pushFn
+	popFn := s.popFn
+	factory := s.factory
+	app := s.app
+	s.mx.RUnlock()
+
+	if pushFn == nil {
+		return
+	}
+
+	descView := NewDescribe(&dao.S3ObjectRID)
+	descView.SetFactory(factory)
+	descView.SetPath(s.currentBucket + "/" + key)
+	descView.SetApp(app)
+	descView.SetBackFn(func() {
+		if popFn != nil {
+			popFn()
+		}
+	})
+
+	if err := descView.Init(context.Background()); err != nil {
+		if app != nil {
+			app.Flash().Err(err)
+		}
+		return
+	}
+
+	pushFn("describe", descView)
+	descView.Start()
+}
+
 // goUpCmd handles going up one level in the hierarchy.
 func (s *S3Browser) goUpCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if len(s.breadcrumbs) == 0 {
+	if !s.upLevel() {
 		return evt
 	}
 
+	s.Start()
+	return nil
+}
+
+// upLevel moves to the parent prefix, bucket or bucket list. It reports
+// false at the bucket list.
+func (s *S3Browser) upLevel() bool {
+	if len(s.breadcrumbs) == 0 {
+		return false
+	}
+
 	if len(s.breadcrumbs) == 1 {
 		// At bucket level, go back to bucket list
 		s.currentBucket = ""
@@ -287,9 +603,7 @@ func (s *S3Browser) goUpCmd(evt *tcell.EventKey) *tcell.EventKey {
 			s.currentPrefix = ""
 		}
 	}
-
-	s.Start()
-	return nil
+	return true
 }
 
 // downloadCmd handles downloading an S3 object.
@@ -305,17 +619,6 @@ func (s *S3Browser) downloadCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	// Can't download folders
-	if strings.HasSuffix(name, "/") {
-		s.mx.RLock()
-		app := s.app
-		s.mx.RUnlock()
-		if app != nil {
-			app.Flash().Warn("Cannot download folders. Navigate into the folder to download files.")
-		}
-		return nil
-	}
-
 	s.mx.RLock()
 	app := s.app
 	factory := s.factory
@@ -325,21 +628,28 @@ func (s *S3Browser) downloadCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	// Build the key path
-	key := s.currentPrefix + name
+	// Folders are downloaded recursively
+	if strings.HasSuffix(name, "/") {
+		s.downloadPrefix(name)
+		return nil
+	}
+
+	// Row IDs hold the full key
+	key := name
+	bucket := s.currentBucket
 
 	// Determine download location (use ~/Downloads if exists, else current dir)
 	downloadDir := getDownloadDir()
-	localPath := downloadDir + "/" + name
+	localPath := filepath.Join(downloadDir, filepath.Base(key))
 
 	app.Flash().Infof("Downloading %s to %s...", name, localPath)
 
 	// Run download in background
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutTransfer))
 		defer cancel()
 
-		err := s.doDownload(ctx, s.currentBucket, key, localPath)
+		err := s.doDownload(ctx, bucket, key, localPath)
 
 		app.QueueUpdateDraw(func() {
 			if err != nil {
@@ -353,6 +663,107 @@ func (s *S3Browser) downloadCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// downloadPrefix downloads every object under prefix into a local directory
+// tree mirroring the keys below the current prefix.
+func (s *S3Browser) downloadPrefix(prefix string) {
+	s.mx.RLock()
+	app := s.app
+	factory := s.factory
+	s.mx.RUnlock()
+
+	acc, err := dao.AccessorFor(factory, &dao.S3ObjectRID)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	lister, ok := acc.(interface {
+		ListRecursive(ctx context.Context, path string) ([]dao.AWSObject, error)
+	})
+	if !ok {
+		app.Flash().Warn("Recursive download not supported")
+		return
+	}
+
+	bucket, parent := s.currentBucket, s.currentPrefix
+	root := filepath.Join(getDownloadDir(), filepath.FromSlash(strings.TrimPrefix(prefix, parent)))
+	app.Flash().Infof("Listing s3://%s/%s...", bucket, prefix)
+
+	go func() {
+		listCtx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutList))
+		objects, err := lister.ListRecursive(listCtx, bucket+"/"+prefix)
+		cancel()
+		if err != nil {
+			app.QueueUpdateDraw(func() {
+				app.Flash().Errf("Download failed: %v", err)
+			})
+			return
+		}
+
+		// Skip zero-byte folder markers
+		keys := make([]string, 0, len(objects))
+		for _, obj := range objects {
+			if key := obj.GetID(); !strings.HasSuffix(key, "/") {
+				keys = append(keys, key)
+			}
+		}
+
+		if len(keys) > 0 {
+			app.QueueUpdateDraw(func() {
+				app.Flash().Infof("Downloading %d objects to %s...", len(keys), root)
+			})
+		}
+
+		// Per-object results go to the log, the flash line shows throttled
+		// progress and the summary
+		var failed int
+		var lastFlash time.Time
+		for i, key := range keys {
+			// i objects have completed so far
+			if done, failures := i, failed; done > 0 && time.Since(lastFlash) >= downloadProgressInterval {
+				lastFlash = time.Now()
+				msg := fmt.Sprintf("Downloaded %d/%d objects to %s...", done, len(keys), root)
+				if failures > 0 {
+					msg = fmt.Sprintf("Downloaded %d/%d objects to %s (%d failed)...", done-failures, len(keys), root, failures)
+				}
+				app.QueueUpdateDraw(func() {
+					app.Flash().Info(msg)
+				})
+			}
+
+			localPath := filepath.Join(getDownloadDir(), filepath.FromSlash(strings.TrimPrefix(key, parent)))
+			if !strings.HasPrefix(localPath, root+string(filepath.Separator)) {
+				slog.Warn("Skipped object outside download directory", "bucket", bucket, "key", key)
+				failed++
+				continue
+			}
+
+			err := os.MkdirAll(filepath.Dir(localPath), 0o755)
+			if err == nil {
+				ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutTransfer))
+				err = s.doDownload(ctx, bucket, key, localPath)
+				cancel()
+			}
+			if err != nil {
+				slog.Error("Download failed", "bucket", bucket, "key", key, "err", err)
+				failed++
+				continue
+			}
+			slog.Debug("Downloaded object", "bucket", bucket, "key", key, "path", localPath)
+		}
+
+		app.QueueUpdateDraw(func() {
+			switch {
+			case len(keys) == 0:
+				app.Flash().Warnf("No objects under s3://%s/%s", bucket, prefix)
+			case failed > 0:
+				app.Flash().Errf("Downloaded %d of %d objects to %s (%d failed, see log)", len(keys)-failed, len(keys), root, failed)
+			default:
+				app.Flash().Infof("Downloaded %d objects to %s", len(keys), root)
+			}
+		})
+	}()
+}
+
 // doDownload performs the actual S3 download.
 func (s *S3Browser) doDownload(ctx context.Context, bucket, key, localPath string) error {
 	s.mx.RLock()
@@ -411,11 +822,177 @@ func createFile(path string) (*os.File, error) {
 
 // uploadCmd handles uploading a file to S3.
 func (s *S3Browser) uploadCmd(evt *tcell.EventKey) *tcell.EventKey {
-	// TODO: Implement upload
+	s.mx.RLock()
+	app := s.app
+	factory := s.factory
+	s.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return nil
+	}
+
+	// Must be inside a bucket to upload
+	if s.currentBucket == "" {
+		app.Flash().Warn("Select a bucket before uploading.")
+		return nil
+	}
+
+	bucket, prefix := s.currentBucket, s.currentPrefix
+	dialog := ui.NewInputDialog(app.Content, "Upload to s3://"+bucket+"/"+prefix, "File: ")
+	dialog.SetText(getDownloadDir() + "/")
+	dialog.SetOnSubmit(func(localPath string) {
+		s.doUpload(bucket, prefix, localPath)
+	})
+	dialog.Show()
+
+	return nil
+}
+
+// presignCmd prompts for an expiry and copies a presigned download URL for
+// the selected object to the clipboard.
+func (s *S3Browser) presignCmd(evt *tcell.EventKey) *tcell.EventKey {
+	s.mx.RLock()
+	app := s.app
+	factory := s.factory
+	s.mx.RUnlock()
+
+	key := s.GetSelectedItem()
+	if app == nil || factory == nil || key == "" || s.currentBucket == "" {
+		return nil
+	}
+	if strings.HasSuffix(key, "/") {
+		app.Flash().Warn("Cannot presign folders. Select an object.")
+		return nil
+	}
+
+	acc, err := dao.AccessorFor(factory, &dao.S3ObjectRID)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+	presigner, ok := acc.(interface {
+		GetPresignedURL(ctx context.Context, bucket, key string, expiry time.Duration) (string, error)
+	})
+	if !ok {
+		app.Flash().Warn("Presigned URLs not supported")
+		return nil
+	}
+
+	bucket := s.currentBucket
+	dialog := ui.NewInputDialog(app.Content, "Presign s3://"+bucket+"/"+key, "Expires in: ")
+	dialog.SetText(render.HumanDuration(defaultPresignExpiry))
+	dialog.SetOnSubmit(func(text string) {
+		expiry, err := time.ParseDuration(strings.TrimSpace(text))
+		if err != nil || expiry <= 0 || expiry > maxPresignExpiry {
+			app.Flash().Warnf("Invalid expiry %q: use a duration up to 168h, e.g. 15m or 24h", text)
+			return
+		}
+
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutGet))
+			defer cancel()
+
+			url, err := presigner.GetPresignedURL(ctx, bucket, key, expiry)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					app.Flash().Errf("Presign failed: %v", err)
+					return
+				}
+				if err := ui.CopyToClipboard(url); err != nil {
+					app.Flash().Errf("Copy failed: %v", err)
+					return
+				}
+				app.Flash().Infof("Copied URL (expires in %s): %s", render.HumanDuration(expiry), truncate(url, 60))
+			})
+		}()
+	})
+	dialog.Show()
+
 	return nil
 }
 
-// deleteCmd handles deleting an S3 object.
+// doUpload uploads a local file into the given bucket and prefix.
+func (s *S3Browser) doUpload(bucket, prefix, localPath string) {
+	s.mx.RLock()
+	app := s.app
+	factory := s.factory
+	s.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return
+	}
+
+	localPath = expandHome(strings.TrimSpace(localPath))
+	if info, err := os.Stat(localPath); err != nil {
+		app.Flash().Errf("Upload failed: %v", err)
+		return
+	} else if info.IsDir() {
+		app.Flash().Warn("Cannot upload directories. Select a file to upload.")
+		return
+	}
+
+	// Get S3 object accessor
+	rid := &dao.ResourceID{Service: "s3", Resource: "object"}
+	accessor, err := dao.AccessorFor(factory, rid)
+	if err != nil {
+		app.Flash().Errf("Failed to get S3 accessor: %v", err)
+		return
+	}
+
+	// Type assert to get Upload method
+	uploader, ok := accessor.(interface {
+		Upload(ctx context.Context, bucket, key string, reader io.Reader) error
+	})
+	if !ok {
+		app.Flash().Errf("S3 accessor does not support upload")
+		return
+	}
+
+	name := filepath.Base(localPath)
+	key := prefix + name
+
+	app.Flash().Infof("Uploading %s to s3://%s/%s...", name, bucket, key)
+
+	// Run upload in background
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutTransfer))
+		defer cancel()
+
+		err := func() error {
+			file, err := os.Open(localPath)
+			if err != nil {
+				return fmt.Errorf("failed to open file: %w", err)
+			}
+			defer file.Close()
+
+			return uploader.Upload(ctx, bucket, key, file)
+		}()
+
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Errf("Upload failed: %v", err)
+			} else {
+				app.Flash().Infof("Uploaded %s to s3://%s/%s", name, bucket, key)
+				// Refresh the view
+				s.Start()
+			}
+		})
+	}()
+}
+
+// expandHome expands a leading "~" in a path to the user's home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// deleteCmd handles deleting the selected bucket or S3 object, or every marked object.
 func (s *S3Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	// Get selected item
 	name := s.GetSelectedItem()
@@ -423,8 +1000,9 @@ func (s *S3Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	// Must be inside a bucket to delete
+	// At the top level the rows are buckets
 	if s.currentBucket == "" {
+		s.confirmDelete("bucket", name, name, func() { s.Start() })
 		return nil
 	}
 
@@ -437,12 +1015,23 @@ func (s *S3Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	// Build full path for deletion
-	fullPath := s.currentBucket + "/"
-	if s.currentPrefix != "" {
-		fullPath += s.currentPrefix
+	if marked := s.GetMarkedItems(); len(marked) > 0 {
+		msg := fmt.Sprintf("Delete %d objects? Folders lose ALL their contents.\n\n%s\n\nThis action cannot be undone!",
+			len(marked), strings.Join(marked, "\n"))
+
+		confirm := ui.NewConfirm(app.Content)
+		confirm.SetMessage(msg)
+		confirm.SetDangerous(true)
+		confirm.SetConfirmationText(strconv.Itoa(len(marked)))
+		confirm.SetOnConfirm(func() {
+			s.doBulkDelete(marked)
+		})
+		confirm.Show()
+		return nil
 	}
-	fullPath += name
+
+	// Row IDs are full keys, so the path is just bucket/key
+	fullPath := s.currentBucket + "/" + name
 
 	// Determine if it's a folder
 	isFolder := strings.HasSuffix(name, "/")
@@ -459,6 +1048,7 @@ func (s *S3Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	confirm := ui.NewConfirm(app.Content)
 	confirm.SetMessage(msg)
 	confirm.SetDangerous(true)
+	confirm.SetConfirmationText(name)
 	confirm.SetOnConfirm(func() {
 		s.doDelete(fullPath, isFolder)
 	})
@@ -467,6 +1057,81 @@ func (s *S3Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// objectDeleter returns the S3 object accessor's Delete capability.
+func (s *S3Browser) objectDeleter(factory dao.Factory) (interface {
+	Delete(ctx context.Context, path string, force bool) error
+}, error) {
+	rid := &dao.ResourceID{Service: "s3", Resource: "object"}
+	accessor, err := dao.AccessorFor(factory, rid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get S3 accessor: %w", err)
+	}
+
+	deleter, ok := accessor.(interface {
+		Delete(ctx context.Context, path string, force bool) error
+	})
+	if !ok {
+		return nil, fmt.Errorf("S3 accessor does not support delete")
+	}
+	return deleter, nil
+}
+
+// doBulkDelete deletes each marked key in turn, flashing per-item results and a summary.
+func (s *S3Browser) doBulkDelete(keys []string) {
+	s.mx.RLock()
+	app := s.app
+	factory := s.factory
+	s.mx.RUnlock()
+
+	if app == nil || factory == nil {
+		return
+	}
+
+	deleter, err := s.objectDeleter(factory)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	bucket := s.currentBucket
+	if app.DryRun() {
+		app.Flash().Infof("Dry run: would delete %d objects", len(keys))
+		return
+	}
+	app.Flash().Infof("Deleting %d objects...", len(keys))
+
+	go func() {
+		var failed int
+		for _, key := range keys {
+			path := bucket + "/" + key
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutAction))
+			err := deleter.Delete(ctx, path, strings.HasSuffix(key, "/"))
+			cancel()
+
+			if err != nil {
+				failed++
+			}
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					app.Flash().Errf("Delete %s failed: %v", path, err)
+				} else {
+					app.Flash().Infof("Deleted %s", path)
+				}
+			})
+		}
+
+		app.QueueUpdateDraw(func() {
+			if failed > 0 {
+				app.Flash().Errf("Delete: %d succeeded, %d failed", len(keys)-failed, failed)
+			} else {
+				app.Flash().Infof("Deleted %d objects", len(keys))
+			}
+			s.ClearMarks()
+			s.Start()
+		})
+	}()
+}
+
 // doDelete performs the actual S3 deletion.
 func (s *S3Browser) doDelete(path string, isFolder bool) {
 	s.mx.RLock()
@@ -478,28 +1143,21 @@ func (s *S3Browser) doDelete(path string, isFolder bool) {
 		return
 	}
 
-	// Get S3 object accessor
-	rid := &dao.ResourceID{Service: "s3", Resource: "object"}
-	accessor, err := dao.AccessorFor(factory, rid)
+	deleter, err := s.objectDeleter(factory)
 	if err != nil {
-		app.Flash().Errf("Failed to get S3 accessor: %v", err)
+		app.Flash().Err(err)
 		return
 	}
 
-	// Type assert to get Delete method
-	deleter, ok := accessor.(interface {
-		Delete(ctx context.Context, path string, force bool) error
-	})
-	if !ok {
-		app.Flash().Errf("S3 accessor does not support delete")
+	if app.DryRun() {
+		app.Flash().Infof("Dry run: would delete %s", path)
 		return
 	}
-
 	app.Flash().Infof("Deleting %s...", path)
 
 	// Run deletion in background
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutAction))
 		defer cancel()
 
 		// force=true for folders to delete all contents
@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ObjectVersions lists the versions of an S3 object and lets the user
+// download or delete a specific one.
+type ObjectVersions struct {
+	*Table
+
+	bucket   string
+	key      string
+	app      *App
+	factory  dao.Factory
+	versions map[string]dao.ObjectVersion
+	listed   []dao.ObjectVersion // last listing, in order, for re-rendering
+}
+
+// NewObjectVersions returns a version view for bucket/key.
+func NewObjectVersions(bucket, key string) *ObjectVersions {
+	return &ObjectVersions{
+		Table:  NewTable(&dao.ResourceID{Service: "s3", Resource: "version"}),
+		bucket: bucket,
+		key:    key,
+	}
+}
+
+// Init initializes the version view.
+func (o *ObjectVersions) Init(ctx context.Context) error {
+	if err := o.Table.Init(ctx); err != nil {
+		return err
+	}
+
+	o.Actions().Bulk(ui.KeyMap{
+		ui.KeyD:        ui.NewKeyAction("Download", o.downloadCmd, true),
+		ui.KeyShiftA:   ui.NewKeyAction("Toggle Age", o.toggleTimesCmd, true),
+		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", o.refreshCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyActionWithOpts("Delete Version", o.deleteCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}),
+	})
+	o.Actions().Delete(tcell.KeyEnter)
+	return nil
+}
+
+// Name returns the component name for breadcrumbs.
+func (o *ObjectVersions) Name() string {
+	return o.key + " versions"
+}
+
+// SetApp sets the App reference for flash messages and dialogs.
+func (o *ObjectVersions) SetApp(app *App) {
+	o.app = app
+}
+
+// SetFactory sets the AWS factory.
+func (o *ObjectVersions) SetFactory(f dao.Factory) {
+	o.factory = f
+}
+
+// Start loads the object's versions.
+func (o *ObjectVersions) Start() {
+	objects, err := o.accessor()
+	if err != nil {
+		o.showError(err.Error())
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(o.app, config.TimeoutList))
+		defer cancel()
+
+		versions, err := objects.ListVersions(ctx, o.bucket, o.key)
+		o.app.QueueUpdateDraw(func() {
+			if err != nil {
+				o.showError(fmt.Sprintf("Failed to list versions: %v", err))
+				return
+			}
+			o.UpdateUI(o.render(versions))
+		})
+	}()
+}
+
+// render converts object versions to TableData.
+func (o *ObjectVersions) render(versions []dao.ObjectVersion) *model1.TableData {
+	data := model1.NewTableData()
+	data.SetNamespace(o.bucket)
+	data.SetHeader(model1.Header{
+		{Name: "VERSION ID"},
+		{Name: "LATEST"},
+		{Name: "SIZE"},
+		{Name: "LAST MODIFIED"},
+		{Name: "TYPE"},
+	})
+
+	o.listed = versions
+	o.versions = make(map[string]dao.ObjectVersion, len(versions))
+	for _, v := range versions {
+		o.versions[v.VersionID] = v
+
+		row := model1.NewRow(5)
+		row.ID = v.VersionID
+		row.Fields[0] = v.VersionID
+		row.Fields[1] = fmt.Sprintf("%t", v.IsLatest)
+		row.Fields[2] = formatBytes(v.Size)
+		row.Fields[3] = formatTime(o.app, v.LastModified)
+		row.Fields[4] = "version"
+		if v.DeleteMarker {
+			row.Fields[2] = "-"
+			row.Fields[4] = "delete marker"
+		}
+		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
+	}
+
+	return data
+}
+
+// toggleTimesCmd switches LAST MODIFIED between relative ages and absolute
+// timestamps, re-rendering the listed versions.
+func (o *ObjectVersions) toggleTimesCmd(*tcell.EventKey) *tcell.EventKey {
+	if toggleTimes(o.app) {
+		o.UpdateUI(o.render(o.listed))
+	}
+	return nil
+}
+
+// downloadCmd downloads the selected version next to other downloads,
+// suffixing the file name with the version ID.
+func (o *ObjectVersions) downloadCmd(*tcell.EventKey) *tcell.EventKey {
+	v, ok := o.versions[o.GetSelectedItem()]
+	if !ok || o.app == nil {
+		return nil
+	}
+	if v.DeleteMarker {
+		o.app.Flash().Warn("Delete markers have no content to download")
+		return nil
+	}
+
+	objects, err := o.accessor()
+	if err != nil {
+		o.app.Flash().Err(err)
+		return nil
+	}
+
+	localPath := filepath.Join(getDownloadDir(), filepath.Base(o.key)+"."+v.VersionID)
+	o.app.Flash().Infof("Downloading %s (%s) to %s...", o.key, v.VersionID, localPath)
+
+	app := o.app
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutTransfer))
+		defer cancel()
+
+		err := downloadVersion(ctx, objects, o.bucket, o.key, v.VersionID, localPath)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Errf("Download failed: %v", err)
+				return
+			}
+			app.Flash().Infof("Downloaded %s to %s", o.key, localPath)
+		})
+	}()
+
+	return nil
+}
+
+// downloadVersion writes one object version to localPath, removing the
+// partial file on failure.
+func downloadVersion(ctx context.Context, objects *dao.S3Object, bucket, key, versionID, localPath string) error {
+	file, err := createFile(localPath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+
+	err = objects.DownloadVersion(ctx, bucket, key, versionID, file)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(localPath)
+	}
+	return err
+}
+
+// deleteCmd permanently deletes the selected version after confirmation.
+func (o *ObjectVersions) deleteCmd(*tcell.EventKey) *tcell.EventKey {
+	v, ok := o.versions[o.GetSelectedItem()]
+	if !ok || o.app == nil {
+		return nil
+	}
+
+	objects, err := o.accessor()
+	if err != nil {
+		o.app.Flash().Err(err)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Permanently delete version %s of '%s'?\n\nThis action cannot be undone!", v.VersionID, o.key)
+	if v.DeleteMarker {
+		msg = fmt.Sprintf("Remove delete marker %s of '%s'?\n\nThe previous version becomes current.", v.VersionID, o.key)
+	}
+
+	app := o.app
+	confirm := ui.NewConfirm(app.Content)
+	confirm.SetMessage(msg)
+	confirm.SetDangerous(true)
+	confirm.SetConfirmationText(v.VersionID)
+	confirm.SetOnConfirm(func() {
+		if app.DryRun() {
+			app.Flash().Infof("Dry run: would delete version %s of %s", v.VersionID, o.key)
+			return
+		}
+		app.Flash().Infof("Deleting version %s...", v.VersionID)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(app, config.TimeoutAction))
+			defer cancel()
+
+			err := objects.DeleteVersion(ctx, o.bucket, o.key, v.VersionID)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					app.Flash().Errf("Delete failed: %v", err)
+					return
+				}
+				app.Flash().Infof("Deleted version %s of %s", v.VersionID, o.key)
+				o.Start()
+			})
+		}()
+	})
+	confirm.Show()
+
+	return nil
+}
+
+// refreshCmd reloads the versions.
+func (o *ObjectVersions) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	o.Start()
+	return nil
+}
+
+// showError displays an error in the table.
+func (o *ObjectVersions) showError(msg string) {
+	data := model1.NewTableData()
+	data.SetNamespace(o.bucket)
+	data.SetError(msg)
+	o.UpdateUI(data)
+}
+
+// accessor returns the S3 object DAO.
+func (o *ObjectVersions) accessor() (*dao.S3Object, error) {
+	if o.app == nil || o.factory == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	acc, err := dao.AccessorFor(o.factory, &dao.S3ObjectRID)
+	if err != nil {
+		return nil, err
+	}
+	objects, ok := acc.(*dao.S3Object)
+	if !ok {
+		return nil, fmt.Errorf("versions not supported for %s", dao.S3ObjectRID.String())
+	}
+	return objects, nil
+}
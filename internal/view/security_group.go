@@ -5,29 +5,44 @@ package view
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
 
+	"github.com/a1s/a1s/internal/config"
 	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/model1"
 	"github.com/a1s/a1s/internal/ui"
 	"github.com/derailed/tcell/v2"
 )
 
+// Rule form field labels.
+const (
+	ruleDirectionLabel = "Direction"
+	ruleProtocolLabel  = "Protocol"
+	rulePortsLabel     = "Ports"
+	rulePeerTypeLabel  = "Peer Type"
+	rulePeerLabel      = "Peer"
+)
+
+// Rule peer types offered by the add-rule form.
+const (
+	rulePeerCIDR       = "CIDR"
+	rulePeerGroup      = "Security Group"
+	rulePeerPrefixList = "Prefix List"
+)
+
 // SecurityGroup represents a security group view with rule browsing.
 type SecurityGroup struct {
 	*Browser
-
-	sgID     string
-	ruleType string
 }
 
 // NewSecurityGroup returns a new security group view.
 func NewSecurityGroup() *SecurityGroup {
-	rid := &dao.ResourceID{
-		Service:  "vpc",
-		Resource: "securitygroup",
-	}
 	return &SecurityGroup{
-		Browser:  NewBrowser(rid),
-		ruleType: "inbound",
+		Browser: NewBrowser(&dao.VPCSecurityGroupRID),
 	}
 }
 
@@ -46,72 +61,358 @@ func (s *SecurityGroup) Name() string {
 	return "security-group"
 }
 
-// SetSecurityGroupID sets the security group to view.
-func (s *SecurityGroup) SetSecurityGroupID(sgID string) {
-	s.sgID = sgID
-}
-
 // bindSGKeys sets up security group-specific key bindings.
 func (s *SecurityGroup) bindSGKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
-		tcell.KeyEnter: ui.NewKeyAction("View Rule Details", s.viewRuleDetails, true),
-		ui.KeyI:        ui.NewKeyAction("Inbound Rules", s.inboundCmd, true),
-		ui.KeyO:        ui.NewKeyAction("Outbound Rules", s.outboundCmd, true),
-		ui.KeyA:        ui.NewKeyAction("Add Rule", s.addRuleCmd, true),
-		ui.KeyE:        ui.NewKeyAction("Edit Rule", s.editRuleCmd, true),
-		tcell.KeyCtrlD: ui.NewKeyActionWithOpts("Delete Rule", s.deleteRuleCmd, ui.ActionOpts{
-			Visible:   true,
-			Dangerous: true,
-		}),
+		tcell.KeyEnter: ui.NewKeyAction("Rules", s.rulesCmd, true),
 	})
 }
 
-// viewRuleDetails shows detailed information about the selected rule.
-func (s *SecurityGroup) viewRuleDetails(*tcell.EventKey) *tcell.EventKey {
-	path := s.GetSelectedItem()
+// rulesCmd shows the rules of the selected security group.
+func (s *SecurityGroup) rulesCmd(*tcell.EventKey) *tcell.EventKey {
+	path, _ := s.selectedPath()
 	if path == "" {
 		return nil
 	}
-	// TODO: Implement rule details view
+
+	s.mx.RLock()
+	app := s.app
+	factory := s.factory
+	pushFn := s.pushFn
+	s.mx.RUnlock()
+
+	if app == nil || factory == nil || pushFn == nil {
+		return nil
+	}
+
+	view := NewSecurityGroupRules(path)
+	view.SetApp(app)
+	view.SetFactory(factory)
+	if err := view.Init(context.Background()); err != nil {
+		app.Flash().Errf("Failed to open rules: %v", err)
+		return nil
+	}
+
+	pushFn("sg-rules", view)
+	view.Start()
+
 	return nil
 }
 
-// inboundCmd switches to showing inbound rules.
-func (s *SecurityGroup) inboundCmd(*tcell.EventKey) *tcell.EventKey {
-	s.ruleType = "inbound"
-	s.Start()
-	return nil
+// SecurityGroupRules lists and edits the rules of a security group.
+type SecurityGroupRules struct {
+	*Table
+
+	path    string // region/sg-id
+	app     *App
+	factory dao.Factory
+	rules   map[string]dao.SecurityGroupRule
+	mx      sync.RWMutex
 }
 
-// outboundCmd switches to showing outbound rules.
-func (s *SecurityGroup) outboundCmd(*tcell.EventKey) *tcell.EventKey {
-	s.ruleType = "outbound"
-	s.Start()
-	return nil
+// NewSecurityGroupRules returns a new rule view for the given "region/sg-id" path.
+func NewSecurityGroupRules(path string) *SecurityGroupRules {
+	return &SecurityGroupRules{
+		Table: NewTable(&dao.ResourceID{Service: "vpc", Resource: "sgrule"}),
+		path:  path,
+	}
 }
 
-// addRuleCmd initiates adding a new security group rule.
-func (s *SecurityGroup) addRuleCmd(*tcell.EventKey) *tcell.EventKey {
-	// TODO: Implement add rule dialog
+// Init initializes the rule view.
+func (s *SecurityGroupRules) Init(ctx context.Context) error {
+	if err := s.Table.Init(ctx); err != nil {
+		return err
+	}
+
+	s.bindKeys(s.Actions())
 	return nil
 }
 
-// editRuleCmd initiates editing the selected security group rule.
-func (s *SecurityGroup) editRuleCmd(*tcell.EventKey) *tcell.EventKey {
-	path := s.GetSelectedItem()
-	if path == "" {
+// Name returns the component name for breadcrumbs.
+func (s *SecurityGroupRules) Name() string {
+	return s.sgID() + " rules"
+}
+
+// SetApp sets the App reference for flash messages and dialogs.
+func (s *SecurityGroupRules) SetApp(app *App) {
+	s.app = app
+}
+
+// SetFactory sets the AWS factory.
+func (s *SecurityGroupRules) SetFactory(f dao.Factory) {
+	s.factory = f
+}
+
+// sgID returns the security group ID part of the path.
+func (s *SecurityGroupRules) sgID() string {
+	if idx := strings.LastIndex(s.path, "/"); idx >= 0 {
+		return s.path[idx+1:]
+	}
+	return s.path
+}
+
+// bindKeys sets up rule bindings.
+func (s *SecurityGroupRules) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(tcell.KeyEnter)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyA: ui.NewKeyAction("Add Rule", s.addCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyActionWithOpts("Delete Rule", s.deleteCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}),
+	})
+}
+
+// Start loads the security group rules.
+func (s *SecurityGroupRules) Start() {
+	sg, err := s.accessor()
+	if err != nil {
+		s.showError(err.Error())
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(s.app, config.TimeoutList))
+		defer cancel()
+
+		rules, err := sg.ListRules(ctx, s.path)
+		s.app.QueueUpdateDraw(func() {
+			if err != nil {
+				s.showError(fmt.Sprintf("Failed to list rules: %v", err))
+				return
+			}
+			s.UpdateUI(s.render(rules))
+		})
+	}()
+}
+
+// render converts rules to TableData.
+func (s *SecurityGroupRules) render(rules []dao.SecurityGroupRule) *model1.TableData {
+	data := model1.NewTableData()
+	data.SetNamespace(s.sgID())
+	data.SetHeader(model1.Header{
+		{Name: "DIRECTION"},
+		{Name: "PROTOCOL"},
+		{Name: "PORTS"},
+		{Name: "CIDR/SG"},
+		{Name: "DESCRIPTION"},
+	})
+
+	byKey := make(map[string]dao.SecurityGroupRule, len(rules))
+	for _, rule := range rules {
+		protocol := rule.Protocol
+		if protocol == "-1" {
+			protocol = "All"
+		}
+
+		row := model1.NewRow(5)
+		row.ID = rule.Key()
+		row.Fields[0] = rule.Direction()
+		row.Fields[1] = protocol
+		row.Fields[2] = rule.Ports()
+		row.Fields[3] = rule.Peer
+		row.Fields[4] = rule.Description
+		data.RowEvents().Add(model1.NewRowEvent(model1.EventAdd, row))
+
+		byKey[row.ID] = rule
+	}
+
+	s.mx.Lock()
+	s.rules = byKey
+	s.mx.Unlock()
+
+	return data
+}
+
+// showError displays an error in the table.
+func (s *SecurityGroupRules) showError(msg string) {
+	data := model1.NewTableData()
+	data.SetNamespace(s.sgID())
+	data.SetError(msg)
+	s.UpdateUI(data)
+}
+
+// accessor returns the security group DAO.
+func (s *SecurityGroupRules) accessor() (*dao.SecurityGroup, error) {
+	if s.app == nil || s.factory == nil {
+		return nil, fmt.Errorf("factory not initialized")
+	}
+
+	acc, err := dao.AccessorFor(s.factory, &dao.VPCSecurityGroupRID)
+	if err != nil {
+		return nil, err
+	}
+
+	sg, ok := acc.(*dao.SecurityGroup)
+	if !ok {
+		return nil, fmt.Errorf("security group accessor does not support rules")
+	}
+	return sg, nil
+}
+
+// addCmd prompts for a new rule and adds it.
+func (s *SecurityGroupRules) addCmd(*tcell.EventKey) *tcell.EventKey {
+	sg, err := s.accessor()
+	if err != nil {
+		if s.app != nil {
+			s.app.Flash().Err(err)
+		}
 		return nil
 	}
-	// TODO: Implement edit rule dialog
+
+	form := ui.NewFormDialog(s.app.Content, "Add Rule to "+s.sgID())
+	form.AddDropDown(ruleDirectionLabel, []string{"ingress", "egress"})
+	form.AddDropDown(ruleProtocolLabel, []string{"tcp", "udp", "icmp", "all"})
+	form.AddInputField(rulePortsLabel, "")
+	form.AddDropDown(rulePeerTypeLabel, []string{rulePeerCIDR, rulePeerGroup, rulePeerPrefixList})
+	form.AddInputField(rulePeerLabel, "")
+	form.SetOnSubmit(func(values map[string]string) {
+		rule, err := parseRule(values)
+		if err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		s.mutate(fmt.Sprintf("Added %s rule %s", rule.Direction(), rule.Key()), func(ctx context.Context) error {
+			return sg.AddRule(ctx, s.path, rule)
+		})
+	})
+	form.Show()
+
 	return nil
 }
 
-// deleteRuleCmd initiates deletion of the selected security group rule.
-func (s *SecurityGroup) deleteRuleCmd(*tcell.EventKey) *tcell.EventKey {
-	path := s.GetSelectedItem()
-	if path == "" {
+// deleteCmd removes the selected rule after confirmation.
+func (s *SecurityGroupRules) deleteCmd(*tcell.EventKey) *tcell.EventKey {
+	key := s.GetSelectedItem()
+	if key == "" {
 		return nil
 	}
-	// TODO: Implement delete rule confirmation dialog
+
+	s.mx.RLock()
+	rule, ok := s.rules[key]
+	s.mx.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	sg, err := s.accessor()
+	if err != nil {
+		if s.app != nil {
+			s.app.Flash().Err(err)
+		}
+		return nil
+	}
+
+	confirm := ui.NewConfirm(s.app.Content)
+	confirm.SetMessage(fmt.Sprintf("Delete %s rule %s %s from %s?", rule.Direction(), rule.Ports(), rule.Peer, s.sgID()))
+	confirm.SetDangerous(true)
+	confirm.SetConfirmationText(key)
+	confirm.SetOnConfirm(func() {
+		s.mutate(fmt.Sprintf("Deleted %s rule %s", rule.Direction(), key), func(ctx context.Context) error {
+			return sg.RemoveRule(ctx, s.path, rule)
+		})
+	})
+	confirm.Show()
+
+	return nil
+}
+
+// mutate applies a rule change in the background and reloads the rules.
+func (s *SecurityGroupRules) mutate(done string, fn func(ctx context.Context) error) {
+	dryRun := s.app.DryRun()
+	s.app.Flash().Info(dryRunPrefix(dryRun) + "Updating rules...")
+
+	go func() {
+		ctx, cancel := context.WithTimeout(dryRunContext(context.Background(), dryRun), timeoutFor(s.app, config.TimeoutAction))
+		defer cancel()
+
+		err := fn(ctx)
+		s.app.QueueUpdateDraw(func() {
+			if err != nil {
+				s.app.Flash().Errf("Rule update failed: %v", err)
+				return
+			}
+			if dryRun {
+				s.app.Flash().Info("Dry run: rule update would succeed")
+				return
+			}
+			s.app.Flash().Info(done)
+			s.Start()
+		})
+	}()
+}
+
+// parseRule builds a rule from the add-rule form values.
+// Ports are a single port or a "from-to" range; ICMP uses type-code.
+func parseRule(values map[string]string) (dao.SecurityGroupRule, error) {
+	rule := dao.SecurityGroupRule{
+		Egress:   values[ruleDirectionLabel] == "egress",
+		Protocol: values[ruleProtocolLabel],
+		FromPort: -1,
+		ToPort:   -1,
+		Peer:     strings.TrimSpace(values[rulePeerLabel]),
+	}
+	if err := checkRulePeer(values[rulePeerTypeLabel], rule.Peer); err != nil {
+		return rule, err
+	}
+	if rule.Protocol == "all" {
+		rule.Protocol = "-1"
+		return rule, nil
+	}
+
+	ports := strings.TrimSpace(values[rulePortsLabel])
+	if ports == "" {
+		if rule.Protocol != "icmp" {
+			return rule, fmt.Errorf("ports are required for %s", rule.Protocol)
+		}
+		return rule, nil
+	}
+
+	from, to, found := strings.Cut(ports, "-")
+	if !found {
+		to = from
+	}
+	fromPort, err := strconv.ParseInt(strings.TrimSpace(from), 10, 32)
+	if err != nil {
+		return rule, fmt.Errorf("invalid port %q", from)
+	}
+	toPort, err := strconv.ParseInt(strings.TrimSpace(to), 10, 32)
+	if err != nil {
+		return rule, fmt.Errorf("invalid port %q", to)
+	}
+	rule.FromPort, rule.ToPort = int32(fromPort), int32(toPort)
+
+	if rule.Protocol == "icmp" {
+		if rule.FromPort < -1 || rule.FromPort > 255 || rule.ToPort < -1 || rule.ToPort > 255 {
+			return rule, fmt.Errorf("ICMP type and code must be between -1 and 255")
+		}
+		return rule, nil
+	}
+	if rule.FromPort < 0 || rule.ToPort > 65535 {
+		return rule, fmt.Errorf("ports must be between 0 and 65535")
+	}
+	if rule.FromPort > rule.ToPort {
+		return rule, fmt.Errorf("invalid port range %d-%d", rule.FromPort, rule.ToPort)
+	}
+
+	return rule, nil
+}
+
+// checkRulePeer validates a rule peer against the chosen peer type.
+func checkRulePeer(peerType, peer string) error {
+	switch peerType {
+	case rulePeerGroup:
+		if !dao.IsGroupPeer(peer) {
+			return fmt.Errorf("invalid security group ID %q (expected sg-...)", peer)
+		}
+	case rulePeerPrefixList:
+		if !strings.HasPrefix(peer, "pl-") {
+			return fmt.Errorf("invalid prefix list ID %q (expected pl-...)", peer)
+		}
+	default:
+		if _, _, err := net.ParseCIDR(peer); err != nil {
+			return fmt.Errorf("invalid CIDR %q (e.g. 10.0.0.0/16 or ::/0)", peer)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,48 @@
+package view
+
+import "testing"
+
+func TestParseRulePorts(t *testing.T) {
+	tests := map[string]struct {
+		protocol, ports string
+		from, to        int32
+		wantErr         bool
+	}{
+		"single":          {protocol: "tcp", ports: "22", from: 22, to: 22},
+		"range":           {protocol: "udp", ports: "1000-2000", from: 1000, to: 2000},
+		"full range":      {protocol: "tcp", ports: "0-65535", from: 0, to: 65535},
+		"above max":       {protocol: "tcp", ports: "65536", wantErr: true},
+		"range above max": {protocol: "tcp", ports: "80-70000", wantErr: true},
+		"reversed":        {protocol: "tcp", ports: "443-80", wantErr: true},
+		"not a number":    {protocol: "tcp", ports: "ssh", wantErr: true},
+		"missing":         {protocol: "tcp", wantErr: true},
+		"icmp type-code":  {protocol: "icmp", ports: "8-0", from: 8, to: 0},
+		"icmp any":        {protocol: "icmp", from: -1, to: -1},
+		"icmp too big":    {protocol: "icmp", ports: "300", wantErr: true},
+		"all":             {protocol: "all", ports: "99999", from: -1, to: -1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rule, err := parseRule(map[string]string{
+				ruleDirectionLabel: "ingress",
+				ruleProtocolLabel:  tt.protocol,
+				rulePortsLabel:     tt.ports,
+				rulePeerTypeLabel:  rulePeerCIDR,
+				rulePeerLabel:      "10.0.0.0/8",
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", rule)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rule.FromPort != tt.from || rule.ToPort != tt.to {
+				t.Errorf("got ports %d-%d, want %d-%d", rule.FromPort, rule.ToPort, tt.from, tt.to)
+			}
+		})
+	}
+}
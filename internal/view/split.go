@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/a1s/a1s/internal/dao"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+// splitPane is a second view stack beside the main content, bound to its
+// own factory so two profiles can be compared side by side.
+type splitPane struct {
+	*tview.Flex
+
+	title   *tview.TextView
+	pages   *ui.Pages
+	factory dao.Factory
+}
+
+// newSplitPane returns an empty pane for factory's profile.
+func newSplitPane(factory dao.Factory) *splitPane {
+	s := &splitPane{
+		Flex:    tview.NewFlex().SetDirection(tview.FlexRow),
+		title:   tview.NewTextView(),
+		pages:   ui.NewPages(),
+		factory: factory,
+	}
+	s.title.SetDynamicColors(true)
+	s.title.SetBorderPadding(0, 0, 1, 1)
+	s.AddItem(s.title, 1, 0, false)
+	s.AddItem(s.pages, 0, 1, true)
+	s.update()
+
+	return s
+}
+
+// update shows the pane's profile, region and account in its title line.
+func (s *splitPane) update() {
+	theme := ui.CurrentTheme()
+	account := "n/a"
+	if client := s.factory.Client(); client != nil && client.AccountID() != "" {
+		account = client.AccountID()
+	}
+	s.title.SetText(fmt.Sprintf("%s %s  %s %s  %s %s",
+		theme.Paint(theme.Dim, "Profile:"), theme.Paint(theme.Key, tview.Escape(s.factory.Profile())),
+		theme.Paint(theme.Dim, "Region:"), theme.Paint(theme.Key, tview.Escape(s.factory.Region())),
+		theme.Paint(theme.Dim, "Account:"), theme.Paint(theme.Key, tview.Escape(account))))
+}
+
+// stop stops every view in the pane.
+func (s *splitPane) stop() {
+	_, pages := s.pages.Stack()
+	for _, p := range pages {
+		if stopper, ok := p.(interface{ Stop() }); ok {
+			stopper.Stop()
+		}
+	}
+	s.pages.ClearStack()
+}
+
+// OpenSplit shows view in a pane beside the main content, replacing any
+// open split, and focuses it. view must be bound to factory.
+func (a *App) OpenSplit(factory dao.Factory, name string, view ui.Component) {
+	a.CloseSplit()
+
+	a.split = newSplitPane(factory)
+	a.body.AddItem(a.split, 0, 1, false)
+	a.split.pages.Push(name, view)
+	a.splitFocus = true
+	a.SetFocus(view)
+	view.Start()
+
+	// The title shows the account once the new connection is verified
+	go func() {
+		if client := factory.Client(); client != nil {
+			client.CheckConnectivity()
+		}
+		a.QueueUpdateDraw(func() {
+			if a.split != nil && a.split.factory == factory {
+				a.split.update()
+			}
+		})
+	}()
+}
+
+// CloseSplit removes the split pane, if any, and focuses the main content.
+func (a *App) CloseSplit() bool {
+	if a.split == nil {
+		return false
+	}
+
+	a.split.stop()
+	a.body.RemoveItem(a.split)
+	a.split = nil
+	a.splitFocus = false
+	a.SetFocus(a.Content)
+	return true
+}
+
+// SplitPages returns the view stack of the split pane, or nil without one.
+func (a *App) SplitPages() *ui.Pages {
+	if a.split == nil {
+		return nil
+	}
+	return a.split.pages
+}
+
+// swapPane moves focus between the main content and the split pane.
+func (a *App) swapPane() {
+	if a.split == nil {
+		return
+	}
+	a.splitFocus = !a.splitFocus
+	a.SetFocus(a.focusedPages())
+}
+
+// focusedPages returns the view stack that has focus: the split pane's
+// while it is focused, otherwise the main content.
+func (a *App) focusedPages() *ui.Pages {
+	if a.split != nil && a.splitFocus {
+		return a.split.pages
+	}
+	return a.Content
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/config"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// ssmTarget is an instance a command runs on.
+type ssmTarget struct {
+	instanceID string
+	region     string
+}
+
+// SSMRun runs a shell command on instances through SSM Run Command and
+// shows each instance's output as it completes.
+type SSMRun struct {
+	*tview.TextView
+
+	command string
+	targets []ssmTarget
+	client  aws.Connection
+	app     *App
+	actions *ui.KeyActions
+
+	mx      sync.Mutex
+	results map[string]*aws.SSMCommandResult
+	errs    map[string]error
+}
+
+// NewSSMRun returns a view that runs command on the given targets with
+// client, the connection of the view the targets were picked from.
+func NewSSMRun(command string, targets []ssmTarget, client aws.Connection) *SSMRun {
+	r := &SSMRun{
+		TextView: tview.NewTextView(),
+		command:  command,
+		targets:  targets,
+		client:   client,
+		actions:  ui.NewKeyActions(),
+	}
+
+	r.SetDynamicColors(true)
+	r.SetWrap(false)
+	r.SetScrollable(true)
+	r.SetBorder(true)
+	r.SetBorderPadding(1, 1, 2, 2)
+	r.SetBorderColor(ui.CurrentTheme().Color(ui.CurrentTheme().Border))
+	r.SetTitle(fmt.Sprintf(" run[%d] %s ", len(targets), tview.Escape(command)))
+
+	return r
+}
+
+// Init initializes the run command view.
+func (r *SSMRun) Init(ctx context.Context) error {
+	r.actions.Bulk(ui.KeyMap{
+		ui.KeyR: ui.NewKeyAction("Rerun", r.rerunCmd, true),
+	})
+	r.SetInputCapture(r.keyboard)
+	return nil
+}
+
+// Start shows the results so far. Refreshing the view must not send the
+// command again, see Run.
+func (r *SSMRun) Start() {
+	r.SetText(r.render())
+}
+
+// Run sends the command to every target in the background.
+func (r *SSMRun) Run() {
+	if r.app == nil {
+		return
+	}
+	if r.client == nil {
+		r.SetText(ui.CurrentTheme().Paint(ui.CurrentTheme().Bad, "No AWS connection"))
+		return
+	}
+	client := r.client
+
+	// A rerun starts over with fresh maps, so late answers of the previous
+	// run land in the discarded ones.
+	results := make(map[string]*aws.SSMCommandResult, len(r.targets))
+	errs := make(map[string]error)
+	r.mx.Lock()
+	r.results, r.errs = results, errs
+	r.mx.Unlock()
+	r.SetText(r.render())
+
+	dryRun := r.app.DryRun()
+	for _, t := range r.targets {
+		go func(t ssmTarget) {
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFor(r.app, config.TimeoutAction))
+			defer cancel()
+
+			res, err := client.RunSSMCommand(dryRunContext(ctx, dryRun), t.region, t.instanceID, r.command)
+			r.mx.Lock()
+			if err != nil {
+				errs[t.instanceID] = err
+			} else {
+				results[t.instanceID] = res
+			}
+			r.mx.Unlock()
+
+			r.app.QueueUpdateDraw(func() {
+				r.SetText(r.render())
+			})
+		}(t)
+	}
+}
+
+// Stop stops the run command view.
+func (r *SSMRun) Stop() {}
+
+// Name returns the component name for breadcrumbs.
+func (r *SSMRun) Name() string {
+	return "run"
+}
+
+// Hints returns the menu hints for this view.
+func (r *SSMRun) Hints() ui.MenuHints {
+	return r.actions.Hints()
+}
+
+// SetApp sets the application instance.
+func (r *SSMRun) SetApp(app *App) {
+	r.app = app
+}
+
+// render formats a section per target, in target order.
+func (r *SSMRun) render() string {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	theme := ui.CurrentTheme()
+	var b strings.Builder
+	for i, t := range r.targets {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "[::b]%s[::-] %s  ", theme.Paint(theme.Key, t.instanceID), theme.Paint(theme.Dim, t.region))
+
+		res, err := r.results[t.instanceID], r.errs[t.instanceID]
+		switch {
+		case err != nil:
+			b.WriteString(theme.Paint(theme.Bad, tview.Escape(err.Error())) + "\n")
+			continue
+		case res == nil:
+			b.WriteString(theme.Paint(theme.Dim, "running...") + "\n")
+			continue
+		case res.Status == "DryRun":
+			b.WriteString(theme.Paint(theme.Dim, "Dry run: command not sent") + "\n")
+			continue
+		case res.OK():
+			b.WriteString(theme.Paint(theme.OK, res.Status) + "\n")
+		default:
+			b.WriteString(theme.Paint(theme.Bad, fmt.Sprintf("%s (exit %d)", res.Status, res.ResponseCode)) + "\n")
+		}
+
+		if out := strings.TrimRight(res.Stdout, "\n"); out != "" {
+			b.WriteString(theme.Paint(theme.Text, tview.Escape(out)) + "\n")
+		}
+		if out := strings.TrimRight(res.Stderr, "\n"); out != "" {
+			b.WriteString(theme.Paint(theme.Bad, tview.Escape(out)) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// rerunCmd sends the command again once confirmed.
+func (r *SSMRun) rerunCmd(*tcell.EventKey) *tcell.EventKey {
+	if r.app == nil {
+		return nil
+	}
+
+	confirm := ui.NewConfirm(r.app.Content)
+	confirm.SetMessage(fmt.Sprintf("Run %q again on %d instance(s)?", r.command, len(r.targets)))
+	confirm.SetDangerous(true)
+	confirm.SetOnConfirm(r.Run)
+	confirm.Show()
+	return nil
+}
+
+// keyboard handles run command view key events.
+func (r *SSMRun) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+	if action, ok := r.actions.Get(key); ok {
+		return action.Action(evt)
+	}
+	return evt
+}
@@ -106,7 +106,6 @@ func (t *Table) bindKeys(aa *ui.KeyActions) {
 
 	aa.Bulk(ui.KeyMap{
 		tcell.KeyEnter: ui.NewKeyAction("Describe", t.enterCmd, true),
-		ui.KeyY:        ui.NewKeyAction("Copy ARN", t.cpyCmd, true),
 		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", t.refreshCmd, true),
 	})
 }
@@ -119,12 +118,6 @@ func (t *Table) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
-// cpyCmd copies the ARN to clipboard.
-func (t *Table) cpyCmd(evt *tcell.EventKey) *tcell.EventKey {
-	// Placeholder - clipboard integration to be implemented
-	return nil
-}
-
 // refreshCmd refreshes the table data.
 func (t *Table) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
 	// Placeholder - refresh logic to be implemented
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of a1s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/a1s/a1s/internal/aws"
+	"github.com/a1s/a1s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// WhoAmI shows the active profile's assume-role chain and the identity STS
+// reports for it, so the user can check which account they are acting in.
+type WhoAmI struct {
+	*tview.TextView
+
+	app     *App
+	actions *ui.KeyActions
+}
+
+// NewWhoAmI returns a new identity view.
+func NewWhoAmI(app *App) *WhoAmI {
+	w := &WhoAmI{
+		TextView: tview.NewTextView(),
+		app:      app,
+		actions:  ui.NewKeyActions(),
+	}
+
+	w.SetDynamicColors(true)
+	w.SetWrap(false)
+	w.SetScrollable(true)
+	w.SetBorder(true)
+	w.SetBorderPadding(1, 1, 2, 2)
+	w.SetBorderColor(ui.CurrentTheme().Color(ui.CurrentTheme().Border))
+	w.SetTitle(" whoami ")
+
+	return w
+}
+
+// Init initializes the identity view.
+func (w *WhoAmI) Init(ctx context.Context) error {
+	w.actions.Bulk(ui.KeyMap{
+		ui.KeyR: ui.NewKeyAction("Verify", w.refreshCmd, true),
+	})
+	w.SetInputCapture(w.keyboard)
+	return nil
+}
+
+// Start renders the role chain and verifies the identity in the background.
+func (w *WhoAmI) Start() {
+	client := w.client()
+	if client == nil {
+		w.SetText(ui.CurrentTheme().Paint(ui.CurrentTheme().Bad, "No AWS connection"))
+		return
+	}
+
+	w.SetText(w.render(client, false))
+	go func() {
+		client.CheckConnectivity()
+		w.app.QueueUpdateDraw(func() {
+			w.SetText(w.render(client, true))
+			w.app.showStatus(client)
+		})
+	}()
+}
+
+// Stop stops the identity view.
+func (w *WhoAmI) Stop() {}
+
+// Name returns the component name for breadcrumbs.
+func (w *WhoAmI) Name() string {
+	return "whoami"
+}
+
+// Hints returns the menu hints for this view.
+func (w *WhoAmI) Hints() ui.MenuHints {
+	return w.actions.Hints()
+}
+
+// client returns the active AWS connection, if any.
+func (w *WhoAmI) client() aws.Connection {
+	if w.app == nil {
+		return nil
+	}
+	factory := w.app.GetFactory()
+	if factory == nil {
+		return nil
+	}
+	return factory.Client()
+}
+
+// render formats the role chain followed by the caller identity. Until
+// checked is set the identity shows as being verified.
+func (w *WhoAmI) render(client aws.Connection, checked bool) string {
+	theme := ui.CurrentTheme()
+	var b strings.Builder
+
+	b.WriteString(theme.Paint(theme.Header, "PROFILE CHAIN") + "\n")
+	chain, err := client.ProfileChain(client.ActiveProfile())
+	if err != nil {
+		fmt.Fprintf(&b, "  %s\n", theme.Paint(theme.Bad, tview.Escape(err.Error())))
+	}
+	for i, p := range chain {
+		if i > 0 {
+			fmt.Fprintf(&b, "     %s %s\n", theme.Paint(theme.Dim, "↓ assumes"), theme.Paint(theme.Key, tview.Escape(p.RoleARN)))
+		}
+		fmt.Fprintf(&b, "  %d. %s  %s\n", i+1, theme.Paint(theme.Name, tview.Escape(p.Name)), theme.Paint(theme.Dim, chainDetail(p, i == 0)))
+	}
+
+	b.WriteString("\n" + theme.Paint(theme.Header, "CALLER IDENTITY") + "\n")
+	id := client.CallerIdentity()
+	switch {
+	case !checked:
+		b.WriteString("  " + theme.Paint(theme.Dim, "Verifying with STS GetCallerIdentity...") + "\n")
+		return b.String()
+	case id.Arn == "":
+		msg := "Identity could not be verified"
+		if err := client.ConnectionErr(); err != nil {
+			msg += ": " + err.Error()
+		}
+		b.WriteString("  " + theme.Paint(theme.Bad, tview.Escape(msg)) + "\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "  %s  %s\n", theme.Paint(theme.Dim, "Account"), theme.Paint(theme.Key, id.Account))
+	fmt.Fprintf(&b, "  %s      %s\n", theme.Paint(theme.Dim, "Arn"), theme.Paint(theme.Key, tview.Escape(id.Arn)))
+	fmt.Fprintf(&b, "  %s   %s\n", theme.Paint(theme.Dim, "UserId"), theme.Paint(theme.Key, tview.Escape(id.UserID)))
+
+	if want := chainAccount(chain); want != "" && want != id.Account {
+		b.WriteString("\n" + theme.Paint(theme.Bad, fmt.Sprintf("WARNING: profile expects account %s but credentials belong to %s", want, id.Account)) + "\n")
+	}
+
+	return b.String()
+}
+
+// chainDetail describes where a chain link gets its credentials.
+func chainDetail(p *aws.Profile, base bool) string {
+	var parts []string
+	switch {
+	case !base:
+	case p.IsSSO():
+		parts = append(parts, "sso")
+	case p.RoleARN != "":
+		parts = append(parts, "assumes "+p.RoleARN)
+	default:
+		parts = append(parts, "credentials")
+	}
+	if account := linkAccount(p); account != "" {
+		parts = append(parts, "account "+account)
+	}
+	return tview.Escape(strings.Join(parts, ", "))
+}
+
+// chainAccount returns the account the last profile in the chain targets.
+func chainAccount(chain []*aws.Profile) string {
+	if len(chain) == 0 {
+		return ""
+	}
+	return linkAccount(chain[len(chain)-1])
+}
+
+// linkAccount returns the account a profile acts in, if known from config.
+func linkAccount(p *aws.Profile) string {
+	if p.RoleARN != "" {
+		return aws.RoleAccount(p.RoleARN)
+	}
+	return p.AccountID
+}
+
+// refreshCmd re-verifies the identity.
+func (w *WhoAmI) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	w.Start()
+	return nil
+}
+
+// keyboard handles identity view key events.
+func (w *WhoAmI) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+	if action, ok := w.actions.Get(key); ok {
+		return action.Action(evt)
+	}
+	return evt
+}